@@ -1,12 +1,18 @@
 package contextutils
 
-import "context"
+import (
+	"context"
+	"slices"
+)
 
 const (
 	tokenCtxKey = "token"
 	userCtxKey  = "user"
+	rolesCtxKey = "roles"
 )
 
+const StaffRole = "staff"
+
 func GetToken(ctx context.Context) string {
 	value, _ := ctx.Value(tokenCtxKey).(string)
 	return value
@@ -24,3 +30,16 @@ func GetUser(ctx context.Context) string {
 func SetUser(ctx context.Context, user string) context.Context {
 	return context.WithValue(ctx, userCtxKey, user)
 }
+
+func GetRoles(ctx context.Context) []string {
+	value, _ := ctx.Value(rolesCtxKey).([]string)
+	return value
+}
+
+func SetRoles(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, rolesCtxKey, roles)
+}
+
+func IsStaff(ctx context.Context) bool {
+	return slices.Contains(GetRoles(ctx), StaffRole)
+}
@@ -17,6 +17,8 @@ const (
 	authorizationHeader = "Authorization"
 	bearerPrefix        = "Bearer "
 	userClaim           = "preferred_username"
+	realmAccessClaim    = "realm_access"
+	rolesClaim          = "roles"
 )
 
 func Middleware(jwksURI string) echo.MiddlewareFunc {
@@ -32,7 +34,7 @@ func Middleware(jwksURI string) echo.MiddlewareFunc {
 				return c.NoContent(http.StatusUnauthorized)
 			}
 
-			user, err := getUserFromToken(token, jwksURI)
+			user, roles, err := getUserFromToken(token, jwksURI)
 			if err != nil {
 				slog.Warn("unable to get user from token", "error", err)
 				return c.NoContent(http.StatusUnauthorized)
@@ -41,6 +43,7 @@ func Middleware(jwksURI string) echo.MiddlewareFunc {
 			ctx := c.Request().Context()
 			ctx = contextutils.SetToken(ctx, token)
 			ctx = contextutils.SetUser(ctx, user)
+			ctx = contextutils.SetRoles(ctx, roles)
 
 			c.SetRequest(c.Request().WithContext(ctx))
 
@@ -62,26 +65,47 @@ func getBearerToken(c echo.Context) (string, bool) {
 	return strings.TrimPrefix(header, bearerPrefix), true
 }
 
-func getUserFromToken(rawToken, jwksURI string) (string, error) {
+func getUserFromToken(rawToken, jwksURI string) (string, []string, error) {
 	jwks, err := keyfunc.Get(jwksURI, keyfunc.Options{})
 	if err != nil {
-		return "", fmt.Errorf("get keyfunc: %w", err)
+		return "", nil, fmt.Errorf("get keyfunc: %w", err)
 	}
 
 	token, err := jwt.Parse(rawToken, jwks.Keyfunc)
 	if err != nil {
-		return "", fmt.Errorf("parse jwt: %w", err)
+		return "", nil, fmt.Errorf("parse jwt: %w", err)
 	}
 
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return "", errors.New("invalid token type")
+		return "", nil, errors.New("invalid token type")
 	}
 
 	user, ok := claims[userClaim].(string)
 	if !ok {
-		return "", errors.New("invalid user claim")
+		return "", nil, errors.New("invalid user claim")
 	}
 
-	return user, nil
+	return user, getRoles(claims), nil
+}
+
+func getRoles(claims jwt.MapClaims) []string {
+	realmAccess, ok := claims[realmAccessClaim].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	rawRoles, ok := realmAccess[rolesClaim].([]any)
+	if !ok {
+		return nil
+	}
+
+	var roles []string
+	for _, role := range rawRoles {
+		if r, ok := role.(string); ok {
+			roles = append(roles, r)
+		}
+	}
+
+	return roles
 }
@@ -0,0 +1,69 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// TTL is an in-memory cache, whose entries expire after a fixed period. When
+// the cache is full, expired entries are dropped first and then arbitrary ones.
+type TTL[K comparable, V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	size    int
+	entries map[K]entry[V]
+}
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+func NewTTL[K comparable, V any](ttl time.Duration, size int) *TTL[K, V] {
+	return &TTL[K, V]{
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[K]entry[V], size),
+	}
+}
+
+func (c *TTL[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || time.Now().After(e.expiresAt) {
+		var zero V
+		return zero, false
+	}
+
+	return e.value, true
+}
+
+func (c *TTL[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		c.evict(now)
+	}
+
+	c.entries[key] = entry[V]{value: value, expiresAt: now.Add(c.ttl)}
+}
+
+func (c *TTL[K, V]) evict(now time.Time) {
+	for key, e := range c.entries {
+		if now.After(e.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+
+	for key := range c.entries {
+		if len(c.entries) < c.size {
+			return
+		}
+
+		delete(c.entries, key)
+	}
+}
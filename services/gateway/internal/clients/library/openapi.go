@@ -12,11 +12,60 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for BookCopyResponseCondition.
+const (
+	BookCopyResponseConditionBAD       BookCopyResponseCondition = "BAD"
+	BookCopyResponseConditionEXCELLENT BookCopyResponseCondition = "EXCELLENT"
+	BookCopyResponseConditionGOOD      BookCopyResponseCondition = "GOOD"
+)
+
+// Defines values for BookCopyResponseStatus.
+const (
+	BookCopyResponseStatusAVAILABLE BookCopyResponseStatus = "AVAILABLE"
+	BookCopyResponseStatusBOOKED    BookCopyResponseStatus = "BOOKED"
+	BookCopyResponseStatusINTRANSIT BookCopyResponseStatus = "IN_TRANSIT"
+	BookCopyResponseStatusONHOLD    BookCopyResponseStatus = "ON_HOLD"
+	BookCopyResponseStatusRENTED    BookCopyResponseStatus = "RENTED"
+	BookCopyResponseStatusWITHDRAWN BookCopyResponseStatus = "WITHDRAWN"
+)
+
+// Defines values for BookingResponseStatus.
+const (
+	BookingResponseStatusBOOKED    BookingResponseStatus = "BOOKED"
+	BookingResponseStatusCANCELLED BookingResponseStatus = "CANCELLED"
+	BookingResponseStatusEXPIRED   BookingResponseStatus = "EXPIRED"
+	BookingResponseStatusPICKEDUP  BookingResponseStatus = "PICKED_UP"
+)
+
+// Defines values for ConditionChangeResponseNewCondition.
+const (
+	ConditionChangeResponseNewConditionBAD       ConditionChangeResponseNewCondition = "BAD"
+	ConditionChangeResponseNewConditionEXCELLENT ConditionChangeResponseNewCondition = "EXCELLENT"
+	ConditionChangeResponseNewConditionGOOD      ConditionChangeResponseNewCondition = "GOOD"
+)
+
+// Defines values for ConditionChangeResponseOldCondition.
+const (
+	ConditionChangeResponseOldConditionBAD       ConditionChangeResponseOldCondition = "BAD"
+	ConditionChangeResponseOldConditionEXCELLENT ConditionChangeResponseOldCondition = "EXCELLENT"
+	ConditionChangeResponseOldConditionGOOD      ConditionChangeResponseOldCondition = "GOOD"
+)
+
+// Defines values for HoldResponseStatus.
+const (
+	HoldResponseStatusCANCELLED HoldResponseStatus = "CANCELLED"
+	HoldResponseStatusEXPIRED   HoldResponseStatus = "EXPIRED"
+	HoldResponseStatusFULFILLED HoldResponseStatus = "FULFILLED"
+	HoldResponseStatusREADY     HoldResponseStatus = "READY"
+	HoldResponseStatusWAITING   HoldResponseStatus = "WAITING"
+)
+
 // Defines values for LibraryBookResponseCondition.
 const (
 	LibraryBookResponseConditionBAD       LibraryBookResponseCondition = "BAD"
@@ -31,6 +80,142 @@ const (
 	ReturnBookRequestConditionGOOD      ReturnBookRequestCondition = "GOOD"
 )
 
+// Defines values for StockAdjustmentRequestCondition.
+const (
+	StockAdjustmentRequestConditionBAD       StockAdjustmentRequestCondition = "BAD"
+	StockAdjustmentRequestConditionEXCELLENT StockAdjustmentRequestCondition = "EXCELLENT"
+	StockAdjustmentRequestConditionGOOD      StockAdjustmentRequestCondition = "GOOD"
+)
+
+// Defines values for StockMovementResponseReason.
+const (
+	StockMovementResponseReasonADJUST   StockMovementResponseReason = "ADJUST"
+	StockMovementResponseReasonBOOKING  StockMovementResponseReason = "BOOKING"
+	StockMovementResponseReasonCANCEL   StockMovementResponseReason = "CANCEL"
+	StockMovementResponseReasonCHECKOUT StockMovementResponseReason = "CHECKOUT"
+	StockMovementResponseReasonHOLD     StockMovementResponseReason = "HOLD"
+	StockMovementResponseReasonRETURN   StockMovementResponseReason = "RETURN"
+	StockMovementResponseReasonTRANSFER StockMovementResponseReason = "TRANSFER"
+)
+
+// Defines values for SuggestionResponseKind.
+const (
+	AUTHOR SuggestionResponseKind = "AUTHOR"
+	BOOK   SuggestionResponseKind = "BOOK"
+	GENRE  SuggestionResponseKind = "GENRE"
+)
+
+// Defines values for TransferResponseStatus.
+const (
+	TransferResponseStatusCANCELLED TransferResponseStatus = "CANCELLED"
+	TransferResponseStatusINTRANSIT TransferResponseStatus = "IN_TRANSIT"
+	TransferResponseStatusRECEIVED  TransferResponseStatus = "RECEIVED"
+	TransferResponseStatusREQUESTED TransferResponseStatus = "REQUESTED"
+)
+
+// Defines values for WriteOffRequestReason.
+const (
+	DAMAGED WriteOffRequestReason = "DAMAGED"
+	LOST    WriteOffRequestReason = "LOST"
+)
+
+// Defines values for GetBookCoverParamsSize.
+const (
+	Original  GetBookCoverParamsSize = "original"
+	Thumbnail GetBookCoverParamsSize = "thumbnail"
+)
+
+// Defines values for ListLibrariesParamsSort.
+const (
+	ListLibrariesParamsSortCity ListLibrariesParamsSort = "city"
+	ListLibrariesParamsSortName ListLibrariesParamsSort = "name"
+)
+
+// Defines values for ListLibrariesParamsOrder.
+const (
+	ListLibrariesParamsOrderAsc  ListLibrariesParamsOrder = "asc"
+	ListLibrariesParamsOrderDesc ListLibrariesParamsOrder = "desc"
+)
+
+// Defines values for ListBooksParamsCondition.
+const (
+	BAD       ListBooksParamsCondition = "BAD"
+	EXCELLENT ListBooksParamsCondition = "EXCELLENT"
+	GOOD      ListBooksParamsCondition = "GOOD"
+)
+
+// Defines values for ListBooksParamsSort.
+const (
+	ListBooksParamsSortAuthor         ListBooksParamsSort = "author"
+	ListBooksParamsSortAvailableCount ListBooksParamsSort = "availableCount"
+	ListBooksParamsSortGenre          ListBooksParamsSort = "genre"
+	ListBooksParamsSortName           ListBooksParamsSort = "name"
+)
+
+// Defines values for ListBooksParamsOrder.
+const (
+	ListBooksParamsOrderAsc  ListBooksParamsOrder = "asc"
+	ListBooksParamsOrderDesc ListBooksParamsOrder = "desc"
+)
+
+// Defines values for GetInventoryReportParamsFormat.
+const (
+	Csv  GetInventoryReportParamsFormat = "csv"
+	Xlsx GetInventoryReportParamsFormat = "xlsx"
+)
+
+// Defines values for ListStockMovementsParamsReason.
+const (
+	ListStockMovementsParamsReasonADJUST   ListStockMovementsParamsReason = "ADJUST"
+	ListStockMovementsParamsReasonBOOKING  ListStockMovementsParamsReason = "BOOKING"
+	ListStockMovementsParamsReasonCANCEL   ListStockMovementsParamsReason = "CANCEL"
+	ListStockMovementsParamsReasonCHECKOUT ListStockMovementsParamsReason = "CHECKOUT"
+	ListStockMovementsParamsReasonHOLD     ListStockMovementsParamsReason = "HOLD"
+	ListStockMovementsParamsReasonRETURN   ListStockMovementsParamsReason = "RETURN"
+	ListStockMovementsParamsReasonTRANSFER ListStockMovementsParamsReason = "TRANSFER"
+)
+
+// Defines values for ListLibraryTransfersParamsDirection.
+const (
+	Incoming ListLibraryTransfersParamsDirection = "incoming"
+	Outgoing ListLibraryTransfersParamsDirection = "outgoing"
+)
+
+// Defines values for ListLibraryTransfersParamsStatus.
+const (
+	CANCELLED ListLibraryTransfersParamsStatus = "CANCELLED"
+	INTRANSIT ListLibraryTransfersParamsStatus = "IN_TRANSIT"
+	RECEIVED  ListLibraryTransfersParamsStatus = "RECEIVED"
+	REQUESTED ListLibraryTransfersParamsStatus = "REQUESTED"
+)
+
+// BookCopyResponse defines model for BookCopyResponse.
+type BookCopyResponse struct {
+	// Barcode Штрихкод экземпляра
+	Barcode string `json:"barcode"`
+
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// Condition Состояние экземпляра
+	Condition BookCopyResponseCondition `json:"condition"`
+
+	// CopyUid UUID экземпляра
+	CopyUid openapi_types.UUID `json:"copyUid"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+
+	// Status Статус экземпляра
+	Status BookCopyResponseStatus `json:"status"`
+}
+
+// BookCopyResponseCondition Состояние экземпляра
+type BookCopyResponseCondition string
+
+// BookCopyResponseStatus Статус экземпляра
+type BookCopyResponseStatus string
+
 // BookInfo defines model for BookInfo.
 type BookInfo struct {
 	// Author Автор
@@ -39,11 +224,129 @@ type BookInfo struct {
 	// BookUid UUID книги
 	BookUid openapi_types.UUID `json:"bookUid"`
 
+	// CoverThumbnailUrl Ссылка на миниатюру обложки книги
+	CoverThumbnailUrl *string `json:"coverThumbnailUrl,omitempty"`
+
+	// CoverUrl Ссылка на обложку книги
+	CoverUrl *string `json:"coverUrl,omitempty"`
+
+	// Description Аннотация
+	Description *string `json:"description,omitempty"`
+
 	// Genre Жанр
 	Genre string `json:"genre"`
 
+	// Isbn10 ISBN-10
+	Isbn10 *string `json:"isbn10,omitempty"`
+
+	// Isbn13 ISBN-13
+	Isbn13 *string `json:"isbn13,omitempty"`
+
+	// Language Язык издания
+	Language *string `json:"language,omitempty"`
+
 	// Name Название книги
 	Name string `json:"name"`
+
+	// PageCount Количество страниц
+	PageCount *int `json:"pageCount,omitempty"`
+
+	// PublicationYear Год издания
+	PublicationYear *int `json:"publicationYear,omitempty"`
+
+	// Publisher Издательство
+	Publisher *string `json:"publisher,omitempty"`
+
+	// SeriesUid UUID серии
+	SeriesUid *openapi_types.UUID `json:"seriesUid,omitempty"`
+
+	// VolumeNumber Номер тома в серии
+	VolumeNumber *int `json:"volumeNumber,omitempty"`
+
+	// WorkUid UUID произведения
+	WorkUid *openapi_types.UUID `json:"workUid,omitempty"`
+}
+
+// BookingRequest defines model for BookingRequest.
+type BookingRequest struct {
+	// ExpiresAt До какого времени экземпляр ждет пользователя
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// PickupDate Дата получения книги
+	PickupDate string `json:"pickupDate"`
+}
+
+// BookingResponse defines model for BookingResponse.
+type BookingResponse struct {
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+	Copy    *BookCopyResponse  `json:"copy,omitempty"`
+
+	// ExpiresAt До какого времени экземпляр ждет пользователя
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+
+	// PickupDate Дата получения книги
+	PickupDate string `json:"pickupDate"`
+
+	// ReservationUid UUID бронирования
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+
+	// Status Статус брони
+	Status BookingResponseStatus `json:"status"`
+}
+
+// BookingResponseStatus Статус брони
+type BookingResponseStatus string
+
+// CityResponse defines model for CityResponse.
+type CityResponse struct {
+	// City Город
+	City string `json:"city"`
+
+	// LibrariesCount Количество библиотек в городе
+	LibrariesCount int `json:"librariesCount"`
+}
+
+// ConditionChangeResponse defines model for ConditionChangeResponse.
+type ConditionChangeResponse struct {
+	// ChangedAt Время изменения
+	ChangedAt time.Time `json:"changedAt"`
+
+	// ChangedBy Пользователь, зафиксировавший изменение
+	ChangedBy string `json:"changedBy"`
+
+	// NewCondition Состояние после изменения
+	NewCondition ConditionChangeResponseNewCondition `json:"newCondition"`
+
+	// OldCondition Состояние до изменения
+	OldCondition ConditionChangeResponseOldCondition `json:"oldCondition"`
+
+	// ReservationUid UUID бронирования, при возврате по которому изменилось состояние
+	ReservationUid *openapi_types.UUID `json:"reservationUid,omitempty"`
+}
+
+// ConditionChangeResponseNewCondition Состояние после изменения
+type ConditionChangeResponseNewCondition string
+
+// ConditionChangeResponseOldCondition Состояние до изменения
+type ConditionChangeResponseOldCondition string
+
+// DueDateResponse defines model for DueDateResponse.
+type DueDateResponse struct {
+	// DueDate Ближайший рабочий день библиотеки
+	DueDate string `json:"dueDate"`
+
+	// NextOpening Время ближайшего открытия библиотеки
+	NextOpening *time.Time `json:"nextOpening,omitempty"`
+
+	// OpenNow Открыта ли библиотека сейчас
+	OpenNow bool `json:"openNow"`
+
+	// RequestedDate Запрошенная дата возврата
+	RequestedDate string `json:"requestedDate"`
 }
 
 // ErrorDescription defines model for ErrorDescription.
@@ -52,10 +355,67 @@ type ErrorDescription struct {
 	Field string `json:"field"`
 }
 
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	// Message Информация об ошибке
+	Message string `json:"message"`
+}
+
+// HoldQueueResponse defines model for HoldQueueResponse.
+type HoldQueueResponse struct {
+	// Waiting Количество ожидающих заявок
+	Waiting int `json:"waiting"`
+}
+
+// HoldRequest defines model for HoldRequest.
+type HoldRequest struct {
+	// Rating Рейтинг пользователя, повышает место в очереди
+	Rating *int `json:"rating,omitempty"`
+}
+
+// HoldResponse defines model for HoldResponse.
+type HoldResponse struct {
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// ExpiresAt До какого времени отложенный экземпляр ждет пользователя
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// HoldUid UUID заявки
+	HoldUid openapi_types.UUID `json:"holdUid"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+
+	// Position Место в очереди, 0 если заявка не ожидает
+	Position int `json:"position"`
+
+	// RequestedAt Время постановки в очередь
+	RequestedAt time.Time `json:"requestedAt"`
+
+	// Status Статус заявки
+	Status HoldResponseStatus `json:"status"`
+}
+
+// HoldResponseStatus Статус заявки
+type HoldResponseStatus string
+
+// HolidayResponse defines model for HolidayResponse.
+type HolidayResponse struct {
+	// Date Дата выходного дня
+	Date string `json:"date"`
+
+	// Reason Причина закрытия
+	Reason *string `json:"reason,omitempty"`
+}
+
 // LibraryBookPaginationResponse defines model for LibraryBookPaginationResponse.
 type LibraryBookPaginationResponse struct {
 	Items []LibraryBookResponse `json:"items"`
 
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string `json:"nextCursor,omitempty"`
+
 	// Page Номер страницы
 	Page *int `json:"page,omitempty"`
 
@@ -80,11 +440,38 @@ type LibraryBookResponse struct {
 	// Condition Состояние книги
 	Condition LibraryBookResponseCondition `json:"condition"`
 
+	// CoverThumbnailUrl Ссылка на миниатюру обложки книги
+	CoverThumbnailUrl *string `json:"coverThumbnailUrl,omitempty"`
+
+	// CoverUrl Ссылка на обложку книги
+	CoverUrl *string `json:"coverUrl,omitempty"`
+
+	// Description Аннотация
+	Description *string `json:"description,omitempty"`
+
 	// Genre Жанр
 	Genre string `json:"genre"`
 
+	// Isbn10 ISBN-10
+	Isbn10 *string `json:"isbn10,omitempty"`
+
+	// Isbn13 ISBN-13
+	Isbn13 *string `json:"isbn13,omitempty"`
+
+	// Language Язык издания
+	Language *string `json:"language,omitempty"`
+
 	// Name Название книги
 	Name string `json:"name"`
+
+	// PageCount Количество страниц
+	PageCount *int `json:"pageCount,omitempty"`
+
+	// PublicationYear Год издания
+	PublicationYear *int `json:"publicationYear,omitempty"`
+
+	// Publisher Издательство
+	Publisher *string `json:"publisher,omitempty"`
 }
 
 // LibraryBookResponseCondition Состояние книги
@@ -94,6 +481,9 @@ type LibraryBookResponseCondition string
 type LibraryPaginationResponse struct {
 	Items []LibraryResponse `json:"items"`
 
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string `json:"nextCursor,omitempty"`
+
 	// Page Номер страницы
 	Page *int `json:"page,omitempty"`
 
@@ -112,90 +502,699 @@ type LibraryResponse struct {
 	// City Город, в котором находится библиотека
 	City string `json:"city"`
 
+	// Distance Расстояние до точки поиска в километрах
+	Distance *float64 `json:"distance,omitempty"`
+
+	// Latitude Широта
+	Latitude *float64 `json:"latitude,omitempty"`
+
 	// LibraryUid UUID библиотеки
 	LibraryUid openapi_types.UUID `json:"libraryUid"`
 
+	// Longitude Долгота
+	Longitude *float64 `json:"longitude,omitempty"`
+
 	// Name Название библиотеки
 	Name string `json:"name"`
 }
 
+// LibraryScheduleResponse defines model for LibraryScheduleResponse.
+type LibraryScheduleResponse struct {
+	// Holidays Ближайшие выходные дни
+	Holidays []HolidayResponse `json:"holidays"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid   openapi_types.UUID `json:"libraryUid"`
+	OpeningHours []OpeningHours     `json:"openingHours"`
+
+	// Timezone Часовой пояс библиотеки
+	Timezone string `json:"timezone"`
+}
+
+// LibraryStatisticsResponse defines model for LibraryStatisticsResponse.
+type LibraryStatisticsResponse struct {
+	// AverageLoanDays Средняя длительность выдачи в днях по возвратам за период
+	AverageLoanDays *float64 `json:"averageLoanDays,omitempty"`
+
+	// BookUid UUID книги
+	BookUid *openapi_types.UUID `json:"bookUid,omitempty"`
+
+	// Checkouts Количество выдач за период
+	Checkouts int `json:"checkouts"`
+
+	// CopiesOut Количество выданных сейчас экземпляров
+	CopiesOut int `json:"copiesOut"`
+
+	// From Начало периода
+	From string `json:"from"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+
+	// Returns Количество возвратов за период
+	Returns int `json:"returns"`
+
+	// To Конец периода
+	To string `json:"to"`
+
+	// TotalCopies Количество экземпляров в фонде
+	TotalCopies int `json:"totalCopies"`
+
+	// Utilization Доля выданных сейчас экземпляров
+	Utilization float64 `json:"utilization"`
+}
+
+// OpeningHours defines model for OpeningHours.
+type OpeningHours struct {
+	// ClosesAt Время закрытия в формате HH:MM
+	ClosesAt string `json:"closesAt"`
+
+	// OpensAt Время открытия в формате HH:MM
+	OpensAt string `json:"opensAt"`
+
+	// Weekday День недели (1 - понедельник, 7 - воскресенье)
+	Weekday int `json:"weekday"`
+}
+
+// OpeningHoursRequest defines model for OpeningHoursRequest.
+type OpeningHoursRequest struct {
+	// OpeningHours Часы работы по дням недели, пустой массив означает круглосуточную работу
+	OpeningHours []OpeningHours `json:"openingHours"`
+
+	// Timezone Часовой пояс библиотеки в формате IANA
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// PopularBookResponse defines model for PopularBookResponse.
+type PopularBookResponse struct {
+	Book BookInfo `json:"book"`
+
+	// Checkouts Количество выдач за период
+	Checkouts int `json:"checkouts"`
+
+	// Returns Количество возвратов за период
+	Returns int `json:"returns"`
+}
+
 // ReturnBookRequest defines model for ReturnBookRequest.
 type ReturnBookRequest struct {
 	// Condition Состояние книги
 	Condition ReturnBookRequestCondition `json:"condition"`
+
+	// CopyUid UUID возвращаемого экземпляра
+	CopyUid *openapi_types.UUID `json:"copyUid,omitempty"`
 }
 
 // ReturnBookRequestCondition Состояние книги
 type ReturnBookRequestCondition string
 
-// ValidationErrorResponse defines model for ValidationErrorResponse.
-type ValidationErrorResponse struct {
-	// Errors Массив полей с описанием ошибки
-	Errors []ErrorDescription `json:"errors"`
+// SeriesRequest defines model for SeriesRequest.
+type SeriesRequest struct {
+	// Author Автор
+	Author *string `json:"author,omitempty"`
 
-	// Message Информация об ошибке
-	Message string `json:"message"`
+	// Name Название серии
+	Name string `json:"name"`
 }
 
-// ViolationStatus defines model for ViolationStatus.
-type ViolationStatus struct {
-	// Violation Нарушены ли правила возврата книг
-	Violation bool `json:"violation"`
+// SeriesResponse defines model for SeriesResponse.
+type SeriesResponse struct {
+	// Author Автор
+	Author *string `json:"author,omitempty"`
+
+	// Name Название серии
+	Name string `json:"name"`
+
+	// SeriesUid UUID серии
+	SeriesUid openapi_types.UUID `json:"seriesUid"`
+
+	// Volumes Тома серии по порядку
+	Volumes []SeriesVolumeResponse `json:"volumes"`
 }
 
-// ListLibrariesParams defines parameters for ListLibraries.
-type ListLibrariesParams struct {
-	Page *int `form:"page,omitempty" json:"page,omitempty"`
-	Size *int `form:"size,omitempty" json:"size,omitempty"`
+// SeriesVolumeRequest defines model for SeriesVolumeRequest.
+type SeriesVolumeRequest struct {
+	// VolumeNumber Номер тома
+	VolumeNumber int `json:"volumeNumber"`
+}
 
-	// City Город
-	City string `form:"city" json:"city"`
+// SeriesVolumeResponse defines model for SeriesVolumeResponse.
+type SeriesVolumeResponse struct {
+	// AvailableCount Количество доступных экземпляров тома
+	AvailableCount int      `json:"availableCount"`
+	Book           BookInfo `json:"book"`
+
+	// VolumeNumber Номер тома
+	VolumeNumber int `json:"volumeNumber"`
 }
 
-// ListBooksParams defines parameters for ListBooks.
-type ListBooksParams struct {
-	Page    *int  `form:"page,omitempty" json:"page,omitempty"`
-	Size    *int  `form:"size,omitempty" json:"size,omitempty"`
-	ShowAll *bool `form:"showAll,omitempty" json:"showAll,omitempty"`
+// StockAdjustmentRequest defines model for StockAdjustmentRequest.
+type StockAdjustmentRequest struct {
+	// Comment Комментарий к корректировке
+	Comment *string `json:"comment,omitempty"`
+
+	// Condition Состояние добавляемых экземпляров
+	Condition *StockAdjustmentRequestCondition `json:"condition,omitempty"`
+
+	// Delta Изменение количества доступных экземпляров
+	Delta int `json:"delta"`
 }
 
-// ReturnBookJSONRequestBody defines body for ReturnBook for application/json ContentType.
-type ReturnBookJSONRequestBody = ReturnBookRequest
+// StockAdjustmentRequestCondition Состояние добавляемых экземпляров
+type StockAdjustmentRequestCondition string
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+// StockConsistencyResponse defines model for StockConsistencyResponse.
+type StockConsistencyResponse struct {
+	// CheckedBooks Количество проверенных книг
+	CheckedBooks int `json:"checkedBooks"`
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
+	// Consistent Совпадает ли журнал с текущим количеством экземпляров
+	Consistent    bool               `json:"consistent"`
+	Discrepancies []StockDiscrepancy `json:"discrepancies"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
 }
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+// StockDiscrepancy defines model for StockDiscrepancy.
+type StockDiscrepancy struct {
+	// ActualCount Текущее количество доступных экземпляров
+	ActualCount int `json:"actualCount"`
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	// BrokenMovements Количество записей, у которых итоговое количество не совпадает с накопленной суммой изменений
+	BrokenMovements int `json:"brokenMovements"`
+
+	// LedgerCount Количество доступных экземпляров по сумме изменений в журнале
+	LedgerCount int `json:"ledgerCount"`
+
+	// RecordedCount Количество доступных экземпляров по последней записи журнала
+	RecordedCount int `json:"recordedCount"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// StockMovementPaginationResponse defines model for StockMovementPaginationResponse.
+type StockMovementPaginationResponse struct {
+	Items []StockMovementResponse `json:"items"`
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
+	// Page Номер страницы
+	Page *int `json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// TotalElements Общее количество элементов
+	TotalElements int `json:"totalElements"`
+}
+
+// StockMovementResponse defines model for StockMovementResponse.
+type StockMovementResponse struct {
+	// Actor Пользователь, выполнивший операцию
+	Actor string `json:"actor"`
+
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// Comment Комментарий
+	Comment *string `json:"comment,omitempty"`
+
+	// CopyUid UUID экземпляра
+	CopyUid *openapi_types.UUID `json:"copyUid,omitempty"`
+
+	// CorrelationUid UUID бронирования или перемещения
+	CorrelationUid *openapi_types.UUID `json:"correlationUid,omitempty"`
+
+	// CreatedAt Время операции
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Delta Изменение количества доступных экземпляров
+	Delta int `json:"delta"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+
+	// Reason Причина движения
+	Reason StockMovementResponseReason `json:"reason"`
+
+	// ResultingCount Количество доступных экземпляров после операции
+	ResultingCount int `json:"resultingCount"`
+}
+
+// StockMovementResponseReason Причина движения
+type StockMovementResponseReason string
+
+// SuggestionResponse defines model for SuggestionResponse.
+type SuggestionResponse struct {
+	// BookUid UUID книги, заполняется для подсказок-книг
+	BookUid *openapi_types.UUID `json:"bookUid,omitempty"`
+
+	// Kind Что подсказывается
+	Kind SuggestionResponseKind `json:"kind"`
+
+	// Text Текст подсказки
+	Text string `json:"text"`
+}
+
+// SuggestionResponseKind Что подсказывается
+type SuggestionResponseKind string
+
+// TransferPaginationResponse defines model for TransferPaginationResponse.
+type TransferPaginationResponse struct {
+	Items []TransferResponse `json:"items"`
+
+	// Page Номер страницы
+	Page *int `json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// TotalElements Общее количество элементов
+	TotalElements int `json:"totalElements"`
+}
+
+// TransferRequest defines model for TransferRequest.
+type TransferRequest struct {
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// DestinationLibraryUid UUID библиотеки-получателя
+	DestinationLibraryUid openapi_types.UUID `json:"destinationLibraryUid"`
+
+	// Quantity Количество экземпляров
+	Quantity int `json:"quantity"`
+
+	// SourceLibraryUid UUID библиотеки-источника
+	SourceLibraryUid openapi_types.UUID `json:"sourceLibraryUid"`
+}
+
+// TransferResponse defines model for TransferResponse.
+type TransferResponse struct {
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// CancelledAt Время отмены
+	CancelledAt *time.Time `json:"cancelledAt,omitempty"`
+
+	// DestinationLibraryUid UUID библиотеки-получателя
+	DestinationLibraryUid openapi_types.UUID `json:"destinationLibraryUid"`
+
+	// DispatchedAt Время отправки
+	DispatchedAt *time.Time `json:"dispatchedAt,omitempty"`
+
+	// Quantity Количество экземпляров
+	Quantity int `json:"quantity"`
+
+	// ReceivedAt Время получения
+	ReceivedAt *time.Time `json:"receivedAt,omitempty"`
+
+	// RequestedAt Время создания заявки
+	RequestedAt time.Time `json:"requestedAt"`
+
+	// RequestedBy Пользователь, создавший заявку
+	RequestedBy string `json:"requestedBy"`
+
+	// SourceLibraryUid UUID библиотеки-источника
+	SourceLibraryUid openapi_types.UUID `json:"sourceLibraryUid"`
+
+	// Status Статус перемещения
+	Status TransferResponseStatus `json:"status"`
+
+	// TransferUid UUID перемещения
+	TransferUid openapi_types.UUID `json:"transferUid"`
+}
+
+// TransferResponseStatus Статус перемещения
+type TransferResponseStatus string
+
+// ValidationErrorResponse defines model for ValidationErrorResponse.
+type ValidationErrorResponse struct {
+	// Errors Массив полей с описанием ошибки
+	Errors []ErrorDescription `json:"errors"`
+
+	// Message Информация об ошибке
+	Message string `json:"message"`
+}
+
+// ViolationStatus defines model for ViolationStatus.
+type ViolationStatus struct {
+	// Violation Нарушены ли правила возврата книг
+	Violation bool `json:"violation"`
+}
+
+// WorkResponse defines model for WorkResponse.
+type WorkResponse struct {
+	// Author Автор
+	Author *string `json:"author,omitempty"`
+
+	// Editions Издания, от новых к старым
+	Editions []BookInfo `json:"editions"`
+
+	// Title Название произведения
+	Title string `json:"title"`
+
+	// WorkUid UUID произведения
+	WorkUid openapi_types.UUID `json:"workUid"`
+}
+
+// WorkSearchPaginationResponse defines model for WorkSearchPaginationResponse.
+type WorkSearchPaginationResponse struct {
+	Items []WorkSearchResult `json:"items"`
+
+	// Page Номер страницы
+	Page *int `json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// TotalElements Общее количество элементов
+	TotalElements int `json:"totalElements"`
+}
+
+// WorkSearchResult defines model for WorkSearchResult.
+type WorkSearchResult struct {
+	// Author Автор
+	Author *string  `json:"author,omitempty"`
+	Book   BookInfo `json:"book"`
+
+	// EditionsCount Количество изданий произведения
+	EditionsCount int `json:"editionsCount"`
+
+	// OtherEditions Остальные издания, заполняется при expandEditions=true
+	OtherEditions *[]BookInfo `json:"otherEditions,omitempty"`
+
+	// Title Название произведения
+	Title string `json:"title"`
+
+	// WorkUid UUID произведения
+	WorkUid openapi_types.UUID `json:"workUid"`
+}
+
+// WriteOffRequest defines model for WriteOffRequest.
+type WriteOffRequest struct {
+	// Comment Комментарий
+	Comment *string `json:"comment,omitempty"`
+
+	// Reason Причина списания
+	Reason WriteOffRequestReason `json:"reason"`
+}
+
+// WriteOffRequestReason Причина списания
+type WriteOffRequestReason string
+
+// SearchBooksParams defines parameters for SearchBooks.
+type SearchBooksParams struct {
+	// Query Строка поиска по названию и автору
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// ExpandEditions Вернуть остальные издания произведения
+	ExpandEditions *bool `form:"expandEditions,omitempty" json:"expandEditions,omitempty"`
+	Page           *int  `form:"page,omitempty" json:"page,omitempty"`
+	Size           *int  `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// GetBookCoverParams defines parameters for GetBookCover.
+type GetBookCoverParams struct {
+	// Size Размер изображения
+	Size *GetBookCoverParamsSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// IfNoneMatch ETag закешированной обложки
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// GetBookCoverParamsSize defines parameters for GetBookCover.
+type GetBookCoverParamsSize string
+
+// ListLibrariesParams defines parameters for ListLibraries.
+type ListLibrariesParams struct {
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+
+	// City Город
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+
+	// NamePrefix Начало названия библиотеки
+	NamePrefix *string `form:"namePrefix,omitempty" json:"namePrefix,omitempty"`
+
+	// Sort Поле сортировки
+	Sort *ListLibrariesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки
+	Order *ListLibrariesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор, полученный в nextCursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListLibrariesParamsSort defines parameters for ListLibraries.
+type ListLibrariesParamsSort string
+
+// ListLibrariesParamsOrder defines parameters for ListLibraries.
+type ListLibrariesParamsOrder string
+
+// ListNearbyLibrariesParams defines parameters for ListNearbyLibraries.
+type ListNearbyLibrariesParams struct {
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+
+	// Latitude Широта точки
+	Latitude float64 `form:"latitude" json:"latitude"`
+
+	// Longitude Долгота точки
+	Longitude float64 `form:"longitude" json:"longitude"`
+
+	// Radius Радиус поиска в километрах, по умолчанию 10
+	Radius *float64 `form:"radius,omitempty" json:"radius,omitempty"`
+}
+
+// ListBooksParams defines parameters for ListBooks.
+type ListBooksParams struct {
+	Page    *int  `form:"page,omitempty" json:"page,omitempty"`
+	Size    *int  `form:"size,omitempty" json:"size,omitempty"`
+	ShowAll *bool `form:"showAll,omitempty" json:"showAll,omitempty"`
+
+	// Genre Жанр
+	Genre *string `form:"genre,omitempty" json:"genre,omitempty"`
+
+	// Author Автор или его часть
+	Author *string `form:"author,omitempty" json:"author,omitempty"`
+
+	// Condition Состояние экземпляров
+	Condition *ListBooksParamsCondition `form:"condition,omitempty" json:"condition,omitempty"`
+
+	// NamePrefix Начало названия книги
+	NamePrefix *string `form:"namePrefix,omitempty" json:"namePrefix,omitempty"`
+
+	// Sort Поле сортировки
+	Sort *ListBooksParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки
+	Order *ListBooksParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор, полученный в nextCursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListBooksParamsCondition defines parameters for ListBooks.
+type ListBooksParamsCondition string
+
+// ListBooksParamsSort defines parameters for ListBooks.
+type ListBooksParamsSort string
+
+// ListBooksParamsOrder defines parameters for ListBooks.
+type ListBooksParamsOrder string
+
+// TakeBookParams defines parameters for TakeBook.
+type TakeBookParams struct {
+	// ReservationUid UUID бронирования, к которому привязывается экземпляр
+	ReservationUid *openapi_types.UUID `form:"reservationUid,omitempty" json:"reservationUid,omitempty"`
+}
+
+// BookCopyForPickupParams defines parameters for BookCopyForPickup.
+type BookCopyForPickupParams struct {
+	// ReservationUid UUID бронирования, к которому привязывается экземпляр
+	ReservationUid openapi_types.UUID `form:"reservationUid" json:"reservationUid"`
+}
+
+// ReleaseBookParams defines parameters for ReleaseBook.
+type ReleaseBookParams struct {
+	// ReservationUid UUID отмененного бронирования
+	ReservationUid openapi_types.UUID `form:"reservationUid" json:"reservationUid"`
+
+	// Rebook Если экземпляр был выдан по брони, но выдача не завершилась в сервисе бронирований, он снова откладывается по брони, а не возвращается в фонд
+	Rebook *bool `form:"rebook,omitempty" json:"rebook,omitempty"`
+}
+
+// ReturnBookParams defines parameters for ReturnBook.
+type ReturnBookParams struct {
+	// ReservationUid UUID бронирования, к которому привязан экземпляр
+	ReservationUid *openapi_types.UUID `form:"reservationUid,omitempty" json:"reservationUid,omitempty"`
+}
+
+// WriteOffBookParams defines parameters for WriteOffBook.
+type WriteOffBookParams struct {
+	// ReservationUid UUID бронирования, к которому привязан экземпляр
+	ReservationUid openapi_types.UUID `form:"reservationUid" json:"reservationUid"`
+}
+
+// GetInventoryReportParams defines parameters for GetInventoryReport.
+type GetInventoryReportParams struct {
+	// Format Формат отчета
+	Format *GetInventoryReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetInventoryReportParamsFormat defines parameters for GetInventoryReport.
+type GetInventoryReportParamsFormat string
+
+// CheckDueDateParams defines parameters for CheckDueDate.
+type CheckDueDateParams struct {
+	// Date Желаемая дата возврата
+	Date string `form:"date" json:"date"`
+}
+
+// GetLibraryStatisticsParams defines parameters for GetLibraryStatistics.
+type GetLibraryStatisticsParams struct {
+	// BookUid UUID книги
+	BookUid *openapi_types.UUID `form:"bookUid,omitempty" json:"bookUid,omitempty"`
+
+	// From Начало периода в формате YYYY-MM-DD, по умолчанию 30 дней назад
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода в формате YYYY-MM-DD, по умолчанию сегодня
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListStockMovementsParams defines parameters for ListStockMovements.
+type ListStockMovementsParams struct {
+	// BookUid UUID книги
+	BookUid *openapi_types.UUID `form:"bookUid,omitempty" json:"bookUid,omitempty"`
+
+	// Reason Причина движения
+	Reason *ListStockMovementsParamsReason `form:"reason,omitempty" json:"reason,omitempty"`
+
+	// CorrelationUid UUID бронирования или перемещения
+	CorrelationUid *openapi_types.UUID `form:"correlationUid,omitempty" json:"correlationUid,omitempty"`
+	Page           *int                `form:"page,omitempty" json:"page,omitempty"`
+	Size           *int                `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListStockMovementsParamsReason defines parameters for ListStockMovements.
+type ListStockMovementsParamsReason string
+
+// ListLibraryTransfersParams defines parameters for ListLibraryTransfers.
+type ListLibraryTransfersParams struct {
+	// Direction Входящие или исходящие перемещения, по умолчанию все
+	Direction *ListLibraryTransfersParamsDirection `form:"direction,omitempty" json:"direction,omitempty"`
+
+	// Status Статус перемещения
+	Status *ListLibraryTransfersParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Page   *int                              `form:"page,omitempty" json:"page,omitempty"`
+	Size   *int                              `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListLibraryTransfersParamsDirection defines parameters for ListLibraryTransfers.
+type ListLibraryTransfersParamsDirection string
+
+// ListLibraryTransfersParamsStatus defines parameters for ListLibraryTransfers.
+type ListLibraryTransfersParamsStatus string
+
+// GetSeriesParams defines parameters for GetSeries.
+type GetSeriesParams struct {
+	// LibraryUid UUID библиотеки, в которой считать доступные экземпляры
+	LibraryUid *openapi_types.UUID `form:"libraryUid,omitempty" json:"libraryUid,omitempty"`
+}
+
+// ListPopularBooksParams defines parameters for ListPopularBooks.
+type ListPopularBooksParams struct {
+	// City Город
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+
+	// Genre Жанр
+	Genre *string `form:"genre,omitempty" json:"genre,omitempty"`
+
+	// From Начало периода в формате YYYY-MM-DD, по умолчанию 30 дней назад
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода в формате YYYY-MM-DD, по умолчанию сегодня
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Количество книг
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListSuggestionsParams defines parameters for ListSuggestions.
+type ListSuggestionsParams struct {
+	// Query Введенная пользователем строка
+	Query string `form:"query" json:"query"`
+
+	// Limit Количество подсказок
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// BookCopyForPickupJSONRequestBody defines body for BookCopyForPickup for application/json ContentType.
+type BookCopyForPickupJSONRequestBody = BookingRequest
+
+// PlaceHoldJSONRequestBody defines body for PlaceHold for application/json ContentType.
+type PlaceHoldJSONRequestBody = HoldRequest
+
+// ReturnBookJSONRequestBody defines body for ReturnBook for application/json ContentType.
+type ReturnBookJSONRequestBody = ReturnBookRequest
+
+// AdjustStockJSONRequestBody defines body for AdjustStock for application/json ContentType.
+type AdjustStockJSONRequestBody = StockAdjustmentRequest
+
+// WriteOffBookJSONRequestBody defines body for WriteOffBook for application/json ContentType.
+type WriteOffBookJSONRequestBody = WriteOffRequest
+
+// AddHolidayJSONRequestBody defines body for AddHoliday for application/json ContentType.
+type AddHolidayJSONRequestBody = HolidayResponse
+
+// SetOpeningHoursJSONRequestBody defines body for SetOpeningHours for application/json ContentType.
+type SetOpeningHoursJSONRequestBody = OpeningHoursRequest
+
+// CreateSeriesJSONRequestBody defines body for CreateSeries for application/json ContentType.
+type CreateSeriesJSONRequestBody = SeriesRequest
+
+// SetSeriesVolumeJSONRequestBody defines body for SetSeriesVolume for application/json ContentType.
+type SetSeriesVolumeJSONRequestBody = SeriesVolumeRequest
+
+// CreateTransferJSONRequestBody defines body for CreateTransfer for application/json ContentType.
+type CreateTransferJSONRequestBody = TransferRequest
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
 		Server: server,
 	}
 	// mutate client and add all optional params
@@ -235,12 +1234,45 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// SearchBooks request
+	SearchBooks(ctx context.Context, params *SearchBooksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBookByIsbn request
+	GetBookByIsbn(ctx context.Context, isbn string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetBook request
 	GetBook(ctx context.Context, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeleteBookCover request
+	DeleteBookCover(ctx context.Context, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBookCover request
+	GetBookCover(ctx context.Context, bookUid openapi_types.UUID, params *GetBookCoverParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetBookCoverWithBody request with any body
+	SetBookCoverWithBody(ctx context.Context, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCities request
+	ListCities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCopyConditionHistory request
+	GetCopyConditionHistory(ctx context.Context, copyUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListHolds request
+	ListHolds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelHold request
+	CancelHold(ctx context.Context, holdUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHold request
+	GetHold(ctx context.Context, holdUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListLibraries request
 	ListLibraries(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListNearbyLibraries request
+	ListNearbyLibraries(ctx context.Context, params *ListNearbyLibrariesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetLibrary request
 	GetLibrary(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -248,17 +1280,152 @@ type ClientInterface interface {
 	ListBooks(ctx context.Context, libraryUid openapi_types.UUID, params *ListBooksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// TakeBook request
-	TakeBook(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	TakeBook(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *TakeBookParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// ReturnBookWithBody request with any body
-	ReturnBookWithBody(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// BookCopyForPickupWithBody request with any body
+	BookCopyForPickupWithBody(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *BookCopyForPickupParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	ReturnBook(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body ReturnBookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	BookCopyForPickup(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *BookCopyForPickupParams, body BookCopyForPickupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListBookCopies request
+	ListBookCopies(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHoldQueue request
+	GetHoldQueue(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PlaceHoldWithBody request with any body
+	PlaceHoldWithBody(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PlaceHold(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body PlaceHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReleaseBook request
+	ReleaseBook(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReleaseBookParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReturnBookWithBody request with any body
+	ReturnBookWithBody(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReturnBookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ReturnBook(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReturnBookParams, body ReturnBookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AdjustStockWithBody request with any body
+	AdjustStockWithBody(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AdjustStock(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body AdjustStockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WriteOffBookWithBody request with any body
+	WriteOffBookWithBody(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *WriteOffBookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WriteOffBook(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *WriteOffBookParams, body WriteOffBookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetInventoryReport request
+	GetInventoryReport(ctx context.Context, libraryUid openapi_types.UUID, params *GetInventoryReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLibrarySchedule request
+	GetLibrarySchedule(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CheckDueDate request
+	CheckDueDate(ctx context.Context, libraryUid openapi_types.UUID, params *CheckDueDateParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddHolidayWithBody request with any body
+	AddHolidayWithBody(ctx context.Context, libraryUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AddHoliday(ctx context.Context, libraryUid openapi_types.UUID, body AddHolidayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteHoliday request
+	DeleteHoliday(ctx context.Context, libraryUid openapi_types.UUID, date string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetOpeningHoursWithBody request with any body
+	SetOpeningHoursWithBody(ctx context.Context, libraryUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetOpeningHours(ctx context.Context, libraryUid openapi_types.UUID, body SetOpeningHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLibraryStatistics request
+	GetLibraryStatistics(ctx context.Context, libraryUid openapi_types.UUID, params *GetLibraryStatisticsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListStockMovements request
+	ListStockMovements(ctx context.Context, libraryUid openapi_types.UUID, params *ListStockMovementsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CheckStockConsistency request
+	CheckStockConsistency(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListLibraryTransfers request
+	ListLibraryTransfers(ctx context.Context, libraryUid openapi_types.UUID, params *ListLibraryTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateSeriesWithBody request with any body
+	CreateSeriesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateSeries(ctx context.Context, body CreateSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSeries request
+	GetSeries(ctx context.Context, seriesUid openapi_types.UUID, params *GetSeriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveSeriesVolume request
+	RemoveSeriesVolume(ctx context.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetSeriesVolumeWithBody request with any body
+	SetSeriesVolumeWithBody(ctx context.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetSeriesVolume(ctx context.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, body SetSeriesVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListPopularBooks request
+	ListPopularBooks(ctx context.Context, params *ListPopularBooksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListSuggestions request
+	ListSuggestions(ctx context.Context, params *ListSuggestionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTransferWithBody request with any body
+	CreateTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTransfer(ctx context.Context, body CreateTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTransfer request
+	GetTransfer(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelTransfer request
+	CancelTransfer(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DispatchTransfer request
+	DispatchTransfer(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReceiveTransfer request
+	ReceiveTransfer(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWork request
+	GetWork(ctx context.Context, workUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveWorkEdition request
+	RemoveWorkEdition(ctx context.Context, workUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddWorkEdition request
+	AddWorkEdition(ctx context.Context, workUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Health request
 	Health(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
+func (c *Client) SearchBooks(ctx context.Context, params *SearchBooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchBooksRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBookByIsbn(ctx context.Context, isbn string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBookByIsbnRequest(c.Server, isbn)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetBook(ctx context.Context, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetBookRequest(c.Server, bookUid)
 	if err != nil {
@@ -271,6 +1438,102 @@ func (c *Client) GetBook(ctx context.Context, bookUid openapi_types.UUID, reqEdi
 	return c.Client.Do(req)
 }
 
+func (c *Client) DeleteBookCover(ctx context.Context, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteBookCoverRequest(c.Server, bookUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBookCover(ctx context.Context, bookUid openapi_types.UUID, params *GetBookCoverParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBookCoverRequest(c.Server, bookUid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetBookCoverWithBody(ctx context.Context, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetBookCoverRequestWithBody(c.Server, bookUid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCities(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCitiesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCopyConditionHistory(ctx context.Context, copyUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCopyConditionHistoryRequest(c.Server, copyUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListHolds(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListHoldsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelHold(ctx context.Context, holdUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelHoldRequest(c.Server, holdUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHold(ctx context.Context, holdUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHoldRequest(c.Server, holdUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ListLibraries(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewListLibrariesRequest(c.Server, params)
 	if err != nil {
@@ -283,6 +1546,18 @@ func (c *Client) ListLibraries(ctx context.Context, params *ListLibrariesParams,
 	return c.Client.Do(req)
 }
 
+func (c *Client) ListNearbyLibraries(ctx context.Context, params *ListNearbyLibrariesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListNearbyLibrariesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetLibrary(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetLibraryRequest(c.Server, libraryUid)
 	if err != nil {
@@ -307,858 +1582,7074 @@ func (c *Client) ListBooks(ctx context.Context, libraryUid openapi_types.UUID, p
 	return c.Client.Do(req)
 }
 
-func (c *Client) TakeBook(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTakeBookRequest(c.Server, libraryUid, bookUid)
-	if err != nil {
-		return nil, err
-	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+func (c *Client) TakeBook(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *TakeBookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTakeBookRequest(c.Server, libraryUid, bookUid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BookCopyForPickupWithBody(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *BookCopyForPickupParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBookCopyForPickupRequestWithBody(c.Server, libraryUid, bookUid, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BookCopyForPickup(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *BookCopyForPickupParams, body BookCopyForPickupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBookCopyForPickupRequest(c.Server, libraryUid, bookUid, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListBookCopies(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListBookCopiesRequest(c.Server, libraryUid, bookUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHoldQueue(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHoldQueueRequest(c.Server, libraryUid, bookUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PlaceHoldWithBody(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPlaceHoldRequestWithBody(c.Server, libraryUid, bookUid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PlaceHold(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body PlaceHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPlaceHoldRequest(c.Server, libraryUid, bookUid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReleaseBook(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReleaseBookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReleaseBookRequest(c.Server, libraryUid, bookUid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReturnBookWithBody(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReturnBookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReturnBookRequestWithBody(c.Server, libraryUid, bookUid, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReturnBook(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReturnBookParams, body ReturnBookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReturnBookRequest(c.Server, libraryUid, bookUid, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdjustStockWithBody(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdjustStockRequestWithBody(c.Server, libraryUid, bookUid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AdjustStock(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body AdjustStockJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAdjustStockRequest(c.Server, libraryUid, bookUid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WriteOffBookWithBody(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *WriteOffBookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWriteOffBookRequestWithBody(c.Server, libraryUid, bookUid, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WriteOffBook(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *WriteOffBookParams, body WriteOffBookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWriteOffBookRequest(c.Server, libraryUid, bookUid, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetInventoryReport(ctx context.Context, libraryUid openapi_types.UUID, params *GetInventoryReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetInventoryReportRequest(c.Server, libraryUid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLibrarySchedule(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLibraryScheduleRequest(c.Server, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CheckDueDate(ctx context.Context, libraryUid openapi_types.UUID, params *CheckDueDateParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckDueDateRequest(c.Server, libraryUid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddHolidayWithBody(ctx context.Context, libraryUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddHolidayRequestWithBody(c.Server, libraryUid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddHoliday(ctx context.Context, libraryUid openapi_types.UUID, body AddHolidayJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddHolidayRequest(c.Server, libraryUid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteHoliday(ctx context.Context, libraryUid openapi_types.UUID, date string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteHolidayRequest(c.Server, libraryUid, date)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetOpeningHoursWithBody(ctx context.Context, libraryUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetOpeningHoursRequestWithBody(c.Server, libraryUid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetOpeningHours(ctx context.Context, libraryUid openapi_types.UUID, body SetOpeningHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetOpeningHoursRequest(c.Server, libraryUid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLibraryStatistics(ctx context.Context, libraryUid openapi_types.UUID, params *GetLibraryStatisticsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLibraryStatisticsRequest(c.Server, libraryUid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListStockMovements(ctx context.Context, libraryUid openapi_types.UUID, params *ListStockMovementsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListStockMovementsRequest(c.Server, libraryUid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CheckStockConsistency(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckStockConsistencyRequest(c.Server, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListLibraryTransfers(ctx context.Context, libraryUid openapi_types.UUID, params *ListLibraryTransfersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListLibraryTransfersRequest(c.Server, libraryUid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSeriesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSeriesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateSeries(ctx context.Context, body CreateSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateSeriesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSeries(ctx context.Context, seriesUid openapi_types.UUID, params *GetSeriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSeriesRequest(c.Server, seriesUid, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveSeriesVolume(ctx context.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveSeriesVolumeRequest(c.Server, seriesUid, bookUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetSeriesVolumeWithBody(ctx context.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetSeriesVolumeRequestWithBody(c.Server, seriesUid, bookUid, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetSeriesVolume(ctx context.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, body SetSeriesVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetSeriesVolumeRequest(c.Server, seriesUid, bookUid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListPopularBooks(ctx context.Context, params *ListPopularBooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListPopularBooksRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListSuggestions(ctx context.Context, params *ListSuggestionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListSuggestionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransferRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTransfer(ctx context.Context, body CreateTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTransferRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTransfer(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTransferRequest(c.Server, transferUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelTransfer(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelTransferRequest(c.Server, transferUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DispatchTransfer(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDispatchTransferRequest(c.Server, transferUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReceiveTransfer(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReceiveTransferRequest(c.Server, transferUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWork(ctx context.Context, workUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWorkRequest(c.Server, workUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveWorkEdition(ctx context.Context, workUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveWorkEditionRequest(c.Server, workUid, bookUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddWorkEdition(ctx context.Context, workUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddWorkEditionRequest(c.Server, workUid, bookUid)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Health(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewSearchBooksRequest generates requests for SearchBooks
+func NewSearchBooksRequest(server string, params *SearchBooksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/books")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Query != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "query", runtime.ParamLocationQuery, *params.Query); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExpandEditions != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "expandEditions", runtime.ParamLocationQuery, *params.ExpandEditions); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBookByIsbnRequest generates requests for GetBookByIsbn
+func NewGetBookByIsbnRequest(server string, isbn string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "isbn", runtime.ParamLocationPath, isbn)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/books/isbn/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBookRequest generates requests for GetBook
+func NewGetBookRequest(server string, bookUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/books/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteBookCoverRequest generates requests for DeleteBookCover
+func NewDeleteBookCoverRequest(server string, bookUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/books/%s/cover", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBookCoverRequest generates requests for GetBookCover
+func NewGetBookCoverRequest(server string, bookUid openapi_types.UUID, params *GetBookCoverParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/books/%s/cover", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewSetBookCoverRequestWithBody generates requests for SetBookCover with any type of body
+func NewSetBookCoverRequestWithBody(server string, bookUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/books/%s/cover", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListCitiesRequest generates requests for ListCities
+func NewListCitiesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/cities")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCopyConditionHistoryRequest generates requests for GetCopyConditionHistory
+func NewGetCopyConditionHistoryRequest(server string, copyUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "copyUid", runtime.ParamLocationPath, copyUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/copies/%s/condition-history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListHoldsRequest generates requests for ListHolds
+func NewListHoldsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/holds")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCancelHoldRequest generates requests for CancelHold
+func NewCancelHoldRequest(server string, holdUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "holdUid", runtime.ParamLocationPath, holdUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/holds/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHoldRequest generates requests for GetHold
+func NewGetHoldRequest(server string, holdUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "holdUid", runtime.ParamLocationPath, holdUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/holds/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListLibrariesRequest generates requests for ListLibraries
+func NewListLibrariesRequest(server string, params *ListLibrariesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.City != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "city", runtime.ParamLocationQuery, *params.City); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.NamePrefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namePrefix", runtime.ParamLocationQuery, *params.NamePrefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListNearbyLibrariesRequest generates requests for ListNearbyLibraries
+func NewListNearbyLibrariesRequest(server string, params *ListNearbyLibrariesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/nearby")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "latitude", runtime.ParamLocationQuery, params.Latitude); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "longitude", runtime.ParamLocationQuery, params.Longitude); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Radius != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "radius", runtime.ParamLocationQuery, *params.Radius); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLibraryRequest generates requests for GetLibrary
+func NewGetLibraryRequest(server string, libraryUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListBooksRequest generates requests for ListBooks
+func NewListBooksRequest(server string, libraryUid openapi_types.UUID, params *ListBooksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ShowAll != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "showAll", runtime.ParamLocationQuery, *params.ShowAll); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Genre != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "genre", runtime.ParamLocationQuery, *params.Genre); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Author != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "author", runtime.ParamLocationQuery, *params.Author); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Condition != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "condition", runtime.ParamLocationQuery, *params.Condition); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.NamePrefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "namePrefix", runtime.ParamLocationQuery, *params.NamePrefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTakeBookRequest generates requests for TakeBook
+func NewTakeBookRequest(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *TakeBookParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ReservationUid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reservationUid", runtime.ParamLocationQuery, *params.ReservationUid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBookCopyForPickupRequest calls the generic BookCopyForPickup builder with application/json body
+func NewBookCopyForPickupRequest(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *BookCopyForPickupParams, body BookCopyForPickupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBookCopyForPickupRequestWithBody(server, libraryUid, bookUid, params, "application/json", bodyReader)
+}
+
+// NewBookCopyForPickupRequestWithBody generates requests for BookCopyForPickup with any type of body
+func NewBookCopyForPickupRequestWithBody(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *BookCopyForPickupParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books/%s/bookings", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reservationUid", runtime.ParamLocationQuery, params.ReservationUid); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListBookCopiesRequest generates requests for ListBookCopies
+func NewListBookCopiesRequest(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books/%s/copies", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHoldQueueRequest generates requests for GetHoldQueue
+func NewGetHoldQueueRequest(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books/%s/holds", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPlaceHoldRequest calls the generic PlaceHold builder with application/json body
+func NewPlaceHoldRequest(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body PlaceHoldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPlaceHoldRequestWithBody(server, libraryUid, bookUid, "application/json", bodyReader)
+}
+
+// NewPlaceHoldRequestWithBody generates requests for PlaceHold with any type of body
+func NewPlaceHoldRequestWithBody(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books/%s/holds", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReleaseBookRequest generates requests for ReleaseBook
+func NewReleaseBookRequest(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReleaseBookParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books/%s/release", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reservationUid", runtime.ParamLocationQuery, params.ReservationUid); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Rebook != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rebook", runtime.ParamLocationQuery, *params.Rebook); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReturnBookRequest calls the generic ReturnBook builder with application/json body
+func NewReturnBookRequest(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReturnBookParams, body ReturnBookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewReturnBookRequestWithBody(server, libraryUid, bookUid, params, "application/json", bodyReader)
+}
+
+// NewReturnBookRequestWithBody generates requests for ReturnBook with any type of body
+func NewReturnBookRequestWithBody(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReturnBookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books/%s/return", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ReservationUid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reservationUid", runtime.ParamLocationQuery, *params.ReservationUid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewAdjustStockRequest calls the generic AdjustStock builder with application/json body
+func NewAdjustStockRequest(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body AdjustStockJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAdjustStockRequestWithBody(server, libraryUid, bookUid, "application/json", bodyReader)
+}
+
+// NewAdjustStockRequestWithBody generates requests for AdjustStock with any type of body
+func NewAdjustStockRequestWithBody(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books/%s/stock-adjustments", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWriteOffBookRequest calls the generic WriteOffBook builder with application/json body
+func NewWriteOffBookRequest(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *WriteOffBookParams, body WriteOffBookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWriteOffBookRequestWithBody(server, libraryUid, bookUid, params, "application/json", bodyReader)
+}
+
+// NewWriteOffBookRequestWithBody generates requests for WriteOffBook with any type of body
+func NewWriteOffBookRequestWithBody(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *WriteOffBookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books/%s/write-off", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reservationUid", runtime.ParamLocationQuery, params.ReservationUid); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetInventoryReportRequest generates requests for GetInventoryReport
+func NewGetInventoryReportRequest(server string, libraryUid openapi_types.UUID, params *GetInventoryReportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/reports/inventory", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetLibraryScheduleRequest generates requests for GetLibrarySchedule
+func NewGetLibraryScheduleRequest(server string, libraryUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/schedule", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCheckDueDateRequest generates requests for CheckDueDate
+func NewCheckDueDateRequest(server string, libraryUid openapi_types.UUID, params *CheckDueDateParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/schedule/due-date", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "date", runtime.ParamLocationQuery, params.Date); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddHolidayRequest calls the generic AddHoliday builder with application/json body
+func NewAddHolidayRequest(server string, libraryUid openapi_types.UUID, body AddHolidayJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAddHolidayRequestWithBody(server, libraryUid, "application/json", bodyReader)
+}
+
+// NewAddHolidayRequestWithBody generates requests for AddHoliday with any type of body
+func NewAddHolidayRequestWithBody(server string, libraryUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/schedule/holidays", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteHolidayRequest generates requests for DeleteHoliday
+func NewDeleteHolidayRequest(server string, libraryUid openapi_types.UUID, date string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "date", runtime.ParamLocationPath, date)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/schedule/holidays/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetOpeningHoursRequest calls the generic SetOpeningHours builder with application/json body
+func NewSetOpeningHoursRequest(server string, libraryUid openapi_types.UUID, body SetOpeningHoursJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetOpeningHoursRequestWithBody(server, libraryUid, "application/json", bodyReader)
+}
+
+// NewSetOpeningHoursRequestWithBody generates requests for SetOpeningHours with any type of body
+func NewSetOpeningHoursRequestWithBody(server string, libraryUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/schedule/opening-hours", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetLibraryStatisticsRequest generates requests for GetLibraryStatistics
+func NewGetLibraryStatisticsRequest(server string, libraryUid openapi_types.UUID, params *GetLibraryStatisticsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/statistics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BookUid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bookUid", runtime.ParamLocationQuery, *params.BookUid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListStockMovementsRequest generates requests for ListStockMovements
+func NewListStockMovementsRequest(server string, libraryUid openapi_types.UUID, params *ListStockMovementsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/stock-movements", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BookUid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bookUid", runtime.ParamLocationQuery, *params.BookUid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Reason != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "reason", runtime.ParamLocationQuery, *params.Reason); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CorrelationUid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "correlationUid", runtime.ParamLocationQuery, *params.CorrelationUid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCheckStockConsistencyRequest generates requests for CheckStockConsistency
+func NewCheckStockConsistencyRequest(server string, libraryUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/stock-movements/consistency", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListLibraryTransfersRequest generates requests for ListLibraryTransfers
+func NewListLibraryTransfersRequest(server string, libraryUid openapi_types.UUID, params *ListLibraryTransfersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/libraries/%s/transfers", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Direction != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "direction", runtime.ParamLocationQuery, *params.Direction); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateSeriesRequest calls the generic CreateSeries builder with application/json body
+func NewCreateSeriesRequest(server string, body CreateSeriesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateSeriesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateSeriesRequestWithBody generates requests for CreateSeries with any type of body
+func NewCreateSeriesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/series")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetSeriesRequest generates requests for GetSeries
+func NewGetSeriesRequest(server string, seriesUid openapi_types.UUID, params *GetSeriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "seriesUid", runtime.ParamLocationPath, seriesUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/series/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.LibraryUid != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "libraryUid", runtime.ParamLocationQuery, *params.LibraryUid); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRemoveSeriesVolumeRequest generates requests for RemoveSeriesVolume
+func NewRemoveSeriesVolumeRequest(server string, seriesUid openapi_types.UUID, bookUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "seriesUid", runtime.ParamLocationPath, seriesUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/series/%s/volumes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetSeriesVolumeRequest calls the generic SetSeriesVolume builder with application/json body
+func NewSetSeriesVolumeRequest(server string, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, body SetSeriesVolumeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetSeriesVolumeRequestWithBody(server, seriesUid, bookUid, "application/json", bodyReader)
+}
+
+// NewSetSeriesVolumeRequestWithBody generates requests for SetSeriesVolume with any type of body
+func NewSetSeriesVolumeRequestWithBody(server string, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "seriesUid", runtime.ParamLocationPath, seriesUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/series/%s/volumes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListPopularBooksRequest generates requests for ListPopularBooks
+func NewListPopularBooksRequest(server string, params *ListPopularBooksParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/statistics/popular-books")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.City != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "city", runtime.ParamLocationQuery, *params.City); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Genre != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "genre", runtime.ParamLocationQuery, *params.Genre); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListSuggestionsRequest generates requests for ListSuggestions
+func NewListSuggestionsRequest(server string, params *ListSuggestionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/suggestions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "query", runtime.ParamLocationQuery, params.Query); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTransferRequest calls the generic CreateTransfer builder with application/json body
+func NewCreateTransferRequest(server string, body CreateTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTransferRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTransferRequestWithBody generates requests for CreateTransfer with any type of body
+func NewCreateTransferRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTransferRequest generates requests for GetTransfer
+func NewGetTransferRequest(server string, transferUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "transferUid", runtime.ParamLocationPath, transferUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/transfers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCancelTransferRequest generates requests for CancelTransfer
+func NewCancelTransferRequest(server string, transferUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "transferUid", runtime.ParamLocationPath, transferUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/transfers/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDispatchTransferRequest generates requests for DispatchTransfer
+func NewDispatchTransferRequest(server string, transferUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "transferUid", runtime.ParamLocationPath, transferUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/transfers/%s/dispatch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReceiveTransferRequest generates requests for ReceiveTransfer
+func NewReceiveTransferRequest(server string, transferUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "transferUid", runtime.ParamLocationPath, transferUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/transfers/%s/receive", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWorkRequest generates requests for GetWork
+func NewGetWorkRequest(server string, workUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workUid", runtime.ParamLocationPath, workUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/works/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRemoveWorkEditionRequest generates requests for RemoveWorkEdition
+func NewRemoveWorkEditionRequest(server string, workUid openapi_types.UUID, bookUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workUid", runtime.ParamLocationPath, workUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/works/%s/editions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddWorkEditionRequest generates requests for AddWorkEdition
+func NewAddWorkEditionRequest(server string, workUid openapi_types.UUID, bookUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "workUid", runtime.ParamLocationPath, workUid)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/works/%s/editions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHealthRequest generates requests for Health
+func NewHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/manage/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// SearchBooksWithResponse request
+	SearchBooksWithResponse(ctx context.Context, params *SearchBooksParams, reqEditors ...RequestEditorFn) (*SearchBooksResponse, error)
+
+	// GetBookByIsbnWithResponse request
+	GetBookByIsbnWithResponse(ctx context.Context, isbn string, reqEditors ...RequestEditorFn) (*GetBookByIsbnResponse, error)
+
+	// GetBookWithResponse request
+	GetBookWithResponse(ctx context.Context, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBookResponse, error)
+
+	// DeleteBookCoverWithResponse request
+	DeleteBookCoverWithResponse(ctx context.Context, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBookCoverResponse, error)
+
+	// GetBookCoverWithResponse request
+	GetBookCoverWithResponse(ctx context.Context, bookUid openapi_types.UUID, params *GetBookCoverParams, reqEditors ...RequestEditorFn) (*GetBookCoverResponse, error)
+
+	// SetBookCoverWithBodyWithResponse request with any body
+	SetBookCoverWithBodyWithResponse(ctx context.Context, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetBookCoverResponse, error)
+
+	// ListCitiesWithResponse request
+	ListCitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCitiesResponse, error)
+
+	// GetCopyConditionHistoryWithResponse request
+	GetCopyConditionHistoryWithResponse(ctx context.Context, copyUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetCopyConditionHistoryResponse, error)
+
+	// ListHoldsWithResponse request
+	ListHoldsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListHoldsResponse, error)
+
+	// CancelHoldWithResponse request
+	CancelHoldWithResponse(ctx context.Context, holdUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelHoldResponse, error)
+
+	// GetHoldWithResponse request
+	GetHoldWithResponse(ctx context.Context, holdUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetHoldResponse, error)
+
+	// ListLibrariesWithResponse request
+	ListLibrariesWithResponse(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*ListLibrariesResponse, error)
+
+	// ListNearbyLibrariesWithResponse request
+	ListNearbyLibrariesWithResponse(ctx context.Context, params *ListNearbyLibrariesParams, reqEditors ...RequestEditorFn) (*ListNearbyLibrariesResponse, error)
+
+	// GetLibraryWithResponse request
+	GetLibraryWithResponse(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLibraryResponse, error)
+
+	// ListBooksWithResponse request
+	ListBooksWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *ListBooksParams, reqEditors ...RequestEditorFn) (*ListBooksResponse, error)
+
+	// TakeBookWithResponse request
+	TakeBookWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *TakeBookParams, reqEditors ...RequestEditorFn) (*TakeBookResponse, error)
+
+	// BookCopyForPickupWithBodyWithResponse request with any body
+	BookCopyForPickupWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *BookCopyForPickupParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BookCopyForPickupResponse, error)
+
+	BookCopyForPickupWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *BookCopyForPickupParams, body BookCopyForPickupJSONRequestBody, reqEditors ...RequestEditorFn) (*BookCopyForPickupResponse, error)
+
+	// ListBookCopiesWithResponse request
+	ListBookCopiesWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListBookCopiesResponse, error)
+
+	// GetHoldQueueWithResponse request
+	GetHoldQueueWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetHoldQueueResponse, error)
+
+	// PlaceHoldWithBodyWithResponse request with any body
+	PlaceHoldWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PlaceHoldResponse, error)
+
+	PlaceHoldWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body PlaceHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*PlaceHoldResponse, error)
+
+	// ReleaseBookWithResponse request
+	ReleaseBookWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReleaseBookParams, reqEditors ...RequestEditorFn) (*ReleaseBookResponse, error)
+
+	// ReturnBookWithBodyWithResponse request with any body
+	ReturnBookWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReturnBookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReturnBookResponse, error)
+
+	ReturnBookWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReturnBookParams, body ReturnBookJSONRequestBody, reqEditors ...RequestEditorFn) (*ReturnBookResponse, error)
+
+	// AdjustStockWithBodyWithResponse request with any body
+	AdjustStockWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdjustStockResponse, error)
+
+	AdjustStockWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body AdjustStockJSONRequestBody, reqEditors ...RequestEditorFn) (*AdjustStockResponse, error)
+
+	// WriteOffBookWithBodyWithResponse request with any body
+	WriteOffBookWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *WriteOffBookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteOffBookResponse, error)
+
+	WriteOffBookWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *WriteOffBookParams, body WriteOffBookJSONRequestBody, reqEditors ...RequestEditorFn) (*WriteOffBookResponse, error)
+
+	// GetInventoryReportWithResponse request
+	GetInventoryReportWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *GetInventoryReportParams, reqEditors ...RequestEditorFn) (*GetInventoryReportResponse, error)
+
+	// GetLibraryScheduleWithResponse request
+	GetLibraryScheduleWithResponse(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLibraryScheduleResponse, error)
+
+	// CheckDueDateWithResponse request
+	CheckDueDateWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *CheckDueDateParams, reqEditors ...RequestEditorFn) (*CheckDueDateResponse, error)
+
+	// AddHolidayWithBodyWithResponse request with any body
+	AddHolidayWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddHolidayResponse, error)
+
+	AddHolidayWithResponse(ctx context.Context, libraryUid openapi_types.UUID, body AddHolidayJSONRequestBody, reqEditors ...RequestEditorFn) (*AddHolidayResponse, error)
+
+	// DeleteHolidayWithResponse request
+	DeleteHolidayWithResponse(ctx context.Context, libraryUid openapi_types.UUID, date string, reqEditors ...RequestEditorFn) (*DeleteHolidayResponse, error)
+
+	// SetOpeningHoursWithBodyWithResponse request with any body
+	SetOpeningHoursWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetOpeningHoursResponse, error)
+
+	SetOpeningHoursWithResponse(ctx context.Context, libraryUid openapi_types.UUID, body SetOpeningHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*SetOpeningHoursResponse, error)
+
+	// GetLibraryStatisticsWithResponse request
+	GetLibraryStatisticsWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *GetLibraryStatisticsParams, reqEditors ...RequestEditorFn) (*GetLibraryStatisticsResponse, error)
+
+	// ListStockMovementsWithResponse request
+	ListStockMovementsWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *ListStockMovementsParams, reqEditors ...RequestEditorFn) (*ListStockMovementsResponse, error)
+
+	// CheckStockConsistencyWithResponse request
+	CheckStockConsistencyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CheckStockConsistencyResponse, error)
+
+	// ListLibraryTransfersWithResponse request
+	ListLibraryTransfersWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *ListLibraryTransfersParams, reqEditors ...RequestEditorFn) (*ListLibraryTransfersResponse, error)
+
+	// CreateSeriesWithBodyWithResponse request with any body
+	CreateSeriesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSeriesResponse, error)
+
+	CreateSeriesWithResponse(ctx context.Context, body CreateSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSeriesResponse, error)
+
+	// GetSeriesWithResponse request
+	GetSeriesWithResponse(ctx context.Context, seriesUid openapi_types.UUID, params *GetSeriesParams, reqEditors ...RequestEditorFn) (*GetSeriesResponse, error)
+
+	// RemoveSeriesVolumeWithResponse request
+	RemoveSeriesVolumeWithResponse(ctx context.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*RemoveSeriesVolumeResponse, error)
+
+	// SetSeriesVolumeWithBodyWithResponse request with any body
+	SetSeriesVolumeWithBodyWithResponse(ctx context.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetSeriesVolumeResponse, error)
+
+	SetSeriesVolumeWithResponse(ctx context.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, body SetSeriesVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*SetSeriesVolumeResponse, error)
+
+	// ListPopularBooksWithResponse request
+	ListPopularBooksWithResponse(ctx context.Context, params *ListPopularBooksParams, reqEditors ...RequestEditorFn) (*ListPopularBooksResponse, error)
+
+	// ListSuggestionsWithResponse request
+	ListSuggestionsWithResponse(ctx context.Context, params *ListSuggestionsParams, reqEditors ...RequestEditorFn) (*ListSuggestionsResponse, error)
+
+	// CreateTransferWithBodyWithResponse request with any body
+	CreateTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransferResponse, error)
+
+	CreateTransferWithResponse(ctx context.Context, body CreateTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransferResponse, error)
+
+	// GetTransferWithResponse request
+	GetTransferWithResponse(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTransferResponse, error)
+
+	// CancelTransferWithResponse request
+	CancelTransferWithResponse(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelTransferResponse, error)
+
+	// DispatchTransferWithResponse request
+	DispatchTransferWithResponse(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*DispatchTransferResponse, error)
+
+	// ReceiveTransferWithResponse request
+	ReceiveTransferWithResponse(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*ReceiveTransferResponse, error)
+
+	// GetWorkWithResponse request
+	GetWorkWithResponse(ctx context.Context, workUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetWorkResponse, error)
+
+	// RemoveWorkEditionWithResponse request
+	RemoveWorkEditionWithResponse(ctx context.Context, workUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*RemoveWorkEditionResponse, error)
+
+	// AddWorkEditionWithResponse request
+	AddWorkEditionWithResponse(ctx context.Context, workUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*AddWorkEditionResponse, error)
+
+	// HealthWithResponse request
+	HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResponse, error)
+}
+
+type SearchBooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkSearchPaginationResponse
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchBooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchBooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBookByIsbnResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BookInfo
+	JSON400      *ValidationErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBookByIsbnResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBookByIsbnResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BookInfo
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteBookCoverResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteBookCoverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteBookCoverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBookCoverResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBookCoverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBookCoverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetBookCoverResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BookInfo
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON413      *ErrorResponse
+	JSON415      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetBookCoverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetBookCoverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCitiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CityResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCitiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCitiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCopyConditionHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ConditionChangeResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCopyConditionHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCopyConditionHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListHoldsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]HoldResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListHoldsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListHoldsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelHoldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelHoldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelHoldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHoldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HoldResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHoldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHoldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLibrariesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LibraryPaginationResponse
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLibrariesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLibrariesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListNearbyLibrariesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LibraryPaginationResponse
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListNearbyLibrariesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListNearbyLibrariesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLibraryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LibraryResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLibraryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLibraryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LibraryBookPaginationResponse
+	JSON400      *ValidationErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TakeBookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BookCopyResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r TakeBookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TakeBookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BookCopyForPickupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *BookingResponse
+	JSON400      *ValidationErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r BookCopyForPickupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BookCopyForPickupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListBookCopiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BookCopyResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListBookCopiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListBookCopiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHoldQueueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HoldQueueResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHoldQueueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHoldQueueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PlaceHoldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HoldResponse
+	JSON400      *ValidationErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PlaceHoldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PlaceHoldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReleaseBookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BookCopyResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReleaseBookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReleaseBookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReturnBookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ViolationStatus
+	JSON400      *ValidationErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReturnBookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReturnBookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AdjustStockResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StockMovementResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AdjustStockResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AdjustStockResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WriteOffBookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BookCopyResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r WriteOffBookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WriteOffBookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetInventoryReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetInventoryReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetInventoryReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLibraryScheduleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LibraryScheduleResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLibraryScheduleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLibraryScheduleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckDueDateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DueDateResponse
+	JSON400      *ValidationErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckDueDateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckDueDateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddHolidayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *HolidayResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddHolidayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddHolidayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteHolidayResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteHolidayResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteHolidayResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetOpeningHoursResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LibraryScheduleResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetOpeningHoursResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetOpeningHoursResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLibraryStatisticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LibraryStatisticsResponse
+	JSON400      *ValidationErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLibraryStatisticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLibraryStatisticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListStockMovementsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StockMovementPaginationResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListStockMovementsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListStockMovementsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckStockConsistencyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *StockConsistencyResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckStockConsistencyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckStockConsistencyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListLibraryTransfersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferPaginationResponse
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListLibraryTransfersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListLibraryTransfersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateSeriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SeriesResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateSeriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateSeriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSeriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SeriesResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSeriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSeriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveSeriesVolumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveSeriesVolumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveSeriesVolumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetSeriesVolumeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SeriesResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetSeriesVolumeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetSeriesVolumeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListPopularBooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]PopularBookResponse
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListPopularBooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListPopularBooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListSuggestionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SuggestionResponse
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListSuggestionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListSuggestionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTransferResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TransferResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTransferResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelTransferResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DispatchTransferResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DispatchTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DispatchTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReceiveTransferResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ReceiveTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReceiveTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWorkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWorkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWorkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveWorkEditionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveWorkEditionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveWorkEditionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddWorkEditionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AddWorkEditionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddWorkEditionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// SearchBooksWithResponse request returning *SearchBooksResponse
+func (c *ClientWithResponses) SearchBooksWithResponse(ctx context.Context, params *SearchBooksParams, reqEditors ...RequestEditorFn) (*SearchBooksResponse, error) {
+	rsp, err := c.SearchBooks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchBooksResponse(rsp)
+}
+
+// GetBookByIsbnWithResponse request returning *GetBookByIsbnResponse
+func (c *ClientWithResponses) GetBookByIsbnWithResponse(ctx context.Context, isbn string, reqEditors ...RequestEditorFn) (*GetBookByIsbnResponse, error) {
+	rsp, err := c.GetBookByIsbn(ctx, isbn, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBookByIsbnResponse(rsp)
+}
+
+// GetBookWithResponse request returning *GetBookResponse
+func (c *ClientWithResponses) GetBookWithResponse(ctx context.Context, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBookResponse, error) {
+	rsp, err := c.GetBook(ctx, bookUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBookResponse(rsp)
+}
+
+// DeleteBookCoverWithResponse request returning *DeleteBookCoverResponse
+func (c *ClientWithResponses) DeleteBookCoverWithResponse(ctx context.Context, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteBookCoverResponse, error) {
+	rsp, err := c.DeleteBookCover(ctx, bookUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteBookCoverResponse(rsp)
+}
+
+// GetBookCoverWithResponse request returning *GetBookCoverResponse
+func (c *ClientWithResponses) GetBookCoverWithResponse(ctx context.Context, bookUid openapi_types.UUID, params *GetBookCoverParams, reqEditors ...RequestEditorFn) (*GetBookCoverResponse, error) {
+	rsp, err := c.GetBookCover(ctx, bookUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBookCoverResponse(rsp)
+}
+
+// SetBookCoverWithBodyWithResponse request with arbitrary body returning *SetBookCoverResponse
+func (c *ClientWithResponses) SetBookCoverWithBodyWithResponse(ctx context.Context, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetBookCoverResponse, error) {
+	rsp, err := c.SetBookCoverWithBody(ctx, bookUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetBookCoverResponse(rsp)
+}
+
+// ListCitiesWithResponse request returning *ListCitiesResponse
+func (c *ClientWithResponses) ListCitiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCitiesResponse, error) {
+	rsp, err := c.ListCities(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCitiesResponse(rsp)
+}
+
+// GetCopyConditionHistoryWithResponse request returning *GetCopyConditionHistoryResponse
+func (c *ClientWithResponses) GetCopyConditionHistoryWithResponse(ctx context.Context, copyUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetCopyConditionHistoryResponse, error) {
+	rsp, err := c.GetCopyConditionHistory(ctx, copyUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCopyConditionHistoryResponse(rsp)
+}
+
+// ListHoldsWithResponse request returning *ListHoldsResponse
+func (c *ClientWithResponses) ListHoldsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListHoldsResponse, error) {
+	rsp, err := c.ListHolds(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListHoldsResponse(rsp)
+}
+
+// CancelHoldWithResponse request returning *CancelHoldResponse
+func (c *ClientWithResponses) CancelHoldWithResponse(ctx context.Context, holdUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelHoldResponse, error) {
+	rsp, err := c.CancelHold(ctx, holdUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelHoldResponse(rsp)
+}
+
+// GetHoldWithResponse request returning *GetHoldResponse
+func (c *ClientWithResponses) GetHoldWithResponse(ctx context.Context, holdUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetHoldResponse, error) {
+	rsp, err := c.GetHold(ctx, holdUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHoldResponse(rsp)
+}
+
+// ListLibrariesWithResponse request returning *ListLibrariesResponse
+func (c *ClientWithResponses) ListLibrariesWithResponse(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*ListLibrariesResponse, error) {
+	rsp, err := c.ListLibraries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLibrariesResponse(rsp)
+}
+
+// ListNearbyLibrariesWithResponse request returning *ListNearbyLibrariesResponse
+func (c *ClientWithResponses) ListNearbyLibrariesWithResponse(ctx context.Context, params *ListNearbyLibrariesParams, reqEditors ...RequestEditorFn) (*ListNearbyLibrariesResponse, error) {
+	rsp, err := c.ListNearbyLibraries(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListNearbyLibrariesResponse(rsp)
+}
+
+// GetLibraryWithResponse request returning *GetLibraryResponse
+func (c *ClientWithResponses) GetLibraryWithResponse(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLibraryResponse, error) {
+	rsp, err := c.GetLibrary(ctx, libraryUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLibraryResponse(rsp)
+}
+
+// ListBooksWithResponse request returning *ListBooksResponse
+func (c *ClientWithResponses) ListBooksWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *ListBooksParams, reqEditors ...RequestEditorFn) (*ListBooksResponse, error) {
+	rsp, err := c.ListBooks(ctx, libraryUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBooksResponse(rsp)
+}
+
+// TakeBookWithResponse request returning *TakeBookResponse
+func (c *ClientWithResponses) TakeBookWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *TakeBookParams, reqEditors ...RequestEditorFn) (*TakeBookResponse, error) {
+	rsp, err := c.TakeBook(ctx, libraryUid, bookUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTakeBookResponse(rsp)
+}
+
+// BookCopyForPickupWithBodyWithResponse request with arbitrary body returning *BookCopyForPickupResponse
+func (c *ClientWithResponses) BookCopyForPickupWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *BookCopyForPickupParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BookCopyForPickupResponse, error) {
+	rsp, err := c.BookCopyForPickupWithBody(ctx, libraryUid, bookUid, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBookCopyForPickupResponse(rsp)
+}
+
+func (c *ClientWithResponses) BookCopyForPickupWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *BookCopyForPickupParams, body BookCopyForPickupJSONRequestBody, reqEditors ...RequestEditorFn) (*BookCopyForPickupResponse, error) {
+	rsp, err := c.BookCopyForPickup(ctx, libraryUid, bookUid, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBookCopyForPickupResponse(rsp)
+}
+
+// ListBookCopiesWithResponse request returning *ListBookCopiesResponse
+func (c *ClientWithResponses) ListBookCopiesWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*ListBookCopiesResponse, error) {
+	rsp, err := c.ListBookCopies(ctx, libraryUid, bookUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListBookCopiesResponse(rsp)
+}
+
+// GetHoldQueueWithResponse request returning *GetHoldQueueResponse
+func (c *ClientWithResponses) GetHoldQueueWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetHoldQueueResponse, error) {
+	rsp, err := c.GetHoldQueue(ctx, libraryUid, bookUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHoldQueueResponse(rsp)
+}
+
+// PlaceHoldWithBodyWithResponse request with arbitrary body returning *PlaceHoldResponse
+func (c *ClientWithResponses) PlaceHoldWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PlaceHoldResponse, error) {
+	rsp, err := c.PlaceHoldWithBody(ctx, libraryUid, bookUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePlaceHoldResponse(rsp)
+}
+
+func (c *ClientWithResponses) PlaceHoldWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body PlaceHoldJSONRequestBody, reqEditors ...RequestEditorFn) (*PlaceHoldResponse, error) {
+	rsp, err := c.PlaceHold(ctx, libraryUid, bookUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePlaceHoldResponse(rsp)
+}
+
+// ReleaseBookWithResponse request returning *ReleaseBookResponse
+func (c *ClientWithResponses) ReleaseBookWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReleaseBookParams, reqEditors ...RequestEditorFn) (*ReleaseBookResponse, error) {
+	rsp, err := c.ReleaseBook(ctx, libraryUid, bookUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReleaseBookResponse(rsp)
+}
+
+// ReturnBookWithBodyWithResponse request with arbitrary body returning *ReturnBookResponse
+func (c *ClientWithResponses) ReturnBookWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReturnBookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReturnBookResponse, error) {
+	rsp, err := c.ReturnBookWithBody(ctx, libraryUid, bookUid, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReturnBookResponse(rsp)
+}
+
+func (c *ClientWithResponses) ReturnBookWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *ReturnBookParams, body ReturnBookJSONRequestBody, reqEditors ...RequestEditorFn) (*ReturnBookResponse, error) {
+	rsp, err := c.ReturnBook(ctx, libraryUid, bookUid, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReturnBookResponse(rsp)
+}
+
+// AdjustStockWithBodyWithResponse request with arbitrary body returning *AdjustStockResponse
+func (c *ClientWithResponses) AdjustStockWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AdjustStockResponse, error) {
+	rsp, err := c.AdjustStockWithBody(ctx, libraryUid, bookUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdjustStockResponse(rsp)
+}
+
+func (c *ClientWithResponses) AdjustStockWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body AdjustStockJSONRequestBody, reqEditors ...RequestEditorFn) (*AdjustStockResponse, error) {
+	rsp, err := c.AdjustStock(ctx, libraryUid, bookUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAdjustStockResponse(rsp)
+}
+
+// WriteOffBookWithBodyWithResponse request with arbitrary body returning *WriteOffBookResponse
+func (c *ClientWithResponses) WriteOffBookWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *WriteOffBookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteOffBookResponse, error) {
+	rsp, err := c.WriteOffBookWithBody(ctx, libraryUid, bookUid, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWriteOffBookResponse(rsp)
+}
+
+func (c *ClientWithResponses) WriteOffBookWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params *WriteOffBookParams, body WriteOffBookJSONRequestBody, reqEditors ...RequestEditorFn) (*WriteOffBookResponse, error) {
+	rsp, err := c.WriteOffBook(ctx, libraryUid, bookUid, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWriteOffBookResponse(rsp)
+}
+
+// GetInventoryReportWithResponse request returning *GetInventoryReportResponse
+func (c *ClientWithResponses) GetInventoryReportWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *GetInventoryReportParams, reqEditors ...RequestEditorFn) (*GetInventoryReportResponse, error) {
+	rsp, err := c.GetInventoryReport(ctx, libraryUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetInventoryReportResponse(rsp)
+}
+
+// GetLibraryScheduleWithResponse request returning *GetLibraryScheduleResponse
+func (c *ClientWithResponses) GetLibraryScheduleWithResponse(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLibraryScheduleResponse, error) {
+	rsp, err := c.GetLibrarySchedule(ctx, libraryUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLibraryScheduleResponse(rsp)
+}
+
+// CheckDueDateWithResponse request returning *CheckDueDateResponse
+func (c *ClientWithResponses) CheckDueDateWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *CheckDueDateParams, reqEditors ...RequestEditorFn) (*CheckDueDateResponse, error) {
+	rsp, err := c.CheckDueDate(ctx, libraryUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckDueDateResponse(rsp)
+}
+
+// AddHolidayWithBodyWithResponse request with arbitrary body returning *AddHolidayResponse
+func (c *ClientWithResponses) AddHolidayWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AddHolidayResponse, error) {
+	rsp, err := c.AddHolidayWithBody(ctx, libraryUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddHolidayResponse(rsp)
+}
+
+func (c *ClientWithResponses) AddHolidayWithResponse(ctx context.Context, libraryUid openapi_types.UUID, body AddHolidayJSONRequestBody, reqEditors ...RequestEditorFn) (*AddHolidayResponse, error) {
+	rsp, err := c.AddHoliday(ctx, libraryUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddHolidayResponse(rsp)
+}
+
+// DeleteHolidayWithResponse request returning *DeleteHolidayResponse
+func (c *ClientWithResponses) DeleteHolidayWithResponse(ctx context.Context, libraryUid openapi_types.UUID, date string, reqEditors ...RequestEditorFn) (*DeleteHolidayResponse, error) {
+	rsp, err := c.DeleteHoliday(ctx, libraryUid, date, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteHolidayResponse(rsp)
+}
+
+// SetOpeningHoursWithBodyWithResponse request with arbitrary body returning *SetOpeningHoursResponse
+func (c *ClientWithResponses) SetOpeningHoursWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetOpeningHoursResponse, error) {
+	rsp, err := c.SetOpeningHoursWithBody(ctx, libraryUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetOpeningHoursResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetOpeningHoursWithResponse(ctx context.Context, libraryUid openapi_types.UUID, body SetOpeningHoursJSONRequestBody, reqEditors ...RequestEditorFn) (*SetOpeningHoursResponse, error) {
+	rsp, err := c.SetOpeningHours(ctx, libraryUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetOpeningHoursResponse(rsp)
+}
+
+// GetLibraryStatisticsWithResponse request returning *GetLibraryStatisticsResponse
+func (c *ClientWithResponses) GetLibraryStatisticsWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *GetLibraryStatisticsParams, reqEditors ...RequestEditorFn) (*GetLibraryStatisticsResponse, error) {
+	rsp, err := c.GetLibraryStatistics(ctx, libraryUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLibraryStatisticsResponse(rsp)
+}
+
+// ListStockMovementsWithResponse request returning *ListStockMovementsResponse
+func (c *ClientWithResponses) ListStockMovementsWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *ListStockMovementsParams, reqEditors ...RequestEditorFn) (*ListStockMovementsResponse, error) {
+	rsp, err := c.ListStockMovements(ctx, libraryUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListStockMovementsResponse(rsp)
+}
+
+// CheckStockConsistencyWithResponse request returning *CheckStockConsistencyResponse
+func (c *ClientWithResponses) CheckStockConsistencyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CheckStockConsistencyResponse, error) {
+	rsp, err := c.CheckStockConsistency(ctx, libraryUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckStockConsistencyResponse(rsp)
+}
+
+// ListLibraryTransfersWithResponse request returning *ListLibraryTransfersResponse
+func (c *ClientWithResponses) ListLibraryTransfersWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *ListLibraryTransfersParams, reqEditors ...RequestEditorFn) (*ListLibraryTransfersResponse, error) {
+	rsp, err := c.ListLibraryTransfers(ctx, libraryUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListLibraryTransfersResponse(rsp)
+}
+
+// CreateSeriesWithBodyWithResponse request with arbitrary body returning *CreateSeriesResponse
+func (c *ClientWithResponses) CreateSeriesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateSeriesResponse, error) {
+	rsp, err := c.CreateSeriesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSeriesResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateSeriesWithResponse(ctx context.Context, body CreateSeriesJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSeriesResponse, error) {
+	rsp, err := c.CreateSeries(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSeriesResponse(rsp)
+}
+
+// GetSeriesWithResponse request returning *GetSeriesResponse
+func (c *ClientWithResponses) GetSeriesWithResponse(ctx context.Context, seriesUid openapi_types.UUID, params *GetSeriesParams, reqEditors ...RequestEditorFn) (*GetSeriesResponse, error) {
+	rsp, err := c.GetSeries(ctx, seriesUid, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSeriesResponse(rsp)
+}
+
+// RemoveSeriesVolumeWithResponse request returning *RemoveSeriesVolumeResponse
+func (c *ClientWithResponses) RemoveSeriesVolumeWithResponse(ctx context.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*RemoveSeriesVolumeResponse, error) {
+	rsp, err := c.RemoveSeriesVolume(ctx, seriesUid, bookUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveSeriesVolumeResponse(rsp)
+}
+
+// SetSeriesVolumeWithBodyWithResponse request with arbitrary body returning *SetSeriesVolumeResponse
+func (c *ClientWithResponses) SetSeriesVolumeWithBodyWithResponse(ctx context.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetSeriesVolumeResponse, error) {
+	rsp, err := c.SetSeriesVolumeWithBody(ctx, seriesUid, bookUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetSeriesVolumeResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetSeriesVolumeWithResponse(ctx context.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID, body SetSeriesVolumeJSONRequestBody, reqEditors ...RequestEditorFn) (*SetSeriesVolumeResponse, error) {
+	rsp, err := c.SetSeriesVolume(ctx, seriesUid, bookUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetSeriesVolumeResponse(rsp)
+}
+
+// ListPopularBooksWithResponse request returning *ListPopularBooksResponse
+func (c *ClientWithResponses) ListPopularBooksWithResponse(ctx context.Context, params *ListPopularBooksParams, reqEditors ...RequestEditorFn) (*ListPopularBooksResponse, error) {
+	rsp, err := c.ListPopularBooks(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListPopularBooksResponse(rsp)
+}
+
+// ListSuggestionsWithResponse request returning *ListSuggestionsResponse
+func (c *ClientWithResponses) ListSuggestionsWithResponse(ctx context.Context, params *ListSuggestionsParams, reqEditors ...RequestEditorFn) (*ListSuggestionsResponse, error) {
+	rsp, err := c.ListSuggestions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListSuggestionsResponse(rsp)
+}
+
+// CreateTransferWithBodyWithResponse request with arbitrary body returning *CreateTransferResponse
+func (c *ClientWithResponses) CreateTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTransferResponse, error) {
+	rsp, err := c.CreateTransferWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransferResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTransferWithResponse(ctx context.Context, body CreateTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTransferResponse, error) {
+	rsp, err := c.CreateTransfer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTransferResponse(rsp)
+}
+
+// GetTransferWithResponse request returning *GetTransferResponse
+func (c *ClientWithResponses) GetTransferWithResponse(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetTransferResponse, error) {
+	rsp, err := c.GetTransfer(ctx, transferUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTransferResponse(rsp)
+}
+
+// CancelTransferWithResponse request returning *CancelTransferResponse
+func (c *ClientWithResponses) CancelTransferWithResponse(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelTransferResponse, error) {
+	rsp, err := c.CancelTransfer(ctx, transferUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelTransferResponse(rsp)
+}
+
+// DispatchTransferWithResponse request returning *DispatchTransferResponse
+func (c *ClientWithResponses) DispatchTransferWithResponse(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*DispatchTransferResponse, error) {
+	rsp, err := c.DispatchTransfer(ctx, transferUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDispatchTransferResponse(rsp)
+}
+
+// ReceiveTransferWithResponse request returning *ReceiveTransferResponse
+func (c *ClientWithResponses) ReceiveTransferWithResponse(ctx context.Context, transferUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*ReceiveTransferResponse, error) {
+	rsp, err := c.ReceiveTransfer(ctx, transferUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReceiveTransferResponse(rsp)
+}
+
+// GetWorkWithResponse request returning *GetWorkResponse
+func (c *ClientWithResponses) GetWorkWithResponse(ctx context.Context, workUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetWorkResponse, error) {
+	rsp, err := c.GetWork(ctx, workUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWorkResponse(rsp)
+}
+
+// RemoveWorkEditionWithResponse request returning *RemoveWorkEditionResponse
+func (c *ClientWithResponses) RemoveWorkEditionWithResponse(ctx context.Context, workUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*RemoveWorkEditionResponse, error) {
+	rsp, err := c.RemoveWorkEdition(ctx, workUid, bookUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveWorkEditionResponse(rsp)
+}
+
+// AddWorkEditionWithResponse request returning *AddWorkEditionResponse
+func (c *ClientWithResponses) AddWorkEditionWithResponse(ctx context.Context, workUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*AddWorkEditionResponse, error) {
+	rsp, err := c.AddWorkEdition(ctx, workUid, bookUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddWorkEditionResponse(rsp)
+}
+
+// HealthWithResponse request returning *HealthResponse
+func (c *ClientWithResponses) HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResponse, error) {
+	rsp, err := c.Health(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthResponse(rsp)
+}
+
+// ParseSearchBooksResponse parses an HTTP response from a SearchBooksWithResponse call
+func ParseSearchBooksResponse(rsp *http.Response) (*SearchBooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchBooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkSearchPaginationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBookByIsbnResponse parses an HTTP response from a GetBookByIsbnWithResponse call
+func ParseGetBookByIsbnResponse(rsp *http.Response) (*GetBookByIsbnResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBookByIsbnResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BookInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBookResponse parses an HTTP response from a GetBookWithResponse call
+func ParseGetBookResponse(rsp *http.Response) (*GetBookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BookInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteBookCoverResponse parses an HTTP response from a DeleteBookCoverWithResponse call
+func ParseDeleteBookCoverResponse(rsp *http.Response) (*DeleteBookCoverResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteBookCoverResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBookCoverResponse parses an HTTP response from a GetBookCoverWithResponse call
+func ParseGetBookCoverResponse(rsp *http.Response) (*GetBookCoverResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBookCoverResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetBookCoverResponse parses an HTTP response from a SetBookCoverWithResponse call
+func ParseSetBookCoverResponse(rsp *http.Response) (*SetBookCoverResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetBookCoverResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BookInfo
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON415 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCitiesResponse parses an HTTP response from a ListCitiesWithResponse call
+func ParseListCitiesResponse(rsp *http.Response) (*ListCitiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCitiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CityResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCopyConditionHistoryResponse parses an HTTP response from a GetCopyConditionHistoryWithResponse call
+func ParseGetCopyConditionHistoryResponse(rsp *http.Response) (*GetCopyConditionHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCopyConditionHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ConditionChangeResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListHoldsResponse parses an HTTP response from a ListHoldsWithResponse call
+func ParseListHoldsResponse(rsp *http.Response) (*ListHoldsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListHoldsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []HoldResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelHoldResponse parses an HTTP response from a CancelHoldWithResponse call
+func ParseCancelHoldResponse(rsp *http.Response) (*CancelHoldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelHoldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHoldResponse parses an HTTP response from a GetHoldWithResponse call
+func ParseGetHoldResponse(rsp *http.Response) (*GetHoldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHoldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HoldResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListLibrariesResponse parses an HTTP response from a ListLibrariesWithResponse call
+func ParseListLibrariesResponse(rsp *http.Response) (*ListLibrariesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListLibrariesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LibraryPaginationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListNearbyLibrariesResponse parses an HTTP response from a ListNearbyLibrariesWithResponse call
+func ParseListNearbyLibrariesResponse(rsp *http.Response) (*ListNearbyLibrariesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListNearbyLibrariesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LibraryPaginationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLibraryResponse parses an HTTP response from a GetLibraryWithResponse call
+func ParseGetLibraryResponse(rsp *http.Response) (*GetLibraryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLibraryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LibraryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBooksResponse parses an HTTP response from a ListBooksWithResponse call
+func ParseListBooksResponse(rsp *http.Response) (*ListBooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LibraryBookPaginationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseTakeBookResponse parses an HTTP response from a TakeBookWithResponse call
+func ParseTakeBookResponse(rsp *http.Response) (*TakeBookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TakeBookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BookCopyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseBookCopyForPickupResponse parses an HTTP response from a BookCopyForPickupWithResponse call
+func ParseBookCopyForPickupResponse(rsp *http.Response) (*BookCopyForPickupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BookCopyForPickupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest BookingResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListBookCopiesResponse parses an HTTP response from a ListBookCopiesWithResponse call
+func ParseListBookCopiesResponse(rsp *http.Response) (*ListBookCopiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListBookCopiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BookCopyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHoldQueueResponse parses an HTTP response from a GetHoldQueueWithResponse call
+func ParseGetHoldQueueResponse(rsp *http.Response) (*GetHoldQueueResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHoldQueueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HoldQueueResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePlaceHoldResponse parses an HTTP response from a PlaceHoldWithResponse call
+func ParsePlaceHoldResponse(rsp *http.Response) (*PlaceHoldResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PlaceHoldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HoldResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) ReturnBookWithBody(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReturnBookRequestWithBody(c.Server, libraryUid, bookUid, contentType, body)
+// ParseReleaseBookResponse parses an HTTP response from a ReleaseBookWithResponse call
+func ParseReleaseBookResponse(rsp *http.Response) (*ReleaseBookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) ReturnBook(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body ReturnBookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewReturnBookRequest(c.Server, libraryUid, bookUid, body)
-	if err != nil {
-		return nil, err
+	response := &ReleaseBookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BookCopyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) Health(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHealthRequest(c.Server)
+// ParseReturnBookResponse parses an HTTP response from a ReturnBookWithResponse call
+func ParseReturnBookResponse(rsp *http.Response) (*ReturnBookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &ReturnBookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
-}
 
-// NewGetBookRequest generates requests for GetBook
-func NewGetBookRequest(server string, bookUid openapi_types.UUID) (*http.Request, error) {
-	var err error
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ViolationStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	var pathParam0 string
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-	operationPath := fmt.Sprintf("/api/v1/books/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseAdjustStockResponse parses an HTTP response from a AdjustStockWithResponse call
+func ParseAdjustStockResponse(rsp *http.Response) (*AdjustStockResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	response := &AdjustStockResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	return req, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockMovementResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// NewListLibrariesRequest generates requests for ListLibraries
-func NewListLibrariesRequest(server string, params *ListLibrariesParams) (*http.Request, error) {
-	var err error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-	operationPath := fmt.Sprintf("/api/v1/libraries")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseWriteOffBookResponse parses an HTTP response from a WriteOffBookWithResponse call
+func ParseWriteOffBookResponse(rsp *http.Response) (*WriteOffBookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Page != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	response := &WriteOffBookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BookCopyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
 
-		if params.Size != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON400 = &dest
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "city", runtime.ParamLocationQuery, params.City); err != nil {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON404 = &dest
 
-		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	return response, nil
+}
+
+// ParseGetInventoryReportResponse parses an HTTP response from a GetInventoryReportWithResponse call
+func ParseGetInventoryReportResponse(rsp *http.Response) (*GetInventoryReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	response := &GetInventoryReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-// NewGetLibraryRequest generates requests for GetLibrary
-func NewGetLibraryRequest(server string, libraryUid openapi_types.UUID) (*http.Request, error) {
-	var err error
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	var pathParam0 string
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
-	if err != nil {
-		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	return response, nil
+}
+
+// ParseGetLibraryScheduleResponse parses an HTTP response from a GetLibraryScheduleWithResponse call
+func ParseGetLibraryScheduleResponse(rsp *http.Response) (*GetLibraryScheduleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/libraries/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &GetLibraryScheduleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LibraryScheduleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	return response, nil
+}
+
+// ParseCheckDueDateResponse parses an HTTP response from a CheckDueDateWithResponse call
+func ParseCheckDueDateResponse(rsp *http.Response) (*CheckDueDateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	response := &CheckDueDateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-// NewListBooksRequest generates requests for ListBooks
-func NewListBooksRequest(server string, libraryUid openapi_types.UUID, params *ListBooksParams) (*http.Request, error) {
-	var err error
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DueDateResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	var pathParam0 string
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	return response, nil
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// ParseAddHolidayResponse parses an HTTP response from a AddHolidayWithResponse call
+func ParseAddHolidayResponse(rsp *http.Response) (*AddHolidayResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Page != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	response := &AddHolidayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest HolidayResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON201 = &dest
 
-		if params.Size != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON400 = &dest
 
-		if params.ShowAll != nil {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "showAll", runtime.ParamLocationQuery, *params.ShowAll); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON409 = &dest
 
-		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	return response, nil
+}
+
+// ParseDeleteHolidayResponse parses an HTTP response from a DeleteHolidayWithResponse call
+func ParseDeleteHolidayResponse(rsp *http.Response) (*DeleteHolidayResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	response := &DeleteHolidayResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-// NewTakeBookRequest generates requests for TakeBook
-func NewTakeBookRequest(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) (*http.Request, error) {
-	var err error
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	var pathParam0 string
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
-	if err != nil {
-		return nil, err
 	}
 
-	var pathParam1 string
+	return response, nil
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+// ParseSetOpeningHoursResponse parses an HTTP response from a SetOpeningHoursWithResponse call
+func ParseSetOpeningHoursResponse(rsp *http.Response) (*SetOpeningHoursResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	response := &SetOpeningHoursResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LibraryScheduleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
 	}
 
-	return req, nil
+	return response, nil
 }
 
-// NewReturnBookRequest calls the generic ReturnBook builder with application/json body
-func NewReturnBookRequest(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body ReturnBookJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// ParseGetLibraryStatisticsResponse parses an HTTP response from a GetLibraryStatisticsWithResponse call
+func ParseGetLibraryStatisticsResponse(rsp *http.Response) (*GetLibraryStatisticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewReturnBookRequestWithBody(server, libraryUid, bookUid, "application/json", bodyReader)
-}
 
-// NewReturnBookRequestWithBody generates requests for ReturnBook with any type of body
-func NewReturnBookRequestWithBody(server string, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	response := &GetLibraryStatisticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	var pathParam0 string
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LibraryStatisticsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "libraryUid", runtime.ParamLocationPath, libraryUid)
-	if err != nil {
-		return nil, err
 	}
 
-	var pathParam1 string
+	return response, nil
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bookUid", runtime.ParamLocationPath, bookUid)
+// ParseListStockMovementsResponse parses an HTTP response from a ListStockMovementsWithResponse call
+func ParseListStockMovementsResponse(rsp *http.Response) (*ListStockMovementsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	response := &ListStockMovementsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/libraries/%s/books/%s/return", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockMovementPaginationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseCheckStockConsistencyResponse parses an HTTP response from a CheckStockConsistencyWithResponse call
+func ParseCheckStockConsistencyResponse(rsp *http.Response) (*CheckStockConsistencyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
+	response := &CheckStockConsistencyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockConsistencyResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	return req, nil
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// NewHealthRequest generates requests for Health
-func NewHealthRequest(server string) (*http.Request, error) {
-	var err error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/manage/health")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	return response, nil
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// ParseListLibraryTransfersResponse parses an HTTP response from a ListLibraryTransfersWithResponse call
+func ParseListLibraryTransfersResponse(rsp *http.Response) (*ListLibraryTransfersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	response := &ListLibraryTransfersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	return req, nil
-}
-
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferPaginationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON400 = &dest
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return response, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// ParseCreateSeriesResponse parses an HTTP response from a CreateSeriesWithResponse call
+func ParseCreateSeriesResponse(rsp *http.Response) (*CreateSeriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	response := &CreateSeriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetBookWithResponse request
-	GetBookWithResponse(ctx context.Context, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBookResponse, error)
-
-	// ListLibrariesWithResponse request
-	ListLibrariesWithResponse(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*ListLibrariesResponse, error)
 
-	// GetLibraryWithResponse request
-	GetLibraryWithResponse(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLibraryResponse, error)
-
-	// ListBooksWithResponse request
-	ListBooksWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *ListBooksParams, reqEditors ...RequestEditorFn) (*ListBooksResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SeriesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-	// TakeBookWithResponse request
-	TakeBookWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*TakeBookResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-	// ReturnBookWithBodyWithResponse request with any body
-	ReturnBookWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReturnBookResponse, error)
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-	ReturnBookWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body ReturnBookJSONRequestBody, reqEditors ...RequestEditorFn) (*ReturnBookResponse, error)
+	}
 
-	// HealthWithResponse request
-	HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResponse, error)
+	return response, nil
 }
 
-type GetBookResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BookInfo
-}
+// ParseGetSeriesResponse parses an HTTP response from a GetSeriesWithResponse call
+func ParseGetSeriesResponse(rsp *http.Response) (*GetSeriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetBookResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetSeriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetBookResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SeriesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return 0
-}
 
-type ListLibrariesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LibraryPaginationResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListLibrariesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseRemoveSeriesVolumeResponse parses an HTTP response from a RemoveSeriesVolumeWithResponse call
+func ParseRemoveSeriesVolumeResponse(rsp *http.Response) (*RemoveSeriesVolumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListLibrariesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &RemoveSeriesVolumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetLibraryResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LibraryResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetLibraryResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetLibraryResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ListBooksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *LibraryBookPaginationResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListBooksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseSetSeriesVolumeResponse parses an HTTP response from a SetSeriesVolumeWithResponse call
+func ParseSetSeriesVolumeResponse(rsp *http.Response) (*SetSeriesVolumeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListBooksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &SetSeriesVolumeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type TakeBookResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON400      *ValidationErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SeriesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
-// Status returns HTTPResponse.Status
-func (r TakeBookResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TakeBookResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type ReturnBookResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ViolationStatus
-	JSON400      *ValidationErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ReturnBookResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListPopularBooksResponse parses an HTTP response from a ListPopularBooksWithResponse call
+func ParseListPopularBooksResponse(rsp *http.Response) (*ListPopularBooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ReturnBookResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListPopularBooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type HealthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []PopularBookResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r HealthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HealthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
+
+	return response, nil
 }
 
-// GetBookWithResponse request returning *GetBookResponse
-func (c *ClientWithResponses) GetBookWithResponse(ctx context.Context, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetBookResponse, error) {
-	rsp, err := c.GetBook(ctx, bookUid, reqEditors...)
+// ParseListSuggestionsResponse parses an HTTP response from a ListSuggestionsWithResponse call
+func ParseListSuggestionsResponse(rsp *http.Response) (*ListSuggestionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetBookResponse(rsp)
-}
 
-// ListLibrariesWithResponse request returning *ListLibrariesResponse
-func (c *ClientWithResponses) ListLibrariesWithResponse(ctx context.Context, params *ListLibrariesParams, reqEditors ...RequestEditorFn) (*ListLibrariesResponse, error) {
-	rsp, err := c.ListLibraries(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &ListSuggestionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListLibrariesResponse(rsp)
-}
 
-// GetLibraryWithResponse request returning *GetLibraryResponse
-func (c *ClientWithResponses) GetLibraryWithResponse(ctx context.Context, libraryUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetLibraryResponse, error) {
-	rsp, err := c.GetLibrary(ctx, libraryUid, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SuggestionResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParseGetLibraryResponse(rsp)
+
+	return response, nil
 }
 
-// ListBooksWithResponse request returning *ListBooksResponse
-func (c *ClientWithResponses) ListBooksWithResponse(ctx context.Context, libraryUid openapi_types.UUID, params *ListBooksParams, reqEditors ...RequestEditorFn) (*ListBooksResponse, error) {
-	rsp, err := c.ListBooks(ctx, libraryUid, params, reqEditors...)
+// ParseCreateTransferResponse parses an HTTP response from a CreateTransferWithResponse call
+func ParseCreateTransferResponse(rsp *http.Response) (*CreateTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListBooksResponse(rsp)
-}
 
-// TakeBookWithResponse request returning *TakeBookResponse
-func (c *ClientWithResponses) TakeBookWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*TakeBookResponse, error) {
-	rsp, err := c.TakeBook(ctx, libraryUid, bookUid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CreateTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseTakeBookResponse(rsp)
-}
 
-// ReturnBookWithBodyWithResponse request with arbitrary body returning *ReturnBookResponse
-func (c *ClientWithResponses) ReturnBookWithBodyWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReturnBookResponse, error) {
-	rsp, err := c.ReturnBookWithBody(ctx, libraryUid, bookUid, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TransferResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseReturnBookResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) ReturnBookWithResponse(ctx context.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, body ReturnBookJSONRequestBody, reqEditors ...RequestEditorFn) (*ReturnBookResponse, error) {
-	rsp, err := c.ReturnBook(ctx, libraryUid, bookUid, body, reqEditors...)
+// ParseGetTransferResponse parses an HTTP response from a GetTransferWithResponse call
+func ParseGetTransferResponse(rsp *http.Response) (*GetTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseReturnBookResponse(rsp)
-}
 
-// HealthWithResponse request returning *HealthResponse
-func (c *ClientWithResponses) HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResponse, error) {
-	rsp, err := c.Health(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseHealthResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
 }
 
-// ParseGetBookResponse parses an HTTP response from a GetBookWithResponse call
-func ParseGetBookResponse(rsp *http.Response) (*GetBookResponse, error) {
+// ParseCancelTransferResponse parses an HTTP response from a CancelTransferWithResponse call
+func ParseCancelTransferResponse(rsp *http.Response) (*CancelTransferResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetBookResponse{
+	response := &CancelTransferResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BookInfo
+		var dest TransferResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseListLibrariesResponse parses an HTTP response from a ListLibrariesWithResponse call
-func ParseListLibrariesResponse(rsp *http.Response) (*ListLibrariesResponse, error) {
+// ParseDispatchTransferResponse parses an HTTP response from a DispatchTransferWithResponse call
+func ParseDispatchTransferResponse(rsp *http.Response) (*DispatchTransferResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListLibrariesResponse{
+	response := &DispatchTransferResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LibraryPaginationResponse
+		var dest TransferResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetLibraryResponse parses an HTTP response from a GetLibraryWithResponse call
-func ParseGetLibraryResponse(rsp *http.Response) (*GetLibraryResponse, error) {
+// ParseReceiveTransferResponse parses an HTTP response from a ReceiveTransferWithResponse call
+func ParseReceiveTransferResponse(rsp *http.Response) (*ReceiveTransferResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetLibraryResponse{
+	response := &ReceiveTransferResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LibraryResponse
+		var dest TransferResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseListBooksResponse parses an HTTP response from a ListBooksWithResponse call
-func ParseListBooksResponse(rsp *http.Response) (*ListBooksResponse, error) {
+// ParseGetWorkResponse parses an HTTP response from a GetWorkWithResponse call
+func ParseGetWorkResponse(rsp *http.Response) (*GetWorkResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListBooksResponse{
+	response := &GetWorkResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest LibraryBookPaginationResponse
+		var dest WorkResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseTakeBookResponse parses an HTTP response from a TakeBookWithResponse call
-func ParseTakeBookResponse(rsp *http.Response) (*TakeBookResponse, error) {
+// ParseRemoveWorkEditionResponse parses an HTTP response from a RemoveWorkEditionWithResponse call
+func ParseRemoveWorkEditionResponse(rsp *http.Response) (*RemoveWorkEditionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &TakeBookResponse{
+	response := &RemoveWorkEditionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ValidationErrorResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseReturnBookResponse parses an HTTP response from a ReturnBookWithResponse call
-func ParseReturnBookResponse(rsp *http.Response) (*ReturnBookResponse, error) {
+// ParseAddWorkEditionResponse parses an HTTP response from a AddWorkEditionWithResponse call
+func ParseAddWorkEditionResponse(rsp *http.Response) (*AddWorkEditionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ReturnBookResponse{
+	response := &AddWorkEditionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ViolationStatus
+		var dest WorkResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ValidationErrorResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
 
 	}
 
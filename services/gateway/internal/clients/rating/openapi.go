@@ -4,6 +4,7 @@
 package rating
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -15,17 +16,56 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// ErrorDescription defines model for ErrorDescription.
+type ErrorDescription struct {
+	Error string `json:"error"`
+	Field string `json:"field"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	// Message Информация об ошибке
+	Message string `json:"message"`
+}
+
+// PenaltyRequest defines model for PenaltyRequest.
+type PenaltyRequest struct {
+	// Stars На сколько опустить рейтинг
+	Stars int `json:"stars"`
+
+	// Username Имя пользователя
+	Username string `json:"username"`
+}
+
 // Rating defines model for Rating.
 type Rating struct {
 	// Stars Количество здесь у пользователя
 	Stars int `json:"stars"`
 }
 
+// ValidationErrorResponse defines model for ValidationErrorResponse.
+type ValidationErrorResponse struct {
+	// Errors Массив полей с описанием ошибки
+	Errors []ErrorDescription `json:"errors"`
+
+	// Message Информация об ошибке
+	Message string `json:"message"`
+}
+
 // SaveViolationsParams defines parameters for SaveViolations.
 type SaveViolationsParams struct {
 	Count int `form:"count" json:"count"`
+
+	// Penalty Количество звезд, снимаемых дополнительно к нарушениям, например за списанные книги
+	Penalty *int `form:"penalty,omitempty" json:"penalty,omitempty"`
+
+	// Username Пользователь, нарушения которого учитываются, по умолчанию текущий. Другого пользователя может указать только сотрудник библиотеки
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
 }
 
+// PenalizeJSONRequestBody defines body for Penalize for application/json ContentType.
+type PenalizeJSONRequestBody = PenaltyRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -102,6 +142,11 @@ type ClientInterface interface {
 	// Get request
 	Get(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PenalizeWithBody request with any body
+	PenalizeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Penalize(ctx context.Context, body PenalizeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// SaveViolations request
 	SaveViolations(ctx context.Context, params *SaveViolationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -121,6 +166,30 @@ func (c *Client) Get(ctx context.Context, reqEditors ...RequestEditorFn) (*http.
 	return c.Client.Do(req)
 }
 
+func (c *Client) PenalizeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPenalizeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Penalize(ctx context.Context, body PenalizeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPenalizeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) SaveViolations(ctx context.Context, params *SaveViolationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewSaveViolationsRequest(c.Server, params)
 	if err != nil {
@@ -172,6 +241,46 @@ func NewGetRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewPenalizeRequest calls the generic Penalize builder with application/json body
+func NewPenalizeRequest(server string, body PenalizeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPenalizeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPenalizeRequestWithBody generates requests for Penalize with any type of body
+func NewPenalizeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/rating/penalty")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
 // NewSaveViolationsRequest generates requests for SaveViolations
 func NewSaveViolationsRequest(server string, params *SaveViolationsParams) (*http.Request, error) {
 	var err error
@@ -206,6 +315,38 @@ func NewSaveViolationsRequest(server string, params *SaveViolationsParams) (*htt
 			}
 		}
 
+		if params.Penalty != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "penalty", runtime.ParamLocationQuery, *params.Penalty); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -290,6 +431,11 @@ type ClientWithResponsesInterface interface {
 	// GetWithResponse request
 	GetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetResponse, error)
 
+	// PenalizeWithBodyWithResponse request with any body
+	PenalizeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PenalizeResponse, error)
+
+	PenalizeWithResponse(ctx context.Context, body PenalizeJSONRequestBody, reqEditors ...RequestEditorFn) (*PenalizeResponse, error)
+
 	// SaveViolationsWithResponse request
 	SaveViolationsWithResponse(ctx context.Context, params *SaveViolationsParams, reqEditors ...RequestEditorFn) (*SaveViolationsResponse, error)
 
@@ -319,9 +465,33 @@ func (r GetResponse) StatusCode() int {
 	return 0
 }
 
+type PenalizeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PenalizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PenalizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type SaveViolationsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
+	JSON403      *ErrorResponse
 }
 
 // Status returns HTTPResponse.Status
@@ -370,6 +540,23 @@ func (c *ClientWithResponses) GetWithResponse(ctx context.Context, reqEditors ..
 	return ParseGetResponse(rsp)
 }
 
+// PenalizeWithBodyWithResponse request with arbitrary body returning *PenalizeResponse
+func (c *ClientWithResponses) PenalizeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PenalizeResponse, error) {
+	rsp, err := c.PenalizeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePenalizeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PenalizeWithResponse(ctx context.Context, body PenalizeJSONRequestBody, reqEditors ...RequestEditorFn) (*PenalizeResponse, error) {
+	rsp, err := c.Penalize(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePenalizeResponse(rsp)
+}
+
 // SaveViolationsWithResponse request returning *SaveViolationsResponse
 func (c *ClientWithResponses) SaveViolationsWithResponse(ctx context.Context, params *SaveViolationsParams, reqEditors ...RequestEditorFn) (*SaveViolationsResponse, error) {
 	rsp, err := c.SaveViolations(ctx, params, reqEditors...)
@@ -414,6 +601,39 @@ func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
 	return response, nil
 }
 
+// ParsePenalizeResponse parses an HTTP response from a PenalizeWithResponse call
+func ParsePenalizeResponse(rsp *http.Response) (*PenalizeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PenalizeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseSaveViolationsResponse parses an HTTP response from a SaveViolationsWithResponse call
 func ParseSaveViolationsResponse(rsp *http.Response) (*SaveViolationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -427,6 +647,16 @@ func ParseSaveViolationsResponse(rsp *http.Response) (*SaveViolationsResponse, e
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
 	return response, nil
 }
 
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -19,17 +20,76 @@ import (
 
 // Defines values for BookReservationResponseStatus.
 const (
-	BookReservationResponseStatusEXPIRED  BookReservationResponseStatus = "EXPIRED"
-	BookReservationResponseStatusRENTED   BookReservationResponseStatus = "RENTED"
-	BookReservationResponseStatusRETURNED BookReservationResponseStatus = "RETURNED"
+	BookReservationResponseStatusCANCELLED BookReservationResponseStatus = "CANCELLED"
+	BookReservationResponseStatusDAMAGED   BookReservationResponseStatus = "DAMAGED"
+	BookReservationResponseStatusEXPIRED   BookReservationResponseStatus = "EXPIRED"
+	BookReservationResponseStatusLOST      BookReservationResponseStatus = "LOST"
+	BookReservationResponseStatusOVERDUE   BookReservationResponseStatus = "OVERDUE"
+	BookReservationResponseStatusPENDING   BookReservationResponseStatus = "PENDING"
+	BookReservationResponseStatusRENTED    BookReservationResponseStatus = "RENTED"
+	BookReservationResponseStatusRETURNED  BookReservationResponseStatus = "RETURNED"
+)
+
+// Defines values for FineResponseStatus.
+const (
+	FineResponseStatusPAID   FineResponseStatus = "PAID"
+	FineResponseStatusUNPAID FineResponseStatus = "UNPAID"
+	FineResponseStatusWAIVED FineResponseStatus = "WAIVED"
 )
 
 // Defines values for TakeBookResponseStatus.
 const (
-	TakeBookResponseStatusEXPIRED  TakeBookResponseStatus = "EXPIRED"
-	TakeBookResponseStatusLOST     TakeBookResponseStatus = "LOST"
-	TakeBookResponseStatusRENTED   TakeBookResponseStatus = "RENTED"
-	TakeBookResponseStatusRETURNED TakeBookResponseStatus = "RETURNED"
+	TakeBookResponseStatusCANCELLED TakeBookResponseStatus = "CANCELLED"
+	TakeBookResponseStatusDAMAGED   TakeBookResponseStatus = "DAMAGED"
+	TakeBookResponseStatusEXPIRED   TakeBookResponseStatus = "EXPIRED"
+	TakeBookResponseStatusLOST      TakeBookResponseStatus = "LOST"
+	TakeBookResponseStatusOVERDUE   TakeBookResponseStatus = "OVERDUE"
+	TakeBookResponseStatusPENDING   TakeBookResponseStatus = "PENDING"
+	TakeBookResponseStatusRENTED    TakeBookResponseStatus = "RENTED"
+	TakeBookResponseStatusRETURNED  TakeBookResponseStatus = "RETURNED"
+)
+
+// Defines values for WriteOffRequestStatus.
+const (
+	WriteOffRequestStatusDAMAGED WriteOffRequestStatus = "DAMAGED"
+	WriteOffRequestStatusLOST    WriteOffRequestStatus = "LOST"
+)
+
+// Defines values for WriteOffReservationResponseStatus.
+const (
+	WriteOffReservationResponseStatusDAMAGED WriteOffReservationResponseStatus = "DAMAGED"
+	WriteOffReservationResponseStatusLOST    WriteOffReservationResponseStatus = "LOST"
+)
+
+// Defines values for ListFinesParamsStatus.
+const (
+	ListFinesParamsStatusPAID   ListFinesParamsStatus = "PAID"
+	ListFinesParamsStatusUNPAID ListFinesParamsStatus = "UNPAID"
+	ListFinesParamsStatusWAIVED ListFinesParamsStatus = "WAIVED"
+)
+
+// Defines values for ListParamsStatus.
+const (
+	CANCELLED ListParamsStatus = "CANCELLED"
+	DAMAGED   ListParamsStatus = "DAMAGED"
+	EXPIRED   ListParamsStatus = "EXPIRED"
+	LOST      ListParamsStatus = "LOST"
+	OVERDUE   ListParamsStatus = "OVERDUE"
+	PENDING   ListParamsStatus = "PENDING"
+	RENTED    ListParamsStatus = "RENTED"
+	RETURNED  ListParamsStatus = "RETURNED"
+)
+
+// Defines values for ListParamsSort.
+const (
+	StartDate ListParamsSort = "startDate"
+	TillDate  ListParamsSort = "tillDate"
+)
+
+// Defines values for ListParamsOrder.
+const (
+	Asc  ListParamsOrder = "asc"
+	Desc ListParamsOrder = "desc"
 )
 
 // BookReservationResponse defines model for BookReservationResponse.
@@ -40,6 +100,9 @@ type BookReservationResponse struct {
 	// LibraryUid UUID библиотеки
 	LibraryUid openapi_types.UUID `json:"libraryUid"`
 
+	// PickupUntil До какого времени нужно забрать заранее забронированную книгу
+	PickupUntil *time.Time `json:"pickupUntil,omitempty"`
+
 	// ReservationUid UUID бронирования
 	ReservationUid openapi_types.UUID `json:"reservationUid"`
 
@@ -51,6 +114,9 @@ type BookReservationResponse struct {
 
 	// TillDate Дата окончания бронирования
 	TillDate string `json:"tillDate"`
+
+	// Username Имя пользователя, взявшего книгу
+	Username string `json:"username"`
 }
 
 // BookReservationResponseStatus Статус бронирования книги
@@ -68,18 +134,148 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// FineResponse defines model for FineResponse.
+type FineResponse struct {
+	// Amount Сумма штрафа в копейках
+	Amount int `json:"amount"`
+
+	// ClosedAt Время оплаты или списания штрафа
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+
+	// ClosedBy Сотрудник, принявший оплату или списавший штраф
+	ClosedBy *string `json:"closedBy,omitempty"`
+
+	// Comment Причина списания штрафа
+	Comment *string `json:"comment,omitempty"`
+
+	// CreatedAt Время начисления штрафа
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DaysLate На сколько дней просрочен возврат
+	DaysLate int `json:"daysLate"`
+
+	// FineUid UUID штрафа
+	FineUid openapi_types.UUID `json:"fineUid"`
+
+	// ReservationUid UUID бронирования
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+
+	// Status Статус штрафа
+	Status FineResponseStatus `json:"status"`
+
+	// Username Имя пользователя
+	Username string `json:"username"`
+}
+
+// FineResponseStatus Статус штрафа
+type FineResponseStatus string
+
+// FineTariffRequest defines model for FineTariffRequest.
+type FineTariffRequest struct {
+	// DailyRate Штраф за день просрочки в копейках
+	DailyRate int `json:"dailyRate"`
+
+	// Genre Жанр книги, если тариф действует только для него
+	Genre *string `json:"genre,omitempty"`
+
+	// LibraryUid UUID библиотеки, если тариф действует только в ней
+	LibraryUid *openapi_types.UUID `json:"libraryUid,omitempty"`
+
+	// MaxAmount Максимальная сумма штрафа за одно бронирование в копейках
+	MaxAmount *int `json:"maxAmount,omitempty"`
+}
+
+// FineTariffResponse defines model for FineTariffResponse.
+type FineTariffResponse struct {
+	// DailyRate Штраф за день просрочки в копейках
+	DailyRate int `json:"dailyRate"`
+
+	// Genre Жанр книги, отсутствует у тарифа для всех жанров
+	Genre *string `json:"genre,omitempty"`
+
+	// LibraryUid UUID библиотеки, отсутствует у тарифа для всех библиотек
+	LibraryUid *openapi_types.UUID `json:"libraryUid,omitempty"`
+
+	// MaxAmount Максимальная сумма штрафа за одно бронирование в копейках
+	MaxAmount *int `json:"maxAmount,omitempty"`
+
+	// UpdatedAt Время изменения тарифа
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// UpdatedBy Сотрудник, изменивший тариф
+	UpdatedBy string `json:"updatedBy"`
+}
+
 // FinishReservationRequest defines model for FinishReservationRequest.
 type FinishReservationRequest struct {
-	// Date Дата возврата
-	Date string `json:"date"`
+	// Date Дата возврата, по умолчанию текущая. Указывать может только сотрудник библиотеки
+	Date *string `json:"date,omitempty"`
+
+	// Genre Жанр книги для выбора тарифа штрафа за просрочку
+	Genre *string `json:"genre,omitempty"`
 }
 
 // FinishReservationResponse defines model for FinishReservationResponse.
 type FinishReservationResponse struct {
+	Fine *FineResponse `json:"fine,omitempty"`
+
 	// Violation Нарушены ли правила окончания брони
 	Violation bool `json:"violation"`
 }
 
+// PenaltiesResponse defines model for PenaltiesResponse.
+type PenaltiesResponse struct {
+	// ClaimUid UUID для подтверждения учета штрафов
+	ClaimUid openapi_types.UUID `json:"claimUid"`
+
+	// Count Количество нарушений, например просроченных книг
+	Count int `json:"count"`
+
+	// Stars Количество звезд рейтинга, снимаемых за списанные книги
+	Stars int `json:"stars"`
+}
+
+// RenewRequest defines model for RenewRequest.
+type RenewRequest struct {
+	// TillDate Новая дата окончания бронирования
+	TillDate string `json:"tillDate"`
+}
+
+// ReservationEventResponse defines model for ReservationEventResponse.
+type ReservationEventResponse struct {
+	// Actor Пользователь, изменивший бронирование, или system для фоновых задач
+	Actor string `json:"actor"`
+
+	// Comment Комментарий к событию
+	Comment *string `json:"comment,omitempty"`
+
+	// CreatedAt Время события
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FromStatus Статус до изменения, отсутствует для создания бронирования
+	FromStatus *string `json:"fromStatus,omitempty"`
+
+	// ToStatus Статус после изменения
+	ToStatus string `json:"toStatus"`
+}
+
+// ReservationPaginationResponse defines model for ReservationPaginationResponse.
+type ReservationPaginationResponse struct {
+	Items []BookReservationResponse `json:"items"`
+
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// Page Номер страницы
+	Page *int `json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// TotalElements Общее количество элементов
+	TotalElements int `json:"totalElements"`
+}
+
 // TakeBookRequest defines model for TakeBookRequest.
 type TakeBookRequest struct {
 	// BookUid UUID книги
@@ -88,6 +284,12 @@ type TakeBookRequest struct {
 	// LibraryUid UUID библиотеки
 	LibraryUid openapi_types.UUID `json:"libraryUid"`
 
+	// MaxActive Сколько незакрытых бронирований может быть у пользователя до нового, обычно его рейтинг. Значение не проверяется сервисом, его передает gateway по рейтингу пользователя, поэтому сервис не должен быть доступен клиентам напрямую
+	MaxActive int `json:"maxActive"`
+
+	// PickupDate Дата, когда пользователь заберет книгу. Без нее книга выдается сразу
+	PickupDate *string `json:"pickupDate,omitempty"`
+
 	// TillDate Дата окончания бронирования
 	TillDate string `json:"tillDate"`
 }
@@ -100,6 +302,9 @@ type TakeBookResponse struct {
 	// LibraryUid UUID библиотеки
 	LibraryUid openapi_types.UUID `json:"libraryUid"`
 
+	// PickupUntil До какого времени нужно забрать заранее забронированную книгу
+	PickupUntil *time.Time `json:"pickupUntil,omitempty"`
+
 	// ReservationUid UUID бронирования
 	ReservationUid openapi_types.UUID `json:"reservationUid"`
 
@@ -125,12 +330,111 @@ type ValidationErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// WaiveFineRequest defines model for WaiveFineRequest.
+type WaiveFineRequest struct {
+	// Comment Причина списания штрафа
+	Comment string `json:"comment"`
+}
+
+// WriteOffRequest defines model for WriteOffRequest.
+type WriteOffRequest struct {
+	// Status Итоговый статус бронирования
+	Status WriteOffRequestStatus `json:"status"`
+}
+
+// WriteOffRequestStatus Итоговый статус бронирования
+type WriteOffRequestStatus string
+
+// WriteOffReservationResponse defines model for WriteOffReservationResponse.
+type WriteOffReservationResponse struct {
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+
+	// ReservationUid UUID бронирования
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+
+	// Status Статус бронирования книги
+	Status WriteOffReservationResponseStatus `json:"status"`
+
+	// Username Имя пользователя, взявшего книгу
+	Username string `json:"username"`
+}
+
+// WriteOffReservationResponseStatus Статус бронирования книги
+type WriteOffReservationResponseStatus string
+
+// ListFinesParams defines parameters for ListFines.
+type ListFinesParams struct {
+	// Username Имя пользователя, доступно только сотрудникам библиотеки
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
+
+	// Status Статус штрафа
+	Status *ListFinesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListFinesParamsStatus defines parameters for ListFines.
+type ListFinesParamsStatus string
+
+// ListParams defines parameters for List.
+type ListParams struct {
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+
+	// Status Статусы бронирований
+	Status *[]ListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Active Только незакрытые бронирования (PENDING, RENTED, OVERDUE)
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+
+	// From Бронирования, начатые не раньше этой даты
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Бронирования, начатые не позже этой даты
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// Sort Поле сортировки
+	Sort *ListParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки, по умолчанию сначала новые
+	Order *ListParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор, полученный в nextCursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// AllUsers Бронирования всех пользователей, доступно только сотрудникам библиотеки
+	AllUsers *bool `form:"allUsers,omitempty" json:"allUsers,omitempty"`
+}
+
+// ListParamsStatus defines parameters for List.
+type ListParamsStatus string
+
+// ListParamsSort defines parameters for List.
+type ListParamsSort string
+
+// ListParamsOrder defines parameters for List.
+type ListParamsOrder string
+
+// SetFineTariffJSONRequestBody defines body for SetFineTariff for application/json ContentType.
+type SetFineTariffJSONRequestBody = FineTariffRequest
+
+// WaiveFineJSONRequestBody defines body for WaiveFine for application/json ContentType.
+type WaiveFineJSONRequestBody = WaiveFineRequest
+
 // CreateJSONRequestBody defines body for Create for application/json ContentType.
 type CreateJSONRequestBody = TakeBookRequest
 
+// RenewJSONRequestBody defines body for Renew for application/json ContentType.
+type RenewJSONRequestBody = RenewRequest
+
 // FinishJSONRequestBody defines body for Finish for application/json ContentType.
 type FinishJSONRequestBody = FinishReservationRequest
 
+// WriteOffJSONRequestBody defines body for WriteOff for application/json ContentType.
+type WriteOffJSONRequestBody = WriteOffRequest
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -204,31 +508,72 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ListFines request
+	ListFines(ctx context.Context, params *ListFinesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListFineTariffs request
+	ListFineTariffs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetFineTariffWithBody request with any body
+	SetFineTariffWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetFineTariff(ctx context.Context, body SetFineTariffJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PayFine request
+	PayFine(ctx context.Context, fineUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WaiveFineWithBody request with any body
+	WaiveFineWithBody(ctx context.Context, fineUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WaiveFine(ctx context.Context, fineUid openapi_types.UUID, body WaiveFineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// List request
-	List(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	List(ctx context.Context, params *ListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CreateWithBody request with any body
 	CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	Create(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ClaimPenalties request
+	ClaimPenalties(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AckPenalties request
+	AckPenalties(ctx context.Context, claimUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Get request
 	Get(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// Cancel request
 	Cancel(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// History request
+	History(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Pickup request
+	Pickup(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RenewWithBody request with any body
+	RenewWithBody(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	Renew(ctx context.Context, reservationUid openapi_types.UUID, body RenewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FinishWithBody request with any body
 	FinishWithBody(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	Finish(ctx context.Context, reservationUid openapi_types.UUID, body FinishJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// WriteOffWithBody request with any body
+	WriteOffWithBody(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	WriteOff(ctx context.Context, reservationUid openapi_types.UUID, body WriteOffJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// Health request
 	Health(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) List(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListRequest(c.Server)
+func (c *Client) ListFines(ctx context.Context, params *ListFinesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFinesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -239,8 +584,8 @@ func (c *Client) List(ctx context.Context, reqEditors ...RequestEditorFn) (*http
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRequestWithBody(c.Server, contentType, body)
+func (c *Client) ListFineTariffs(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListFineTariffsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -251,8 +596,8 @@ func (c *Client) CreateWithBody(ctx context.Context, contentType string, body io
 	return c.Client.Do(req)
 }
 
-func (c *Client) Create(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateRequest(c.Server, body)
+func (c *Client) SetFineTariffWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetFineTariffRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -263,8 +608,8 @@ func (c *Client) Create(ctx context.Context, body CreateJSONRequestBody, reqEdit
 	return c.Client.Do(req)
 }
 
-func (c *Client) Get(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetRequest(c.Server, reservationUid)
+func (c *Client) SetFineTariff(ctx context.Context, body SetFineTariffJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetFineTariffRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -275,8 +620,8 @@ func (c *Client) Get(ctx context.Context, reservationUid openapi_types.UUID, req
 	return c.Client.Do(req)
 }
 
-func (c *Client) Cancel(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCancelRequest(c.Server, reservationUid)
+func (c *Client) PayFine(ctx context.Context, fineUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPayFineRequest(c.Server, fineUid)
 	if err != nil {
 		return nil, err
 	}
@@ -287,8 +632,8 @@ func (c *Client) Cancel(ctx context.Context, reservationUid openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) FinishWithBody(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFinishRequestWithBody(c.Server, reservationUid, contentType, body)
+func (c *Client) WaiveFineWithBody(ctx context.Context, fineUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWaiveFineRequestWithBody(c.Server, fineUid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -299,8 +644,8 @@ func (c *Client) FinishWithBody(ctx context.Context, reservationUid openapi_type
 	return c.Client.Do(req)
 }
 
-func (c *Client) Finish(ctx context.Context, reservationUid openapi_types.UUID, body FinishJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewFinishRequest(c.Server, reservationUid, body)
+func (c *Client) WaiveFine(ctx context.Context, fineUid openapi_types.UUID, body WaiveFineJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWaiveFineRequest(c.Server, fineUid, body)
 	if err != nil {
 		return nil, err
 	}
@@ -311,8 +656,8 @@ func (c *Client) Finish(ctx context.Context, reservationUid openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-func (c *Client) Health(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewHealthRequest(c.Server)
+func (c *Client) List(ctx context.Context, params *ListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -323,169 +668,196 @@ func (c *Client) Health(ctx context.Context, reqEditors ...RequestEditorFn) (*ht
 	return c.Client.Do(req)
 }
 
-// NewListRequest generates requests for List
-func NewListRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/reservations")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) Create(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateRequest calls the generic Create builder with application/json body
-func NewCreateRequest(server string, body CreateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ClaimPenalties(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewClaimPenaltiesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateRequestWithBody generates requests for Create with any type of body
-func NewCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) AckPenalties(ctx context.Context, claimUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAckPenaltiesRequest(c.Server, claimUid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/reservations")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) Get(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRequest(c.Server, reservationUid)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetRequest generates requests for Get
-func NewGetRequest(server string, reservationUid openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reservationUid", runtime.ParamLocationPath, reservationUid)
+func (c *Client) Cancel(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelRequest(c.Server, reservationUid)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) History(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHistoryRequest(c.Server, reservationUid)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/reservations/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) Pickup(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPickupRequest(c.Server, reservationUid)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCancelRequest generates requests for Cancel
-func NewCancelRequest(server string, reservationUid openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reservationUid", runtime.ParamLocationPath, reservationUid)
+func (c *Client) RenewWithBody(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenewRequestWithBody(c.Server, reservationUid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) Renew(ctx context.Context, reservationUid openapi_types.UUID, body RenewJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRenewRequest(c.Server, reservationUid, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/v1/reservations/%s/cancel", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) FinishWithBody(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFinishRequestWithBody(c.Server, reservationUid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+func (c *Client) Finish(ctx context.Context, reservationUid openapi_types.UUID, body FinishJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewFinishRequest(c.Server, reservationUid, body)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewFinishRequest calls the generic Finish builder with application/json body
-func NewFinishRequest(server string, reservationUid openapi_types.UUID, body FinishJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) WriteOffWithBody(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWriteOffRequestWithBody(c.Server, reservationUid, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewFinishRequestWithBody(server, reservationUid, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewFinishRequestWithBody generates requests for Finish with any type of body
-func NewFinishRequestWithBody(server string, reservationUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
+func (c *Client) WriteOff(ctx context.Context, reservationUid openapi_types.UUID, body WriteOffJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWriteOffRequest(c.Server, reservationUid, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reservationUid", runtime.ParamLocationPath, reservationUid)
+func (c *Client) Health(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewHealthRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListFinesRequest generates requests for ListFines
+func NewListFinesRequest(server string, params *ListFinesParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/v1/reservations/%s/return", pathParam0)
+	operationPath := fmt.Sprintf("/api/v1/fines")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -495,18 +867,54 @@ func NewFinishRequestWithBody(server string, reservationUid openapi_types.UUID,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewHealthRequest generates requests for Health
-func NewHealthRequest(server string) (*http.Request, error) {
+// NewListFineTariffsRequest generates requests for ListFineTariffs
+func NewListFineTariffsRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -514,7 +922,7 @@ func NewHealthRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/manage/health")
+	operationPath := fmt.Sprintf("/api/v1/fines/tariffs")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -532,343 +940,1912 @@ func NewHealthRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewSetFineTariffRequest calls the generic SetFineTariff builder with application/json body
+func NewSetFineTariffRequest(server string, body SetFineTariffJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	bodyReader = bytes.NewReader(buf)
+	return NewSetFineTariffRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewSetFineTariffRequestWithBody generates requests for SetFineTariff with any type of body
+func NewSetFineTariffRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/api/v1/fines/tariffs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListWithResponse request
-	ListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateWithBodyWithResponse request with any body
-	CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetWithResponse request
-	GetWithResponse(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetResponse, error)
+	return req, nil
+}
 
-	// CancelWithResponse request
-	CancelWithResponse(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelResponse, error)
+// NewPayFineRequest generates requests for PayFine
+func NewPayFineRequest(server string, fineUid openapi_types.UUID) (*http.Request, error) {
+	var err error
 
-	// FinishWithBodyWithResponse request with any body
-	FinishWithBodyWithResponse(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FinishResponse, error)
+	var pathParam0 string
 
-	FinishWithResponse(ctx context.Context, reservationUid openapi_types.UUID, body FinishJSONRequestBody, reqEditors ...RequestEditorFn) (*FinishResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fineUid", runtime.ParamLocationPath, fineUid)
+	if err != nil {
+		return nil, err
+	}
 
-	// HealthWithResponse request
-	HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResponse, error)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type ListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]BookReservationResponse
-}
+	operationPath := fmt.Sprintf("/api/v1/fines/%s/pay", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TakeBookResponse
-	JSON400      *ValidationErrorResponse
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewWaiveFineRequest calls the generic WaiveFine builder with application/json body
+func NewWaiveFineRequest(server string, fineUid openapi_types.UUID, body WaiveFineJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewWaiveFineRequestWithBody(server, fineUid, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewWaiveFineRequestWithBody generates requests for WaiveFine with any type of body
+func NewWaiveFineRequestWithBody(server string, fineUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *BookReservationResponse
-	JSON404      *ErrorResponse
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "fineUid", runtime.ParamLocationPath, fineUid)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CancelResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	operationPath := fmt.Sprintf("/api/v1/fines/%s/waive", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CancelResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CancelResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewListRequest generates requests for List
+func NewListRequest(server string, params *ListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reservations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Page != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Size != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "size", runtime.ParamLocationQuery, *params.Size); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Active != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "active", runtime.ParamLocationQuery, *params.Active); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sort != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AllUsers != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "allUsers", runtime.ParamLocationQuery, *params.AllUsers); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRequest calls the generic Create builder with application/json body
+func NewCreateRequest(server string, body CreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateRequestWithBody generates requests for Create with any type of body
+func NewCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reservations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewClaimPenaltiesRequest generates requests for ClaimPenalties
+func NewClaimPenaltiesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reservations/penalties/claim")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAckPenaltiesRequest generates requests for AckPenalties
+func NewAckPenaltiesRequest(server string, claimUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "claimUid", runtime.ParamLocationPath, claimUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reservations/penalties/claim/%s/ack", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRequest generates requests for Get
+func NewGetRequest(server string, reservationUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reservationUid", runtime.ParamLocationPath, reservationUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reservations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCancelRequest generates requests for Cancel
+func NewCancelRequest(server string, reservationUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reservationUid", runtime.ParamLocationPath, reservationUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reservations/%s/cancel", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewHistoryRequest generates requests for History
+func NewHistoryRequest(server string, reservationUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reservationUid", runtime.ParamLocationPath, reservationUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reservations/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPickupRequest generates requests for Pickup
+func NewPickupRequest(server string, reservationUid openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reservationUid", runtime.ParamLocationPath, reservationUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reservations/%s/pickup", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRenewRequest calls the generic Renew builder with application/json body
+func NewRenewRequest(server string, reservationUid openapi_types.UUID, body RenewJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRenewRequestWithBody(server, reservationUid, "application/json", bodyReader)
+}
+
+// NewRenewRequestWithBody generates requests for Renew with any type of body
+func NewRenewRequestWithBody(server string, reservationUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reservationUid", runtime.ParamLocationPath, reservationUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reservations/%s/renew", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewFinishRequest calls the generic Finish builder with application/json body
+func NewFinishRequest(server string, reservationUid openapi_types.UUID, body FinishJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewFinishRequestWithBody(server, reservationUid, "application/json", bodyReader)
+}
+
+// NewFinishRequestWithBody generates requests for Finish with any type of body
+func NewFinishRequestWithBody(server string, reservationUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reservationUid", runtime.ParamLocationPath, reservationUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reservations/%s/return", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewWriteOffRequest calls the generic WriteOff builder with application/json body
+func NewWriteOffRequest(server string, reservationUid openapi_types.UUID, body WriteOffJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewWriteOffRequestWithBody(server, reservationUid, "application/json", bodyReader)
+}
+
+// NewWriteOffRequestWithBody generates requests for WriteOff with any type of body
+func NewWriteOffRequestWithBody(server string, reservationUid openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "reservationUid", runtime.ParamLocationPath, reservationUid)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/reservations/%s/write-off", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewHealthRequest generates requests for Health
+func NewHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/manage/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListFinesWithResponse request
+	ListFinesWithResponse(ctx context.Context, params *ListFinesParams, reqEditors ...RequestEditorFn) (*ListFinesResponse, error)
+
+	// ListFineTariffsWithResponse request
+	ListFineTariffsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListFineTariffsResponse, error)
+
+	// SetFineTariffWithBodyWithResponse request with any body
+	SetFineTariffWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetFineTariffResponse, error)
+
+	SetFineTariffWithResponse(ctx context.Context, body SetFineTariffJSONRequestBody, reqEditors ...RequestEditorFn) (*SetFineTariffResponse, error)
+
+	// PayFineWithResponse request
+	PayFineWithResponse(ctx context.Context, fineUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*PayFineResponse, error)
+
+	// WaiveFineWithBodyWithResponse request with any body
+	WaiveFineWithBodyWithResponse(ctx context.Context, fineUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WaiveFineResponse, error)
+
+	WaiveFineWithResponse(ctx context.Context, fineUid openapi_types.UUID, body WaiveFineJSONRequestBody, reqEditors ...RequestEditorFn) (*WaiveFineResponse, error)
+
+	// ListWithResponse request
+	ListWithResponse(ctx context.Context, params *ListParams, reqEditors ...RequestEditorFn) (*ListResponse, error)
+
+	// CreateWithBodyWithResponse request with any body
+	CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+
+	CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error)
+
+	// ClaimPenaltiesWithResponse request
+	ClaimPenaltiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ClaimPenaltiesResponse, error)
+
+	// AckPenaltiesWithResponse request
+	AckPenaltiesWithResponse(ctx context.Context, claimUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*AckPenaltiesResponse, error)
+
+	// GetWithResponse request
+	GetWithResponse(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetResponse, error)
+
+	// CancelWithResponse request
+	CancelWithResponse(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelResponse, error)
+
+	// HistoryWithResponse request
+	HistoryWithResponse(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*HistoryResponse, error)
+
+	// PickupWithResponse request
+	PickupWithResponse(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*PickupResponse, error)
+
+	// RenewWithBodyWithResponse request with any body
+	RenewWithBodyWithResponse(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenewResponse, error)
+
+	RenewWithResponse(ctx context.Context, reservationUid openapi_types.UUID, body RenewJSONRequestBody, reqEditors ...RequestEditorFn) (*RenewResponse, error)
+
+	// FinishWithBodyWithResponse request with any body
+	FinishWithBodyWithResponse(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FinishResponse, error)
+
+	FinishWithResponse(ctx context.Context, reservationUid openapi_types.UUID, body FinishJSONRequestBody, reqEditors ...RequestEditorFn) (*FinishResponse, error)
+
+	// WriteOffWithBodyWithResponse request with any body
+	WriteOffWithBodyWithResponse(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteOffResponse, error)
+
+	WriteOffWithResponse(ctx context.Context, reservationUid openapi_types.UUID, body WriteOffJSONRequestBody, reqEditors ...RequestEditorFn) (*WriteOffResponse, error)
+
+	// HealthWithResponse request
+	HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResponse, error)
+}
+
+type ListFinesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FineResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFinesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFinesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListFineTariffsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]FineTariffResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListFineTariffsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListFineTariffsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetFineTariffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FineTariffResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetFineTariffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetFineTariffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PayFineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FineResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PayFineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PayFineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WaiveFineResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FineResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r WaiveFineResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WaiveFineResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReservationPaginationResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TakeBookResponse
+	JSON400      *ValidationErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ClaimPenaltiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PenaltiesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ClaimPenaltiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ClaimPenaltiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AckPenaltiesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r AckPenaltiesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AckPenaltiesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BookReservationResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ReservationEventResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r HistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PickupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BookReservationResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PickupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PickupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RenewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BookReservationResponse
+	JSON400      *ValidationErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RenewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RenewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type FinishResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FinishReservationResponse
+	JSON400      *ValidationErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r FinishResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r FinishResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WriteOffResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WriteOffReservationResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r WriteOffResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WriteOffResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type HealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r HealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r HealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListFinesWithResponse request returning *ListFinesResponse
+func (c *ClientWithResponses) ListFinesWithResponse(ctx context.Context, params *ListFinesParams, reqEditors ...RequestEditorFn) (*ListFinesResponse, error) {
+	rsp, err := c.ListFines(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFinesResponse(rsp)
+}
+
+// ListFineTariffsWithResponse request returning *ListFineTariffsResponse
+func (c *ClientWithResponses) ListFineTariffsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListFineTariffsResponse, error) {
+	rsp, err := c.ListFineTariffs(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListFineTariffsResponse(rsp)
+}
+
+// SetFineTariffWithBodyWithResponse request with arbitrary body returning *SetFineTariffResponse
+func (c *ClientWithResponses) SetFineTariffWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetFineTariffResponse, error) {
+	rsp, err := c.SetFineTariffWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetFineTariffResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetFineTariffWithResponse(ctx context.Context, body SetFineTariffJSONRequestBody, reqEditors ...RequestEditorFn) (*SetFineTariffResponse, error) {
+	rsp, err := c.SetFineTariff(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetFineTariffResponse(rsp)
+}
+
+// PayFineWithResponse request returning *PayFineResponse
+func (c *ClientWithResponses) PayFineWithResponse(ctx context.Context, fineUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*PayFineResponse, error) {
+	rsp, err := c.PayFine(ctx, fineUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePayFineResponse(rsp)
+}
+
+// WaiveFineWithBodyWithResponse request with arbitrary body returning *WaiveFineResponse
+func (c *ClientWithResponses) WaiveFineWithBodyWithResponse(ctx context.Context, fineUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WaiveFineResponse, error) {
+	rsp, err := c.WaiveFineWithBody(ctx, fineUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWaiveFineResponse(rsp)
+}
+
+func (c *ClientWithResponses) WaiveFineWithResponse(ctx context.Context, fineUid openapi_types.UUID, body WaiveFineJSONRequestBody, reqEditors ...RequestEditorFn) (*WaiveFineResponse, error) {
+	rsp, err := c.WaiveFine(ctx, fineUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWaiveFineResponse(rsp)
+}
+
+// ListWithResponse request returning *ListResponse
+func (c *ClientWithResponses) ListWithResponse(ctx context.Context, params *ListParams, reqEditors ...RequestEditorFn) (*ListResponse, error) {
+	rsp, err := c.List(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListResponse(rsp)
+}
+
+// CreateWithBodyWithResponse request with arbitrary body returning *CreateResponse
+func (c *ClientWithResponses) CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	rsp, err := c.CreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
+	rsp, err := c.Create(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateResponse(rsp)
+}
+
+// ClaimPenaltiesWithResponse request returning *ClaimPenaltiesResponse
+func (c *ClientWithResponses) ClaimPenaltiesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ClaimPenaltiesResponse, error) {
+	rsp, err := c.ClaimPenalties(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseClaimPenaltiesResponse(rsp)
+}
+
+// AckPenaltiesWithResponse request returning *AckPenaltiesResponse
+func (c *ClientWithResponses) AckPenaltiesWithResponse(ctx context.Context, claimUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*AckPenaltiesResponse, error) {
+	rsp, err := c.AckPenalties(ctx, claimUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAckPenaltiesResponse(rsp)
+}
+
+// GetWithResponse request returning *GetResponse
+func (c *ClientWithResponses) GetWithResponse(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetResponse, error) {
+	rsp, err := c.Get(ctx, reservationUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetResponse(rsp)
+}
+
+// CancelWithResponse request returning *CancelResponse
+func (c *ClientWithResponses) CancelWithResponse(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelResponse, error) {
+	rsp, err := c.Cancel(ctx, reservationUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelResponse(rsp)
+}
+
+// HistoryWithResponse request returning *HistoryResponse
+func (c *ClientWithResponses) HistoryWithResponse(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*HistoryResponse, error) {
+	rsp, err := c.History(ctx, reservationUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHistoryResponse(rsp)
+}
+
+// PickupWithResponse request returning *PickupResponse
+func (c *ClientWithResponses) PickupWithResponse(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*PickupResponse, error) {
+	rsp, err := c.Pickup(ctx, reservationUid, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePickupResponse(rsp)
+}
+
+// RenewWithBodyWithResponse request with arbitrary body returning *RenewResponse
+func (c *ClientWithResponses) RenewWithBodyWithResponse(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RenewResponse, error) {
+	rsp, err := c.RenewWithBody(ctx, reservationUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenewResponse(rsp)
+}
+
+func (c *ClientWithResponses) RenewWithResponse(ctx context.Context, reservationUid openapi_types.UUID, body RenewJSONRequestBody, reqEditors ...RequestEditorFn) (*RenewResponse, error) {
+	rsp, err := c.Renew(ctx, reservationUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRenewResponse(rsp)
+}
+
+// FinishWithBodyWithResponse request with arbitrary body returning *FinishResponse
+func (c *ClientWithResponses) FinishWithBodyWithResponse(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FinishResponse, error) {
+	rsp, err := c.FinishWithBody(ctx, reservationUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFinishResponse(rsp)
+}
+
+func (c *ClientWithResponses) FinishWithResponse(ctx context.Context, reservationUid openapi_types.UUID, body FinishJSONRequestBody, reqEditors ...RequestEditorFn) (*FinishResponse, error) {
+	rsp, err := c.Finish(ctx, reservationUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseFinishResponse(rsp)
+}
+
+// WriteOffWithBodyWithResponse request with arbitrary body returning *WriteOffResponse
+func (c *ClientWithResponses) WriteOffWithBodyWithResponse(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*WriteOffResponse, error) {
+	rsp, err := c.WriteOffWithBody(ctx, reservationUid, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWriteOffResponse(rsp)
+}
+
+func (c *ClientWithResponses) WriteOffWithResponse(ctx context.Context, reservationUid openapi_types.UUID, body WriteOffJSONRequestBody, reqEditors ...RequestEditorFn) (*WriteOffResponse, error) {
+	rsp, err := c.WriteOff(ctx, reservationUid, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWriteOffResponse(rsp)
+}
+
+// HealthWithResponse request returning *HealthResponse
+func (c *ClientWithResponses) HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResponse, error) {
+	rsp, err := c.Health(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseHealthResponse(rsp)
+}
+
+// ParseListFinesResponse parses an HTTP response from a ListFinesWithResponse call
+func ParseListFinesResponse(rsp *http.Response) (*ListFinesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFinesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []FineResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListFineTariffsResponse parses an HTTP response from a ListFineTariffsWithResponse call
+func ParseListFineTariffsResponse(rsp *http.Response) (*ListFineTariffsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListFineTariffsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []FineTariffResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetFineTariffResponse parses an HTTP response from a SetFineTariffWithResponse call
+func ParseSetFineTariffResponse(rsp *http.Response) (*SetFineTariffResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetFineTariffResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FineTariffResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePayFineResponse parses an HTTP response from a PayFineWithResponse call
+func ParsePayFineResponse(rsp *http.Response) (*PayFineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PayFineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FineResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseWaiveFineResponse parses an HTTP response from a WaiveFineWithResponse call
+func ParseWaiveFineResponse(rsp *http.Response) (*WaiveFineResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &WaiveFineResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FineResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListResponse parses an HTTP response from a ListWithResponse call
+func ParseListResponse(rsp *http.Response) (*ListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReservationPaginationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateResponse parses an HTTP response from a CreateWithResponse call
+func ParseCreateResponse(rsp *http.Response) (*CreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TakeBookResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
-	return 0
-}
 
-type FinishResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *FinishReservationResponse
-	JSON404      *ErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r FinishResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseClaimPenaltiesResponse parses an HTTP response from a ClaimPenaltiesWithResponse call
+func ParseClaimPenaltiesResponse(rsp *http.Response) (*ClaimPenaltiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r FinishResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ClaimPenaltiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type HealthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PenaltiesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r HealthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r HealthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// ListWithResponse request returning *ListResponse
-func (c *ClientWithResponses) ListWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListResponse, error) {
-	rsp, err := c.List(ctx, reqEditors...)
+// ParseAckPenaltiesResponse parses an HTTP response from a AckPenaltiesWithResponse call
+func ParseAckPenaltiesResponse(rsp *http.Response) (*AckPenaltiesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListResponse(rsp)
-}
 
-// CreateWithBodyWithResponse request with arbitrary body returning *CreateResponse
-func (c *ClientWithResponses) CreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
-	rsp, err := c.CreateWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &AckPenaltiesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseCreateResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateWithResponse(ctx context.Context, body CreateJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateResponse, error) {
-	rsp, err := c.Create(ctx, body, reqEditors...)
+// ParseGetResponse parses an HTTP response from a GetWithResponse call
+func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateResponse(rsp)
-}
 
-// GetWithResponse request returning *GetResponse
-func (c *ClientWithResponses) GetWithResponse(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetResponse, error) {
-	rsp, err := c.Get(ctx, reservationUid, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetResponse(rsp)
-}
 
-// CancelWithResponse request returning *CancelResponse
-func (c *ClientWithResponses) CancelWithResponse(ctx context.Context, reservationUid openapi_types.UUID, reqEditors ...RequestEditorFn) (*CancelResponse, error) {
-	rsp, err := c.Cancel(ctx, reservationUid, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BookReservationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
-	return ParseCancelResponse(rsp)
+
+	return response, nil
 }
 
-// FinishWithBodyWithResponse request with arbitrary body returning *FinishResponse
-func (c *ClientWithResponses) FinishWithBodyWithResponse(ctx context.Context, reservationUid openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*FinishResponse, error) {
-	rsp, err := c.FinishWithBody(ctx, reservationUid, contentType, body, reqEditors...)
+// ParseCancelResponse parses an HTTP response from a CancelWithResponse call
+func ParseCancelResponse(rsp *http.Response) (*CancelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseFinishResponse(rsp)
-}
 
-func (c *ClientWithResponses) FinishWithResponse(ctx context.Context, reservationUid openapi_types.UUID, body FinishJSONRequestBody, reqEditors ...RequestEditorFn) (*FinishResponse, error) {
-	rsp, err := c.Finish(ctx, reservationUid, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &CancelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseFinishResponse(rsp)
-}
 
-// HealthWithResponse request returning *HealthResponse
-func (c *ClientWithResponses) HealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*HealthResponse, error) {
-	rsp, err := c.Health(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
-	return ParseHealthResponse(rsp)
+
+	return response, nil
 }
 
-// ParseListResponse parses an HTTP response from a ListWithResponse call
-func ParseListResponse(rsp *http.Response) (*ListResponse, error) {
+// ParseHistoryResponse parses an HTTP response from a HistoryWithResponse call
+func ParseHistoryResponse(rsp *http.Response) (*HistoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListResponse{
+	response := &HistoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []BookReservationResponse
+		var dest []ReservationEventResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCreateResponse parses an HTTP response from a CreateWithResponse call
-func ParseCreateResponse(rsp *http.Response) (*CreateResponse, error) {
+// ParsePickupResponse parses an HTTP response from a PickupWithResponse call
+func ParsePickupResponse(rsp *http.Response) (*PickupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateResponse{
+	response := &PickupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TakeBookResponse
+		var dest BookReservationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ValidationErrorResponse
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParseGetResponse parses an HTTP response from a GetWithResponse call
-func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
+// ParseRenewResponse parses an HTTP response from a RenewWithResponse call
+func ParseRenewResponse(rsp *http.Response) (*RenewResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetResponse{
+	response := &RenewResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -881,6 +2858,13 @@ func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -888,48 +2872,100 @@ func ParseGetResponse(rsp *http.Response) (*GetResponse, error) {
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseCancelResponse parses an HTTP response from a CancelWithResponse call
-func ParseCancelResponse(rsp *http.Response) (*CancelResponse, error) {
+// ParseFinishResponse parses an HTTP response from a FinishWithResponse call
+func ParseFinishResponse(rsp *http.Response) (*FinishResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CancelResponse{
+	response := &FinishResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FinishReservationResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseFinishResponse parses an HTTP response from a FinishWithResponse call
-func ParseFinishResponse(rsp *http.Response) (*FinishResponse, error) {
+// ParseWriteOffResponse parses an HTTP response from a WriteOffWithResponse call
+func ParseWriteOffResponse(rsp *http.Response) (*WriteOffResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &FinishResponse{
+	response := &WriteOffResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest FinishReservationResponse
+		var dest WriteOffReservationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
 		var dest ErrorResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -937,6 +2973,13 @@ func ParseFinishResponse(rsp *http.Response) (*FinishResponse, error) {
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	}
 
 	return response, nil
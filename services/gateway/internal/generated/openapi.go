@@ -7,7 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
@@ -17,9 +19,30 @@ import (
 
 // Defines values for BookReservationResponseStatus.
 const (
-	BookReservationResponseStatusEXPIRED  BookReservationResponseStatus = "EXPIRED"
-	BookReservationResponseStatusRENTED   BookReservationResponseStatus = "RENTED"
-	BookReservationResponseStatusRETURNED BookReservationResponseStatus = "RETURNED"
+	BookReservationResponseStatusCANCELLED BookReservationResponseStatus = "CANCELLED"
+	BookReservationResponseStatusDAMAGED   BookReservationResponseStatus = "DAMAGED"
+	BookReservationResponseStatusEXPIRED   BookReservationResponseStatus = "EXPIRED"
+	BookReservationResponseStatusLOST      BookReservationResponseStatus = "LOST"
+	BookReservationResponseStatusOVERDUE   BookReservationResponseStatus = "OVERDUE"
+	BookReservationResponseStatusPENDING   BookReservationResponseStatus = "PENDING"
+	BookReservationResponseStatusRENTED    BookReservationResponseStatus = "RENTED"
+	BookReservationResponseStatusRETURNED  BookReservationResponseStatus = "RETURNED"
+)
+
+// Defines values for FineResponseStatus.
+const (
+	FineResponseStatusPAID   FineResponseStatus = "PAID"
+	FineResponseStatusUNPAID FineResponseStatus = "UNPAID"
+	FineResponseStatusWAIVED FineResponseStatus = "WAIVED"
+)
+
+// Defines values for HoldResponseStatus.
+const (
+	HoldResponseStatusCANCELLED HoldResponseStatus = "CANCELLED"
+	HoldResponseStatusEXPIRED   HoldResponseStatus = "EXPIRED"
+	HoldResponseStatusFULFILLED HoldResponseStatus = "FULFILLED"
+	HoldResponseStatusREADY     HoldResponseStatus = "READY"
+	HoldResponseStatusWAITING   HoldResponseStatus = "WAITING"
 )
 
 // Defines values for LibraryBookResponseCondition.
@@ -36,12 +59,99 @@ const (
 	ReturnBookRequestConditionGOOD      ReturnBookRequestCondition = "GOOD"
 )
 
+// Defines values for SuggestionResponseKind.
+const (
+	AUTHOR SuggestionResponseKind = "AUTHOR"
+	BOOK   SuggestionResponseKind = "BOOK"
+	GENRE  SuggestionResponseKind = "GENRE"
+)
+
 // Defines values for TakeBookResponseStatus.
 const (
-	TakeBookResponseStatusEXPIRED  TakeBookResponseStatus = "EXPIRED"
-	TakeBookResponseStatusLOST     TakeBookResponseStatus = "LOST"
-	TakeBookResponseStatusRENTED   TakeBookResponseStatus = "RENTED"
-	TakeBookResponseStatusRETURNED TakeBookResponseStatus = "RETURNED"
+	TakeBookResponseStatusCANCELLED TakeBookResponseStatus = "CANCELLED"
+	TakeBookResponseStatusDAMAGED   TakeBookResponseStatus = "DAMAGED"
+	TakeBookResponseStatusEXPIRED   TakeBookResponseStatus = "EXPIRED"
+	TakeBookResponseStatusLOST      TakeBookResponseStatus = "LOST"
+	TakeBookResponseStatusOVERDUE   TakeBookResponseStatus = "OVERDUE"
+	TakeBookResponseStatusPENDING   TakeBookResponseStatus = "PENDING"
+	TakeBookResponseStatusRENTED    TakeBookResponseStatus = "RENTED"
+	TakeBookResponseStatusRETURNED  TakeBookResponseStatus = "RETURNED"
+)
+
+// Defines values for WriteOffRequestReason.
+const (
+	WriteOffRequestReasonDAMAGED WriteOffRequestReason = "DAMAGED"
+	WriteOffRequestReasonLOST    WriteOffRequestReason = "LOST"
+)
+
+// Defines values for GetBookCoverParamsSize.
+const (
+	Original  GetBookCoverParamsSize = "original"
+	Thumbnail GetBookCoverParamsSize = "thumbnail"
+)
+
+// Defines values for ListFinesParamsStatus.
+const (
+	ListFinesParamsStatusPAID   ListFinesParamsStatus = "PAID"
+	ListFinesParamsStatusUNPAID ListFinesParamsStatus = "UNPAID"
+	ListFinesParamsStatusWAIVED ListFinesParamsStatus = "WAIVED"
+)
+
+// Defines values for ListLibrariesParamsSort.
+const (
+	ListLibrariesParamsSortCity ListLibrariesParamsSort = "city"
+	ListLibrariesParamsSortName ListLibrariesParamsSort = "name"
+)
+
+// Defines values for ListLibrariesParamsOrder.
+const (
+	ListLibrariesParamsOrderAsc  ListLibrariesParamsOrder = "asc"
+	ListLibrariesParamsOrderDesc ListLibrariesParamsOrder = "desc"
+)
+
+// Defines values for ListBooksParamsCondition.
+const (
+	BAD       ListBooksParamsCondition = "BAD"
+	EXCELLENT ListBooksParamsCondition = "EXCELLENT"
+	GOOD      ListBooksParamsCondition = "GOOD"
+)
+
+// Defines values for ListBooksParamsSort.
+const (
+	ListBooksParamsSortAuthor         ListBooksParamsSort = "author"
+	ListBooksParamsSortAvailableCount ListBooksParamsSort = "availableCount"
+	ListBooksParamsSortGenre          ListBooksParamsSort = "genre"
+	ListBooksParamsSortName           ListBooksParamsSort = "name"
+)
+
+// Defines values for ListBooksParamsOrder.
+const (
+	ListBooksParamsOrderAsc  ListBooksParamsOrder = "asc"
+	ListBooksParamsOrderDesc ListBooksParamsOrder = "desc"
+)
+
+// Defines values for ListReservationsParamsStatus.
+const (
+	CANCELLED ListReservationsParamsStatus = "CANCELLED"
+	DAMAGED   ListReservationsParamsStatus = "DAMAGED"
+	EXPIRED   ListReservationsParamsStatus = "EXPIRED"
+	LOST      ListReservationsParamsStatus = "LOST"
+	OVERDUE   ListReservationsParamsStatus = "OVERDUE"
+	PENDING   ListReservationsParamsStatus = "PENDING"
+	RENTED    ListReservationsParamsStatus = "RENTED"
+	RETURNED  ListReservationsParamsStatus = "RETURNED"
+)
+
+// Defines values for ListReservationsParamsSort.
+const (
+	StartDate ListReservationsParamsSort = "startDate"
+	TillDate  ListReservationsParamsSort = "tillDate"
+)
+
+// Defines values for ListReservationsParamsOrder.
+const (
+	Asc  ListReservationsParamsOrder = "asc"
+	Desc ListReservationsParamsOrder = "desc"
 )
 
 // BookInfo defines model for BookInfo.
@@ -52,11 +162,47 @@ type BookInfo struct {
 	// BookUid UUID книги
 	BookUid openapi_types.UUID `json:"bookUid"`
 
+	// CoverThumbnailUrl Ссылка на миниатюру обложки книги
+	CoverThumbnailUrl *string `json:"coverThumbnailUrl,omitempty"`
+
+	// CoverUrl Ссылка на обложку книги
+	CoverUrl *string `json:"coverUrl,omitempty"`
+
+	// Description Аннотация
+	Description *string `json:"description,omitempty"`
+
 	// Genre Жанр
 	Genre string `json:"genre"`
 
+	// Isbn10 ISBN-10
+	Isbn10 *string `json:"isbn10,omitempty"`
+
+	// Isbn13 ISBN-13
+	Isbn13 *string `json:"isbn13,omitempty"`
+
+	// Language Язык издания
+	Language *string `json:"language,omitempty"`
+
 	// Name Название книги
 	Name string `json:"name"`
+
+	// PageCount Количество страниц
+	PageCount *int `json:"pageCount,omitempty"`
+
+	// PublicationYear Год издания
+	PublicationYear *int `json:"publicationYear,omitempty"`
+
+	// Publisher Издательство
+	Publisher *string `json:"publisher,omitempty"`
+
+	// SeriesUid UUID серии
+	SeriesUid *openapi_types.UUID `json:"seriesUid,omitempty"`
+
+	// VolumeNumber Номер тома в серии
+	VolumeNumber *int `json:"volumeNumber,omitempty"`
+
+	// WorkUid UUID произведения
+	WorkUid *openapi_types.UUID `json:"workUid,omitempty"`
 }
 
 // BookReservationResponse defines model for BookReservationResponse.
@@ -64,6 +210,9 @@ type BookReservationResponse struct {
 	Book    BookInfo        `json:"book"`
 	Library LibraryResponse `json:"library"`
 
+	// PickupUntil До какого времени нужно забрать заранее забронированную книгу
+	PickupUntil *time.Time `json:"pickupUntil,omitempty"`
+
 	// ReservationUid UUID бронирования
 	ReservationUid openapi_types.UUID `json:"reservationUid"`
 
@@ -73,13 +222,22 @@ type BookReservationResponse struct {
 	// Status Статус бронирования книги
 	Status BookReservationResponseStatus `json:"status"`
 
-	// TillDate Дата окончания бронирования
+	// TillDate Дата окончания бронирования, переносится на ближайший рабочий день библиотеки
 	TillDate string `json:"tillDate"`
 }
 
 // BookReservationResponseStatus Статус бронирования книги
 type BookReservationResponseStatus string
 
+// CityResponse defines model for CityResponse.
+type CityResponse struct {
+	// City Город
+	City string `json:"city"`
+
+	// LibrariesCount Количество библиотек в городе
+	LibrariesCount int `json:"librariesCount"`
+}
+
 // ErrorDescription defines model for ErrorDescription.
 type ErrorDescription struct {
 	Error string `json:"error"`
@@ -92,10 +250,118 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// FineResponse defines model for FineResponse.
+type FineResponse struct {
+	// Amount Сумма штрафа в копейках
+	Amount int `json:"amount"`
+
+	// ClosedAt Время оплаты или списания штрафа
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+
+	// ClosedBy Сотрудник, принявший оплату или списавший штраф
+	ClosedBy *string `json:"closedBy,omitempty"`
+
+	// Comment Причина списания штрафа
+	Comment *string `json:"comment,omitempty"`
+
+	// CreatedAt Время начисления штрафа
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DaysLate На сколько дней просрочен возврат
+	DaysLate int `json:"daysLate"`
+
+	// FineUid UUID штрафа
+	FineUid openapi_types.UUID `json:"fineUid"`
+
+	// ReservationUid UUID бронирования
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+
+	// Status Статус штрафа
+	Status FineResponseStatus `json:"status"`
+
+	// Username Имя пользователя
+	Username string `json:"username"`
+}
+
+// FineResponseStatus Статус штрафа
+type FineResponseStatus string
+
+// FineTariffRequest defines model for FineTariffRequest.
+type FineTariffRequest struct {
+	// DailyRate Штраф за день просрочки в копейках
+	DailyRate int `json:"dailyRate"`
+
+	// Genre Жанр книги, если тариф действует только для него
+	Genre *string `json:"genre,omitempty"`
+
+	// LibraryUid UUID библиотеки, если тариф действует только в ней
+	LibraryUid *openapi_types.UUID `json:"libraryUid,omitempty"`
+
+	// MaxAmount Максимальная сумма штрафа за одно бронирование в копейках
+	MaxAmount *int `json:"maxAmount,omitempty"`
+}
+
+// FineTariffResponse defines model for FineTariffResponse.
+type FineTariffResponse struct {
+	// DailyRate Штраф за день просрочки в копейках
+	DailyRate int `json:"dailyRate"`
+
+	// Genre Жанр книги, отсутствует у тарифа для всех жанров
+	Genre *string `json:"genre,omitempty"`
+
+	// LibraryUid UUID библиотеки, отсутствует у тарифа для всех библиотек
+	LibraryUid *openapi_types.UUID `json:"libraryUid,omitempty"`
+
+	// MaxAmount Максимальная сумма штрафа за одно бронирование в копейках
+	MaxAmount *int `json:"maxAmount,omitempty"`
+
+	// UpdatedAt Время изменения тарифа
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// UpdatedBy Сотрудник, изменивший тариф
+	UpdatedBy string `json:"updatedBy"`
+}
+
+// HoldResponse defines model for HoldResponse.
+type HoldResponse struct {
+	Book BookInfo `json:"book"`
+
+	// ExpiresAt До какого времени отложенный экземпляр ждет пользователя
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// HoldUid UUID заявки
+	HoldUid openapi_types.UUID `json:"holdUid"`
+	Library LibraryResponse    `json:"library"`
+
+	// Position Место в очереди, 0 если заявка не ожидает
+	Position int `json:"position"`
+
+	// RequestedAt Время постановки в очередь
+	RequestedAt time.Time `json:"requestedAt"`
+
+	// Status Статус заявки
+	Status HoldResponseStatus `json:"status"`
+}
+
+// HoldResponseStatus Статус заявки
+type HoldResponseStatus string
+
+// HolidayResponse defines model for HolidayResponse.
+type HolidayResponse struct {
+	// Date Дата выходного дня
+	Date string `json:"date"`
+
+	// Reason Причина закрытия
+	Reason *string `json:"reason,omitempty"`
+}
+
 // LibraryBookPaginationResponse defines model for LibraryBookPaginationResponse.
 type LibraryBookPaginationResponse struct {
 	Items []LibraryBookResponse `json:"items"`
 
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string `json:"nextCursor,omitempty"`
+
 	// Page Номер страницы
 	Page *int `json:"page,omitempty"`
 
@@ -120,11 +386,38 @@ type LibraryBookResponse struct {
 	// Condition Состояние книги
 	Condition LibraryBookResponseCondition `json:"condition"`
 
+	// CoverThumbnailUrl Ссылка на миниатюру обложки книги
+	CoverThumbnailUrl *string `json:"coverThumbnailUrl,omitempty"`
+
+	// CoverUrl Ссылка на обложку книги
+	CoverUrl *string `json:"coverUrl,omitempty"`
+
+	// Description Аннотация
+	Description *string `json:"description,omitempty"`
+
 	// Genre Жанр
 	Genre string `json:"genre"`
 
+	// Isbn10 ISBN-10
+	Isbn10 *string `json:"isbn10,omitempty"`
+
+	// Isbn13 ISBN-13
+	Isbn13 *string `json:"isbn13,omitempty"`
+
+	// Language Язык издания
+	Language *string `json:"language,omitempty"`
+
 	// Name Название книги
 	Name string `json:"name"`
+
+	// PageCount Количество страниц
+	PageCount *int `json:"pageCount,omitempty"`
+
+	// PublicationYear Год издания
+	PublicationYear *int `json:"publicationYear,omitempty"`
+
+	// Publisher Издательство
+	Publisher *string `json:"publisher,omitempty"`
 }
 
 // LibraryBookResponseCondition Состояние книги
@@ -134,6 +427,9 @@ type LibraryBookResponseCondition string
 type LibraryPaginationResponse struct {
 	Items []LibraryResponse `json:"items"`
 
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string `json:"nextCursor,omitempty"`
+
 	// Page Номер страницы
 	Page *int `json:"page,omitempty"`
 
@@ -152,25 +448,151 @@ type LibraryResponse struct {
 	// City Город, в котором находится библиотека
 	City string `json:"city"`
 
+	// Distance Расстояние до точки поиска в километрах
+	Distance *float64 `json:"distance,omitempty"`
+
+	// Latitude Широта
+	Latitude *float64 `json:"latitude,omitempty"`
+
 	// LibraryUid UUID библиотеки
 	LibraryUid openapi_types.UUID `json:"libraryUid"`
 
+	// Longitude Долгота
+	Longitude *float64 `json:"longitude,omitempty"`
+
 	// Name Название библиотеки
 	Name string `json:"name"`
 }
 
+// LibraryScheduleResponse defines model for LibraryScheduleResponse.
+type LibraryScheduleResponse struct {
+	// Holidays Ближайшие выходные дни
+	Holidays []HolidayResponse `json:"holidays"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid   openapi_types.UUID `json:"libraryUid"`
+	OpeningHours []OpeningHours     `json:"openingHours"`
+
+	// Timezone Часовой пояс библиотеки
+	Timezone string `json:"timezone"`
+}
+
+// OpeningHours defines model for OpeningHours.
+type OpeningHours struct {
+	// ClosesAt Время закрытия в формате HH:MM
+	ClosesAt string `json:"closesAt"`
+
+	// OpensAt Время открытия в формате HH:MM
+	OpensAt string `json:"opensAt"`
+
+	// Weekday День недели (1 - понедельник, 7 - воскресенье)
+	Weekday int `json:"weekday"`
+}
+
+// PopularBookResponse defines model for PopularBookResponse.
+type PopularBookResponse struct {
+	Book BookInfo `json:"book"`
+
+	// Checkouts Количество выдач за период
+	Checkouts int `json:"checkouts"`
+
+	// Returns Количество возвратов за период
+	Returns int `json:"returns"`
+}
+
+// RenewBookRequest defines model for RenewBookRequest.
+type RenewBookRequest struct {
+	// TillDate Новая дата окончания бронирования
+	TillDate string `json:"tillDate"`
+}
+
+// ReservationEventResponse defines model for ReservationEventResponse.
+type ReservationEventResponse struct {
+	// Actor Пользователь, изменивший бронирование, или system для фоновых задач
+	Actor string `json:"actor"`
+
+	// Comment Комментарий к событию
+	Comment *string `json:"comment,omitempty"`
+
+	// CreatedAt Время события
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FromStatus Статус до изменения, отсутствует для создания бронирования
+	FromStatus *string `json:"fromStatus,omitempty"`
+
+	// ToStatus Статус после изменения
+	ToStatus string `json:"toStatus"`
+}
+
+// ReservationPaginationResponse defines model for ReservationPaginationResponse.
+type ReservationPaginationResponse struct {
+	Items []BookReservationResponse `json:"items"`
+
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// Page Номер страницы
+	Page *int `json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// TotalElements Общее количество элементов
+	TotalElements int `json:"totalElements"`
+}
+
 // ReturnBookRequest defines model for ReturnBookRequest.
 type ReturnBookRequest struct {
 	// Condition Состояние книги
 	Condition ReturnBookRequestCondition `json:"condition"`
 
-	// Date Дата возврата
-	Date string `json:"date"`
+	// Date Дата возврата, по умолчанию текущая. Указывать может только сотрудник библиотеки
+	Date *string `json:"date,omitempty"`
 }
 
 // ReturnBookRequestCondition Состояние книги
 type ReturnBookRequestCondition string
 
+// SeriesResponse defines model for SeriesResponse.
+type SeriesResponse struct {
+	// Author Автор
+	Author *string `json:"author,omitempty"`
+
+	// Name Название серии
+	Name string `json:"name"`
+
+	// SeriesUid UUID серии
+	SeriesUid openapi_types.UUID `json:"seriesUid"`
+
+	// Volumes Тома серии по порядку
+	Volumes []SeriesVolumeResponse `json:"volumes"`
+}
+
+// SeriesVolumeResponse defines model for SeriesVolumeResponse.
+type SeriesVolumeResponse struct {
+	// AvailableCount Количество доступных экземпляров тома
+	AvailableCount int      `json:"availableCount"`
+	Book           BookInfo `json:"book"`
+
+	// VolumeNumber Номер тома
+	VolumeNumber int `json:"volumeNumber"`
+}
+
+// SuggestionResponse defines model for SuggestionResponse.
+type SuggestionResponse struct {
+	// BookUid UUID книги, заполняется для подсказок-книг
+	BookUid *openapi_types.UUID `json:"bookUid,omitempty"`
+
+	// Kind Что подсказывается
+	Kind SuggestionResponseKind `json:"kind"`
+
+	// Text Текст подсказки
+	Text string `json:"text"`
+}
+
+// SuggestionResponseKind Что подсказывается
+type SuggestionResponseKind string
+
 // TakeBookRequest defines model for TakeBookRequest.
 type TakeBookRequest struct {
 	// BookUid UUID книги
@@ -179,15 +601,21 @@ type TakeBookRequest struct {
 	// LibraryUid UUID библиотеки
 	LibraryUid openapi_types.UUID `json:"libraryUid"`
 
+	// PickupDate Дата, когда пользователь заберет книгу. Без нее книга выдается сразу
+	PickupDate *string `json:"pickupDate,omitempty"`
+
 	// TillDate Дата окончания бронирования
 	TillDate string `json:"tillDate"`
 }
 
 // TakeBookResponse defines model for TakeBookResponse.
 type TakeBookResponse struct {
-	Book    BookInfo           `json:"book"`
-	Library LibraryResponse    `json:"library"`
-	Rating  UserRatingResponse `json:"rating"`
+	Book    BookInfo        `json:"book"`
+	Library LibraryResponse `json:"library"`
+
+	// PickupUntil До какого времени нужно забрать заранее забронированную книгу
+	PickupUntil *time.Time         `json:"pickupUntil,omitempty"`
+	Rating      UserRatingResponse `json:"rating"`
 
 	// ReservationUid UUID бронирования
 	ReservationUid openapi_types.UUID `json:"reservationUid"`
@@ -220,388 +648,2559 @@ type ValidationErrorResponse struct {
 	Message string `json:"message"`
 }
 
-// ListLibrariesParams defines parameters for ListLibraries.
-type ListLibrariesParams struct {
-	Page *int `form:"page,omitempty" json:"page,omitempty"`
-	Size *int `form:"size,omitempty" json:"size,omitempty"`
-
-	// City Город
-	City string `form:"city" json:"city"`
+// WaiveFineRequest defines model for WaiveFineRequest.
+type WaiveFineRequest struct {
+	// Comment Причина списания штрафа
+	Comment string `json:"comment"`
 }
 
-// ListBooksParams defines parameters for ListBooks.
-type ListBooksParams struct {
-	Page    *int  `form:"page,omitempty" json:"page,omitempty"`
-	Size    *int  `form:"size,omitempty" json:"size,omitempty"`
-	ShowAll *bool `form:"showAll,omitempty" json:"showAll,omitempty"`
-}
+// WorkSearchPaginationResponse defines model for WorkSearchPaginationResponse.
+type WorkSearchPaginationResponse struct {
+	Items []WorkSearchResult `json:"items"`
 
-// TakeBookJSONRequestBody defines body for TakeBook for application/json ContentType.
-type TakeBookJSONRequestBody = TakeBookRequest
+	// Page Номер страницы
+	Page *int `json:"page,omitempty"`
 
-// ReturnBookJSONRequestBody defines body for ReturnBook for application/json ContentType.
-type ReturnBookJSONRequestBody = ReturnBookRequest
+	// PageSize Количество элементов на странице
+	PageSize *int `json:"pageSize,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Получить список библиотек в городе
-	// (GET /api/v1/libraries)
-	ListLibraries(ctx echo.Context, params ListLibrariesParams) error
-	// Получить список книг в выбранной библиотеке
-	// (GET /api/v1/libraries/{libraryUid}/books)
-	ListBooks(ctx echo.Context, libraryUid openapi_types.UUID, params ListBooksParams) error
-	// Получить рейтинг пользователя
-	// (GET /api/v1/rating)
-	GetRating(ctx echo.Context) error
-	// Получить информацию по всем взятым в прокат книгам пользователя
-	// (GET /api/v1/reservations)
-	ListReservations(ctx echo.Context) error
-	// Взять книгу в библиотеке
-	// (POST /api/v1/reservations)
-	TakeBook(ctx echo.Context) error
-	// Вернуть книгу
-	// (POST /api/v1/reservations/{reservationUid}/return)
-	ReturnBook(ctx echo.Context, reservationUid openapi_types.UUID) error
-	// Проверка живости сервиса
-	// (GET /manage/health)
-	Health(ctx echo.Context) error
+	// TotalElements Общее количество элементов
+	TotalElements int `json:"totalElements"`
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
-}
+// WorkSearchResult defines model for WorkSearchResult.
+type WorkSearchResult struct {
+	// Author Автор
+	Author *string  `json:"author,omitempty"`
+	Book   BookInfo `json:"book"`
 
-// ListLibraries converts echo context to params.
-func (w *ServerInterfaceWrapper) ListLibraries(ctx echo.Context) error {
-	var err error
+	// EditionsCount Количество изданий произведения
+	EditionsCount int `json:"editionsCount"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLibrariesParams
-	// ------------- Optional query parameter "page" -------------
+	// OtherEditions Остальные издания, заполняется при expandEditions=true
+	OtherEditions *[]BookInfo `json:"otherEditions,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
-	}
+	// Title Название произведения
+	Title string `json:"title"`
 
-	// ------------- Optional query parameter "size" -------------
+	// WorkUid UUID произведения
+	WorkUid openapi_types.UUID `json:"workUid"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
-	}
+// WriteOffRequest defines model for WriteOffRequest.
+type WriteOffRequest struct {
+	// Comment Комментарий
+	Comment *string `json:"comment,omitempty"`
 
-	// ------------- Required query parameter "city" -------------
+	// Reason Причина списания
+	Reason WriteOffRequestReason `json:"reason"`
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "city", ctx.QueryParams(), &params.City)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter city: %s", err))
-	}
+// WriteOffRequestReason Причина списания
+type WriteOffRequestReason string
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListLibraries(ctx, params)
-	return err
+// SearchBooksParams defines parameters for SearchBooks.
+type SearchBooksParams struct {
+	// Query Строка поиска по названию и автору
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// ExpandEditions Вернуть остальные издания произведения
+	ExpandEditions *bool `form:"expandEditions,omitempty" json:"expandEditions,omitempty"`
+	Page           *int  `form:"page,omitempty" json:"page,omitempty"`
+	Size           *int  `form:"size,omitempty" json:"size,omitempty"`
 }
 
-// ListBooks converts echo context to params.
-func (w *ServerInterfaceWrapper) ListBooks(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "libraryUid" -------------
-	var libraryUid openapi_types.UUID
+// GetBookCoverParams defines parameters for GetBookCover.
+type GetBookCoverParams struct {
+	// Size Размер изображения
+	Size *GetBookCoverParamsSize `form:"size,omitempty" json:"size,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
-	}
+	// IfNoneMatch ETag закешированной обложки
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListBooksParams
-	// ------------- Optional query parameter "page" -------------
+// GetBookCoverParamsSize defines parameters for GetBookCover.
+type GetBookCoverParamsSize string
 
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
-	}
+// ListFinesParams defines parameters for ListFines.
+type ListFinesParams struct {
+	// Username Имя пользователя, доступно только сотрудникам библиотеки
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
 
-	// ------------- Optional query parameter "size" -------------
+	// Status Статус штрафа
+	Status *ListFinesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
-	}
+// ListFinesParamsStatus defines parameters for ListFines.
+type ListFinesParamsStatus string
 
-	// ------------- Optional query parameter "showAll" -------------
+// ListLibrariesParams defines parameters for ListLibraries.
+type ListLibrariesParams struct {
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
 
-	err = runtime.BindQueryParameter("form", true, false, "showAll", ctx.QueryParams(), &params.ShowAll)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showAll: %s", err))
-	}
+	// City Город
+	City *string `form:"city,omitempty" json:"city,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListBooks(ctx, libraryUid, params)
-	return err
-}
+	// NamePrefix Начало названия библиотеки
+	NamePrefix *string `form:"namePrefix,omitempty" json:"namePrefix,omitempty"`
 
-// GetRating converts echo context to params.
-func (w *ServerInterfaceWrapper) GetRating(ctx echo.Context) error {
-	var err error
+	// Sort Поле сортировки
+	Sort *ListLibrariesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetRating(ctx)
-	return err
+	// Order Направление сортировки
+	Order *ListLibrariesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор, полученный в nextCursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
-// ListReservations converts echo context to params.
-func (w *ServerInterfaceWrapper) ListReservations(ctx echo.Context) error {
-	var err error
+// ListLibrariesParamsSort defines parameters for ListLibraries.
+type ListLibrariesParamsSort string
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListReservations(ctx)
-	return err
-}
+// ListLibrariesParamsOrder defines parameters for ListLibraries.
+type ListLibrariesParamsOrder string
 
-// TakeBook converts echo context to params.
-func (w *ServerInterfaceWrapper) TakeBook(ctx echo.Context) error {
-	var err error
+// ListNearbyLibrariesParams defines parameters for ListNearbyLibraries.
+type ListNearbyLibrariesParams struct {
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TakeBook(ctx)
-	return err
+	// Latitude Широта точки
+	Latitude float64 `form:"latitude" json:"latitude"`
+
+	// Longitude Долгота точки
+	Longitude float64 `form:"longitude" json:"longitude"`
+
+	// Radius Радиус поиска в километрах, по умолчанию 10
+	Radius *float64 `form:"radius,omitempty" json:"radius,omitempty"`
 }
 
-// ReturnBook converts echo context to params.
-func (w *ServerInterfaceWrapper) ReturnBook(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "reservationUid" -------------
-	var reservationUid openapi_types.UUID
+// ListBooksParams defines parameters for ListBooks.
+type ListBooksParams struct {
+	Page    *int  `form:"page,omitempty" json:"page,omitempty"`
+	Size    *int  `form:"size,omitempty" json:"size,omitempty"`
+	ShowAll *bool `form:"showAll,omitempty" json:"showAll,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "reservationUid", ctx.Param("reservationUid"), &reservationUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
-	}
+	// Genre Жанр
+	Genre *string `form:"genre,omitempty" json:"genre,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ReturnBook(ctx, reservationUid)
-	return err
-}
+	// Author Автор или его часть
+	Author *string `form:"author,omitempty" json:"author,omitempty"`
 
-// Health converts echo context to params.
-func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
-	var err error
+	// Condition Состояние экземпляров
+	Condition *ListBooksParamsCondition `form:"condition,omitempty" json:"condition,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.Health(ctx)
-	return err
-}
+	// NamePrefix Начало названия книги
+	NamePrefix *string `form:"namePrefix,omitempty" json:"namePrefix,omitempty"`
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+	// Sort Поле сортировки
+	Sort *ListBooksParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+	// Order Направление сортировки
+	Order *ListBooksParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор, полученный в nextCursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// ListBooksParamsCondition defines parameters for ListBooks.
+type ListBooksParamsCondition string
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+// ListBooksParamsSort defines parameters for ListBooks.
+type ListBooksParamsSort string
 
-	router.GET(baseURL+"/api/v1/libraries", wrapper.ListLibraries)
-	router.GET(baseURL+"/api/v1/libraries/:libraryUid/books", wrapper.ListBooks)
-	router.GET(baseURL+"/api/v1/rating", wrapper.GetRating)
-	router.GET(baseURL+"/api/v1/reservations", wrapper.ListReservations)
-	router.POST(baseURL+"/api/v1/reservations", wrapper.TakeBook)
-	router.POST(baseURL+"/api/v1/reservations/:reservationUid/return", wrapper.ReturnBook)
-	router.GET(baseURL+"/manage/health", wrapper.Health)
+// ListBooksParamsOrder defines parameters for ListBooks.
+type ListBooksParamsOrder string
 
-}
+// ListReservationsParams defines parameters for ListReservations.
+type ListReservationsParams struct {
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
 
-type ListLibrariesRequestObject struct {
-	Params ListLibrariesParams
-}
+	// Status Статусы бронирований
+	Status *[]ListReservationsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
 
-type ListLibrariesResponseObject interface {
-	VisitListLibrariesResponse(w http.ResponseWriter) error
-}
+	// Active Только незакрытые бронирования (PENDING, RENTED, OVERDUE)
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
 
-type ListLibraries200JSONResponse LibraryPaginationResponse
+	// From Бронирования, начатые не раньше этой даты
+	From *string `form:"from,omitempty" json:"from,omitempty"`
 
-func (response ListLibraries200JSONResponse) VisitListLibrariesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// To Бронирования, начатые не позже этой даты
+	To *string `form:"to,omitempty" json:"to,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Sort Поле сортировки
+	Sort *ListReservationsParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
 
-type ListBooksRequestObject struct {
-	LibraryUid openapi_types.UUID `json:"libraryUid"`
-	Params     ListBooksParams
-}
+	// Order Направление сортировки, по умолчанию сначала новые
+	Order *ListReservationsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
 
-type ListBooksResponseObject interface {
-	VisitListBooksResponse(w http.ResponseWriter) error
+	// Cursor Курсор, полученный в nextCursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
-type ListBooks200JSONResponse LibraryBookPaginationResponse
+// ListReservationsParamsStatus defines parameters for ListReservations.
+type ListReservationsParamsStatus string
 
-func (response ListBooks200JSONResponse) VisitListBooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListReservationsParamsSort defines parameters for ListReservations.
+type ListReservationsParamsSort string
 
-	return json.NewEncoder(w).Encode(response)
-}
+// ListReservationsParamsOrder defines parameters for ListReservations.
+type ListReservationsParamsOrder string
 
-type GetRatingRequestObject struct {
+// GetSeriesParams defines parameters for GetSeries.
+type GetSeriesParams struct {
+	// LibraryUid UUID библиотеки, в которой считать доступные экземпляры
+	LibraryUid *openapi_types.UUID `form:"libraryUid,omitempty" json:"libraryUid,omitempty"`
 }
 
-type GetRatingResponseObject interface {
-	VisitGetRatingResponse(w http.ResponseWriter) error
-}
+// ListPopularBooksParams defines parameters for ListPopularBooks.
+type ListPopularBooksParams struct {
+	// City Город
+	City *string `form:"city,omitempty" json:"city,omitempty"`
 
-type GetRating200JSONResponse UserRatingResponse
+	// Genre Жанр
+	Genre *string `form:"genre,omitempty" json:"genre,omitempty"`
 
-func (response GetRating200JSONResponse) VisitGetRatingResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// From Начало периода в формате YYYY-MM-DD, по умолчанию 30 дней назад
+	From *string `form:"from,omitempty" json:"from,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// To Конец периода в формате YYYY-MM-DD, по умолчанию сегодня
+	To *string `form:"to,omitempty" json:"to,omitempty"`
 
-type ListReservationsRequestObject struct {
+	// Limit Количество книг
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type ListReservationsResponseObject interface {
-	VisitListReservationsResponse(w http.ResponseWriter) error
+// ListSuggestionsParams defines parameters for ListSuggestions.
+type ListSuggestionsParams struct {
+	// Query Введенная пользователем строка
+	Query string `form:"query" json:"query"`
+
+	// Limit Количество подсказок
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type ListReservations200JSONResponse []BookReservationResponse
+// SetFineTariffJSONRequestBody defines body for SetFineTariff for application/json ContentType.
+type SetFineTariffJSONRequestBody = FineTariffRequest
 
-func (response ListReservations200JSONResponse) VisitListReservationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// WaiveFineJSONRequestBody defines body for WaiveFine for application/json ContentType.
+type WaiveFineJSONRequestBody = WaiveFineRequest
 
-	return json.NewEncoder(w).Encode(response)
-}
+// TakeBookJSONRequestBody defines body for TakeBook for application/json ContentType.
+type TakeBookJSONRequestBody = TakeBookRequest
 
-type TakeBookRequestObject struct {
-	Body *TakeBookJSONRequestBody
+// RenewBookJSONRequestBody defines body for RenewBook for application/json ContentType.
+type RenewBookJSONRequestBody = RenewBookRequest
+
+// ReturnBookJSONRequestBody defines body for ReturnBook for application/json ContentType.
+type ReturnBookJSONRequestBody = ReturnBookRequest
+
+// WriteOffBookJSONRequestBody defines body for WriteOffBook for application/json ContentType.
+type WriteOffBookJSONRequestBody = WriteOffRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Поиск книг с группировкой изданий по произведениям
+	// (GET /api/v1/books)
+	SearchBooks(ctx echo.Context, params SearchBooksParams) error
+	// Найти книгу по ISBN
+	// (GET /api/v1/books/isbn/{isbn})
+	GetBookByIsbn(ctx echo.Context, isbn string) error
+	// Получить обложку книги
+	// (GET /api/v1/books/{bookUid}/cover)
+	GetBookCover(ctx echo.Context, bookUid openapi_types.UUID, params GetBookCoverParams) error
+	// Получить список городов, в которых есть библиотеки
+	// (GET /api/v1/cities)
+	ListCities(ctx echo.Context) error
+	// Получить штрафы пользователя
+	// (GET /api/v1/fines)
+	ListFines(ctx echo.Context, params ListFinesParams) error
+	// Получить тарифы штрафов за просрочку
+	// (GET /api/v1/fines/tariffs)
+	ListFineTariffs(ctx echo.Context) error
+	// Задать тариф штрафа для библиотеки и жанра
+	// (PUT /api/v1/fines/tariffs)
+	SetFineTariff(ctx echo.Context) error
+	// Отметить штраф оплаченным
+	// (POST /api/v1/fines/{fineUid}/pay)
+	PayFine(ctx echo.Context, fineUid openapi_types.UUID) error
+	// Списать штраф
+	// (POST /api/v1/fines/{fineUid}/waive)
+	WaiveFine(ctx echo.Context, fineUid openapi_types.UUID) error
+	// Получить активные заявки пользователя
+	// (GET /api/v1/holds)
+	ListHolds(ctx echo.Context) error
+	// Отменить заявку
+	// (DELETE /api/v1/holds/{holdUid})
+	CancelHold(ctx echo.Context, holdUid openapi_types.UUID) error
+	// Получить заявку пользователя
+	// (GET /api/v1/holds/{holdUid})
+	GetHold(ctx echo.Context, holdUid openapi_types.UUID) error
+	// Получить список библиотек
+	// (GET /api/v1/libraries)
+	ListLibraries(ctx echo.Context, params ListLibrariesParams) error
+	// Найти библиотеки рядом с точкой
+	// (GET /api/v1/libraries/nearby)
+	ListNearbyLibraries(ctx echo.Context, params ListNearbyLibrariesParams) error
+	// Получить список книг в выбранной библиотеке
+	// (GET /api/v1/libraries/{libraryUid}/books)
+	ListBooks(ctx echo.Context, libraryUid openapi_types.UUID, params ListBooksParams) error
+	// Встать в очередь на книгу, которой нет в наличии
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/holds)
+	PlaceHold(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error
+	// Получить режим работы библиотеки
+	// (GET /api/v1/libraries/{libraryUid}/schedule)
+	GetLibrarySchedule(ctx echo.Context, libraryUid openapi_types.UUID) error
+	// Получить рейтинг пользователя
+	// (GET /api/v1/rating)
+	GetRating(ctx echo.Context) error
+	// Получить информацию по всем взятым в прокат книгам пользователя
+	// (GET /api/v1/reservations)
+	ListReservations(ctx echo.Context, params ListReservationsParams) error
+	// Взять книгу в библиотеке
+	// (POST /api/v1/reservations)
+	TakeBook(ctx echo.Context) error
+	// Отменить бронирование книги
+	// (DELETE /api/v1/reservations/{reservationUid})
+	CancelReservation(ctx echo.Context, reservationUid openapi_types.UUID) error
+	// Получить историю изменений бронирования
+	// (GET /api/v1/reservations/{reservationUid}/history)
+	GetReservationHistory(ctx echo.Context, reservationUid openapi_types.UUID) error
+	// Выдать заранее забронированную книгу
+	// (POST /api/v1/reservations/{reservationUid}/pickup)
+	PickupBook(ctx echo.Context, reservationUid openapi_types.UUID) error
+	// Продлить бронирование книги
+	// (POST /api/v1/reservations/{reservationUid}/renew)
+	RenewBook(ctx echo.Context, reservationUid openapi_types.UUID) error
+	// Вернуть книгу
+	// (POST /api/v1/reservations/{reservationUid}/return)
+	ReturnBook(ctx echo.Context, reservationUid openapi_types.UUID) error
+	// Списать книгу по бронированию как утерянную или испорченную
+	// (POST /api/v1/reservations/{reservationUid}/write-off)
+	WriteOffBook(ctx echo.Context, reservationUid openapi_types.UUID) error
+	// Получить тома серии и их доступность
+	// (GET /api/v1/series/{seriesUid})
+	GetSeries(ctx echo.Context, seriesUid openapi_types.UUID, params GetSeriesParams) error
+	// Получить самые популярные книги
+	// (GET /api/v1/statistics/popular-books)
+	ListPopularBooks(ctx echo.Context, params ListPopularBooksParams) error
+	// Подсказки для поиска по названиям, авторам и жанрам
+	// (GET /api/v1/suggestions)
+	ListSuggestions(ctx echo.Context, params ListSuggestionsParams) error
+	// Проверка живости сервиса
+	// (GET /manage/health)
+	Health(ctx echo.Context) error
 }
 
-type TakeBookResponseObject interface {
-	VisitTakeBookResponse(w http.ResponseWriter) error
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
 }
 
-type TakeBook200JSONResponse TakeBookResponse
+// SearchBooks converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchBooks(ctx echo.Context) error {
+	var err error
 
-func (response TakeBook200JSONResponse) VisitTakeBookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchBooksParams
+	// ------------- Optional query parameter "query" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
 
-type TakeBook400JSONResponse ValidationErrorResponse
+	// ------------- Optional query parameter "expandEditions" -------------
 
-func (response TakeBook400JSONResponse) VisitTakeBookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "expandEditions", ctx.QueryParams(), &params.ExpandEditions)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter expandEditions: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "page" -------------
 
-type ReturnBookRequestObject struct {
-	ReservationUid openapi_types.UUID `json:"reservationUid"`
-	Body           *ReturnBookJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
 
-type ReturnBookResponseObject interface {
-	VisitReturnBookResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "size" -------------
 
-type ReturnBook204Response struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
 
-func (response ReturnBook204Response) VisitReturnBookResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SearchBooks(ctx, params)
+	return err
 }
 
-type ReturnBook404JSONResponse ErrorResponse
+// GetBookByIsbn converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBookByIsbn(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "isbn" -------------
+	var isbn string
 
-func (response ReturnBook404JSONResponse) VisitReturnBookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindStyledParameterWithOptions("simple", "isbn", ctx.Param("isbn"), &isbn, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter isbn: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBookByIsbn(ctx, isbn)
+	return err
 }
 
-type HealthRequestObject struct {
-}
+// GetBookCover converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBookCover(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
 
-type HealthResponseObject interface {
-	VisitHealthResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
 
-type Health200Response struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBookCoverParams
+	// ------------- Optional query parameter "size" -------------
 
-func (response Health200Response) VisitHealthResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBookCover(ctx, bookUid, params)
+	return err
+}
+
+// ListCities converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCities(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListCities(ctx)
+	return err
+}
+
+// ListFines converts echo context to params.
+func (w *ServerInterfaceWrapper) ListFines(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFinesParams
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListFines(ctx, params)
+	return err
+}
+
+// ListFineTariffs converts echo context to params.
+func (w *ServerInterfaceWrapper) ListFineTariffs(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListFineTariffs(ctx)
+	return err
+}
+
+// SetFineTariff converts echo context to params.
+func (w *ServerInterfaceWrapper) SetFineTariff(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetFineTariff(ctx)
+	return err
+}
+
+// PayFine converts echo context to params.
+func (w *ServerInterfaceWrapper) PayFine(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "fineUid" -------------
+	var fineUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fineUid", ctx.Param("fineUid"), &fineUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fineUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PayFine(ctx, fineUid)
+	return err
+}
+
+// WaiveFine converts echo context to params.
+func (w *ServerInterfaceWrapper) WaiveFine(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "fineUid" -------------
+	var fineUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fineUid", ctx.Param("fineUid"), &fineUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fineUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WaiveFine(ctx, fineUid)
+	return err
+}
+
+// ListHolds converts echo context to params.
+func (w *ServerInterfaceWrapper) ListHolds(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListHolds(ctx)
+	return err
+}
+
+// CancelHold converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelHold(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "holdUid" -------------
+	var holdUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "holdUid", ctx.Param("holdUid"), &holdUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter holdUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelHold(ctx, holdUid)
+	return err
+}
+
+// GetHold converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHold(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "holdUid" -------------
+	var holdUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "holdUid", ctx.Param("holdUid"), &holdUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter holdUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHold(ctx, holdUid)
+	return err
+}
+
+// ListLibraries converts echo context to params.
+func (w *ServerInterfaceWrapper) ListLibraries(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLibrariesParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", ctx.QueryParams(), &params.City)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter city: %s", err))
+	}
+
+	// ------------- Optional query parameter "namePrefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namePrefix", ctx.QueryParams(), &params.NamePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namePrefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListLibraries(ctx, params)
+	return err
+}
+
+// ListNearbyLibraries converts echo context to params.
+func (w *ServerInterfaceWrapper) ListNearbyLibraries(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNearbyLibrariesParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Required query parameter "latitude" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "latitude", ctx.QueryParams(), &params.Latitude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter latitude: %s", err))
+	}
+
+	// ------------- Required query parameter "longitude" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "longitude", ctx.QueryParams(), &params.Longitude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter longitude: %s", err))
+	}
+
+	// ------------- Optional query parameter "radius" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "radius", ctx.QueryParams(), &params.Radius)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter radius: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListNearbyLibraries(ctx, params)
+	return err
+}
+
+// ListBooks converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBooks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBooksParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "showAll" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "showAll", ctx.QueryParams(), &params.ShowAll)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showAll: %s", err))
+	}
+
+	// ------------- Optional query parameter "genre" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "genre", ctx.QueryParams(), &params.Genre)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter genre: %s", err))
+	}
+
+	// ------------- Optional query parameter "author" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author", ctx.QueryParams(), &params.Author)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter author: %s", err))
+	}
+
+	// ------------- Optional query parameter "condition" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "condition", ctx.QueryParams(), &params.Condition)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter condition: %s", err))
+	}
+
+	// ------------- Optional query parameter "namePrefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namePrefix", ctx.QueryParams(), &params.NamePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namePrefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBooks(ctx, libraryUid, params)
+	return err
+}
+
+// PlaceHold converts echo context to params.
+func (w *ServerInterfaceWrapper) PlaceHold(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PlaceHold(ctx, libraryUid, bookUid)
+	return err
+}
+
+// GetLibrarySchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLibrarySchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLibrarySchedule(ctx, libraryUid)
+	return err
+}
+
+// GetRating converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRating(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRating(ctx)
+	return err
+}
+
+// ListReservations converts echo context to params.
+func (w *ServerInterfaceWrapper) ListReservations(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListReservationsParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", ctx.QueryParams(), &params.Active)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter active: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListReservations(ctx, params)
+	return err
+}
+
+// TakeBook converts echo context to params.
+func (w *ServerInterfaceWrapper) TakeBook(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TakeBook(ctx)
+	return err
+}
+
+// CancelReservation converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelReservation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reservationUid" -------------
+	var reservationUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationUid", ctx.Param("reservationUid"), &reservationUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelReservation(ctx, reservationUid)
+	return err
+}
+
+// GetReservationHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReservationHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reservationUid" -------------
+	var reservationUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationUid", ctx.Param("reservationUid"), &reservationUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReservationHistory(ctx, reservationUid)
+	return err
+}
+
+// PickupBook converts echo context to params.
+func (w *ServerInterfaceWrapper) PickupBook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reservationUid" -------------
+	var reservationUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationUid", ctx.Param("reservationUid"), &reservationUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PickupBook(ctx, reservationUid)
+	return err
+}
+
+// RenewBook converts echo context to params.
+func (w *ServerInterfaceWrapper) RenewBook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reservationUid" -------------
+	var reservationUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationUid", ctx.Param("reservationUid"), &reservationUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RenewBook(ctx, reservationUid)
+	return err
+}
+
+// ReturnBook converts echo context to params.
+func (w *ServerInterfaceWrapper) ReturnBook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reservationUid" -------------
+	var reservationUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationUid", ctx.Param("reservationUid"), &reservationUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReturnBook(ctx, reservationUid)
+	return err
+}
+
+// WriteOffBook converts echo context to params.
+func (w *ServerInterfaceWrapper) WriteOffBook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reservationUid" -------------
+	var reservationUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationUid", ctx.Param("reservationUid"), &reservationUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WriteOffBook(ctx, reservationUid)
+	return err
+}
+
+// GetSeries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSeries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "seriesUid" -------------
+	var seriesUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "seriesUid", ctx.Param("seriesUid"), &seriesUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seriesUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSeriesParams
+	// ------------- Optional query parameter "libraryUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "libraryUid", ctx.QueryParams(), &params.LibraryUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSeries(ctx, seriesUid, params)
+	return err
+}
+
+// ListPopularBooks converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPopularBooks(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPopularBooksParams
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", ctx.QueryParams(), &params.City)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter city: %s", err))
+	}
+
+	// ------------- Optional query parameter "genre" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "genre", ctx.QueryParams(), &params.Genre)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter genre: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPopularBooks(ctx, params)
+	return err
+}
+
+// ListSuggestions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSuggestions(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSuggestionsParams
+	// ------------- Required query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSuggestions(ctx, params)
+	return err
+}
+
+// Health converts echo context to params.
+func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Health(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/api/v1/books", wrapper.SearchBooks)
+	router.GET(baseURL+"/api/v1/books/isbn/:isbn", wrapper.GetBookByIsbn)
+	router.GET(baseURL+"/api/v1/books/:bookUid/cover", wrapper.GetBookCover)
+	router.GET(baseURL+"/api/v1/cities", wrapper.ListCities)
+	router.GET(baseURL+"/api/v1/fines", wrapper.ListFines)
+	router.GET(baseURL+"/api/v1/fines/tariffs", wrapper.ListFineTariffs)
+	router.PUT(baseURL+"/api/v1/fines/tariffs", wrapper.SetFineTariff)
+	router.POST(baseURL+"/api/v1/fines/:fineUid/pay", wrapper.PayFine)
+	router.POST(baseURL+"/api/v1/fines/:fineUid/waive", wrapper.WaiveFine)
+	router.GET(baseURL+"/api/v1/holds", wrapper.ListHolds)
+	router.DELETE(baseURL+"/api/v1/holds/:holdUid", wrapper.CancelHold)
+	router.GET(baseURL+"/api/v1/holds/:holdUid", wrapper.GetHold)
+	router.GET(baseURL+"/api/v1/libraries", wrapper.ListLibraries)
+	router.GET(baseURL+"/api/v1/libraries/nearby", wrapper.ListNearbyLibraries)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid/books", wrapper.ListBooks)
+	router.POST(baseURL+"/api/v1/libraries/:libraryUid/books/:bookUid/holds", wrapper.PlaceHold)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid/schedule", wrapper.GetLibrarySchedule)
+	router.GET(baseURL+"/api/v1/rating", wrapper.GetRating)
+	router.GET(baseURL+"/api/v1/reservations", wrapper.ListReservations)
+	router.POST(baseURL+"/api/v1/reservations", wrapper.TakeBook)
+	router.DELETE(baseURL+"/api/v1/reservations/:reservationUid", wrapper.CancelReservation)
+	router.GET(baseURL+"/api/v1/reservations/:reservationUid/history", wrapper.GetReservationHistory)
+	router.POST(baseURL+"/api/v1/reservations/:reservationUid/pickup", wrapper.PickupBook)
+	router.POST(baseURL+"/api/v1/reservations/:reservationUid/renew", wrapper.RenewBook)
+	router.POST(baseURL+"/api/v1/reservations/:reservationUid/return", wrapper.ReturnBook)
+	router.POST(baseURL+"/api/v1/reservations/:reservationUid/write-off", wrapper.WriteOffBook)
+	router.GET(baseURL+"/api/v1/series/:seriesUid", wrapper.GetSeries)
+	router.GET(baseURL+"/api/v1/statistics/popular-books", wrapper.ListPopularBooks)
+	router.GET(baseURL+"/api/v1/suggestions", wrapper.ListSuggestions)
+	router.GET(baseURL+"/manage/health", wrapper.Health)
+
+}
+
+type SearchBooksRequestObject struct {
+	Params SearchBooksParams
+}
+
+type SearchBooksResponseObject interface {
+	VisitSearchBooksResponse(w http.ResponseWriter) error
+}
+
+type SearchBooks200JSONResponse WorkSearchPaginationResponse
+
+func (response SearchBooks200JSONResponse) VisitSearchBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchBooks400JSONResponse ValidationErrorResponse
+
+func (response SearchBooks400JSONResponse) VisitSearchBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookByIsbnRequestObject struct {
+	Isbn string `json:"isbn"`
+}
+
+type GetBookByIsbnResponseObject interface {
+	VisitGetBookByIsbnResponse(w http.ResponseWriter) error
+}
+
+type GetBookByIsbn200JSONResponse BookInfo
+
+func (response GetBookByIsbn200JSONResponse) VisitGetBookByIsbnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookByIsbn400JSONResponse ValidationErrorResponse
+
+func (response GetBookByIsbn400JSONResponse) VisitGetBookByIsbnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookByIsbn404JSONResponse ErrorResponse
+
+func (response GetBookByIsbn404JSONResponse) VisitGetBookByIsbnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookCoverRequestObject struct {
+	BookUid openapi_types.UUID `json:"bookUid"`
+	Params  GetBookCoverParams
+}
+
+type GetBookCoverResponseObject interface {
+	VisitGetBookCoverResponse(w http.ResponseWriter) error
+}
+
+type GetBookCover200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+
+type GetBookCover200ImageResponse struct {
+	Body          io.Reader
+	Headers       GetBookCover200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetBookCover200ImageResponse) VisitGetBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetBookCover304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+
+type GetBookCover304Response struct {
+	Headers GetBookCover304ResponseHeaders
+}
+
+func (response GetBookCover304Response) VisitGetBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetBookCover404JSONResponse ErrorResponse
+
+func (response GetBookCover404JSONResponse) VisitGetBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCitiesRequestObject struct {
+}
+
+type ListCitiesResponseObject interface {
+	VisitListCitiesResponse(w http.ResponseWriter) error
+}
+
+type ListCities200JSONResponse []CityResponse
+
+func (response ListCities200JSONResponse) VisitListCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFinesRequestObject struct {
+	Params ListFinesParams
+}
+
+type ListFinesResponseObject interface {
+	VisitListFinesResponse(w http.ResponseWriter) error
+}
+
+type ListFines200JSONResponse []FineResponse
+
+func (response ListFines200JSONResponse) VisitListFinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFines403JSONResponse ErrorResponse
+
+func (response ListFines403JSONResponse) VisitListFinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFineTariffsRequestObject struct {
+}
+
+type ListFineTariffsResponseObject interface {
+	VisitListFineTariffsResponse(w http.ResponseWriter) error
+}
+
+type ListFineTariffs200JSONResponse []FineTariffResponse
+
+func (response ListFineTariffs200JSONResponse) VisitListFineTariffsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetFineTariffRequestObject struct {
+	Body *SetFineTariffJSONRequestBody
+}
+
+type SetFineTariffResponseObject interface {
+	VisitSetFineTariffResponse(w http.ResponseWriter) error
+}
+
+type SetFineTariff200JSONResponse FineTariffResponse
+
+func (response SetFineTariff200JSONResponse) VisitSetFineTariffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetFineTariff400JSONResponse ValidationErrorResponse
+
+func (response SetFineTariff400JSONResponse) VisitSetFineTariffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetFineTariff403JSONResponse ErrorResponse
+
+func (response SetFineTariff403JSONResponse) VisitSetFineTariffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayFineRequestObject struct {
+	FineUid openapi_types.UUID `json:"fineUid"`
+}
+
+type PayFineResponseObject interface {
+	VisitPayFineResponse(w http.ResponseWriter) error
+}
+
+type PayFine200JSONResponse FineResponse
+
+func (response PayFine200JSONResponse) VisitPayFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayFine403JSONResponse ErrorResponse
+
+func (response PayFine403JSONResponse) VisitPayFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayFine404JSONResponse ErrorResponse
+
+func (response PayFine404JSONResponse) VisitPayFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayFine409JSONResponse ErrorResponse
+
+func (response PayFine409JSONResponse) VisitPayFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaiveFineRequestObject struct {
+	FineUid openapi_types.UUID `json:"fineUid"`
+	Body    *WaiveFineJSONRequestBody
+}
+
+type WaiveFineResponseObject interface {
+	VisitWaiveFineResponse(w http.ResponseWriter) error
+}
+
+type WaiveFine200JSONResponse FineResponse
+
+func (response WaiveFine200JSONResponse) VisitWaiveFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaiveFine400JSONResponse ValidationErrorResponse
+
+func (response WaiveFine400JSONResponse) VisitWaiveFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaiveFine403JSONResponse ErrorResponse
+
+func (response WaiveFine403JSONResponse) VisitWaiveFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaiveFine404JSONResponse ErrorResponse
+
+func (response WaiveFine404JSONResponse) VisitWaiveFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaiveFine409JSONResponse ErrorResponse
+
+func (response WaiveFine409JSONResponse) VisitWaiveFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListHoldsRequestObject struct {
+}
+
+type ListHoldsResponseObject interface {
+	VisitListHoldsResponse(w http.ResponseWriter) error
+}
+
+type ListHolds200JSONResponse []HoldResponse
+
+func (response ListHolds200JSONResponse) VisitListHoldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelHoldRequestObject struct {
+	HoldUid openapi_types.UUID `json:"holdUid"`
+}
+
+type CancelHoldResponseObject interface {
+	VisitCancelHoldResponse(w http.ResponseWriter) error
+}
+
+type CancelHold204Response struct {
+}
+
+func (response CancelHold204Response) VisitCancelHoldResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelHold404JSONResponse ErrorResponse
+
+func (response CancelHold404JSONResponse) VisitCancelHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelHold409JSONResponse ErrorResponse
+
+func (response CancelHold409JSONResponse) VisitCancelHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHoldRequestObject struct {
+	HoldUid openapi_types.UUID `json:"holdUid"`
+}
+
+type GetHoldResponseObject interface {
+	VisitGetHoldResponse(w http.ResponseWriter) error
+}
+
+type GetHold200JSONResponse HoldResponse
+
+func (response GetHold200JSONResponse) VisitGetHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHold404JSONResponse ErrorResponse
+
+func (response GetHold404JSONResponse) VisitGetHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLibrariesRequestObject struct {
+	Params ListLibrariesParams
+}
+
+type ListLibrariesResponseObject interface {
+	VisitListLibrariesResponse(w http.ResponseWriter) error
+}
+
+type ListLibraries200JSONResponse LibraryPaginationResponse
+
+func (response ListLibraries200JSONResponse) VisitListLibrariesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLibraries400JSONResponse ValidationErrorResponse
+
+func (response ListLibraries400JSONResponse) VisitListLibrariesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNearbyLibrariesRequestObject struct {
+	Params ListNearbyLibrariesParams
+}
+
+type ListNearbyLibrariesResponseObject interface {
+	VisitListNearbyLibrariesResponse(w http.ResponseWriter) error
+}
+
+type ListNearbyLibraries200JSONResponse LibraryPaginationResponse
+
+func (response ListNearbyLibraries200JSONResponse) VisitListNearbyLibrariesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNearbyLibraries400JSONResponse ValidationErrorResponse
+
+func (response ListNearbyLibraries400JSONResponse) VisitListNearbyLibrariesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBooksRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	Params     ListBooksParams
+}
+
+type ListBooksResponseObject interface {
+	VisitListBooksResponse(w http.ResponseWriter) error
+}
+
+type ListBooks200JSONResponse LibraryBookPaginationResponse
+
+func (response ListBooks200JSONResponse) VisitListBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBooks400JSONResponse ValidationErrorResponse
+
+func (response ListBooks400JSONResponse) VisitListBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBooks404JSONResponse ErrorResponse
+
+func (response ListBooks404JSONResponse) VisitListBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceHoldRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	BookUid    openapi_types.UUID `json:"bookUid"`
+}
+
+type PlaceHoldResponseObject interface {
+	VisitPlaceHoldResponse(w http.ResponseWriter) error
+}
+
+type PlaceHold201JSONResponse HoldResponse
+
+func (response PlaceHold201JSONResponse) VisitPlaceHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceHold404JSONResponse ErrorResponse
+
+func (response PlaceHold404JSONResponse) VisitPlaceHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceHold409JSONResponse ErrorResponse
+
+func (response PlaceHold409JSONResponse) VisitPlaceHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLibraryScheduleRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+}
+
+type GetLibraryScheduleResponseObject interface {
+	VisitGetLibraryScheduleResponse(w http.ResponseWriter) error
+}
+
+type GetLibrarySchedule200JSONResponse LibraryScheduleResponse
+
+func (response GetLibrarySchedule200JSONResponse) VisitGetLibraryScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLibrarySchedule404JSONResponse ErrorResponse
+
+func (response GetLibrarySchedule404JSONResponse) VisitGetLibraryScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRatingRequestObject struct {
+}
+
+type GetRatingResponseObject interface {
+	VisitGetRatingResponse(w http.ResponseWriter) error
+}
+
+type GetRating200JSONResponse UserRatingResponse
+
+func (response GetRating200JSONResponse) VisitGetRatingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReservationsRequestObject struct {
+	Params ListReservationsParams
+}
+
+type ListReservationsResponseObject interface {
+	VisitListReservationsResponse(w http.ResponseWriter) error
+}
+
+type ListReservations200JSONResponse ReservationPaginationResponse
+
+func (response ListReservations200JSONResponse) VisitListReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListReservations400JSONResponse ValidationErrorResponse
+
+func (response ListReservations400JSONResponse) VisitListReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeBookRequestObject struct {
+	Body *TakeBookJSONRequestBody
+}
+
+type TakeBookResponseObject interface {
+	VisitTakeBookResponse(w http.ResponseWriter) error
+}
+
+type TakeBook200JSONResponse TakeBookResponse
+
+func (response TakeBook200JSONResponse) VisitTakeBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeBook400JSONResponse ValidationErrorResponse
+
+func (response TakeBook400JSONResponse) VisitTakeBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeBook404JSONResponse ErrorResponse
+
+func (response TakeBook404JSONResponse) VisitTakeBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeBook409JSONResponse ErrorResponse
+
+func (response TakeBook409JSONResponse) VisitTakeBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelReservationRequestObject struct {
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+}
+
+type CancelReservationResponseObject interface {
+	VisitCancelReservationResponse(w http.ResponseWriter) error
+}
+
+type CancelReservation204Response struct {
+}
+
+func (response CancelReservation204Response) VisitCancelReservationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelReservation404JSONResponse ErrorResponse
+
+func (response CancelReservation404JSONResponse) VisitCancelReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelReservation409JSONResponse ErrorResponse
+
+func (response CancelReservation409JSONResponse) VisitCancelReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReservationHistoryRequestObject struct {
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+}
+
+type GetReservationHistoryResponseObject interface {
+	VisitGetReservationHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetReservationHistory200JSONResponse []ReservationEventResponse
+
+func (response GetReservationHistory200JSONResponse) VisitGetReservationHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReservationHistory404JSONResponse ErrorResponse
+
+func (response GetReservationHistory404JSONResponse) VisitGetReservationHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PickupBookRequestObject struct {
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+}
+
+type PickupBookResponseObject interface {
+	VisitPickupBookResponse(w http.ResponseWriter) error
+}
+
+type PickupBook200JSONResponse BookReservationResponse
+
+func (response PickupBook200JSONResponse) VisitPickupBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PickupBook403JSONResponse ErrorResponse
+
+func (response PickupBook403JSONResponse) VisitPickupBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PickupBook404JSONResponse ErrorResponse
+
+func (response PickupBook404JSONResponse) VisitPickupBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PickupBook409JSONResponse ErrorResponse
+
+func (response PickupBook409JSONResponse) VisitPickupBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewBookRequestObject struct {
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+	Body           *RenewBookJSONRequestBody
+}
+
+type RenewBookResponseObject interface {
+	VisitRenewBookResponse(w http.ResponseWriter) error
+}
+
+type RenewBook200JSONResponse BookReservationResponse
+
+func (response RenewBook200JSONResponse) VisitRenewBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewBook400JSONResponse ValidationErrorResponse
+
+func (response RenewBook400JSONResponse) VisitRenewBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewBook404JSONResponse ErrorResponse
+
+func (response RenewBook404JSONResponse) VisitRenewBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewBook409JSONResponse ErrorResponse
+
+func (response RenewBook409JSONResponse) VisitRenewBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBookRequestObject struct {
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+	Body           *ReturnBookJSONRequestBody
+}
+
+type ReturnBookResponseObject interface {
+	VisitReturnBookResponse(w http.ResponseWriter) error
+}
+
+type ReturnBook204Response struct {
+}
+
+func (response ReturnBook204Response) VisitReturnBookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ReturnBook400JSONResponse ValidationErrorResponse
+
+func (response ReturnBook400JSONResponse) VisitReturnBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBook403JSONResponse ErrorResponse
+
+func (response ReturnBook403JSONResponse) VisitReturnBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBook404JSONResponse ErrorResponse
+
+func (response ReturnBook404JSONResponse) VisitReturnBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBook409JSONResponse ErrorResponse
+
+func (response ReturnBook409JSONResponse) VisitReturnBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WriteOffBookRequestObject struct {
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+	Body           *WriteOffBookJSONRequestBody
+}
+
+type WriteOffBookResponseObject interface {
+	VisitWriteOffBookResponse(w http.ResponseWriter) error
+}
+
+type WriteOffBook204Response struct {
+}
+
+func (response WriteOffBook204Response) VisitWriteOffBookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type WriteOffBook400JSONResponse ValidationErrorResponse
+
+func (response WriteOffBook400JSONResponse) VisitWriteOffBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WriteOffBook403JSONResponse ErrorResponse
+
+func (response WriteOffBook403JSONResponse) VisitWriteOffBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WriteOffBook404JSONResponse ErrorResponse
+
+func (response WriteOffBook404JSONResponse) VisitWriteOffBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WriteOffBook409JSONResponse ErrorResponse
+
+func (response WriteOffBook409JSONResponse) VisitWriteOffBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSeriesRequestObject struct {
+	SeriesUid openapi_types.UUID `json:"seriesUid"`
+	Params    GetSeriesParams
+}
+
+type GetSeriesResponseObject interface {
+	VisitGetSeriesResponse(w http.ResponseWriter) error
+}
+
+type GetSeries200JSONResponse SeriesResponse
+
+func (response GetSeries200JSONResponse) VisitGetSeriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSeries404JSONResponse ErrorResponse
+
+func (response GetSeries404JSONResponse) VisitGetSeriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPopularBooksRequestObject struct {
+	Params ListPopularBooksParams
+}
+
+type ListPopularBooksResponseObject interface {
+	VisitListPopularBooksResponse(w http.ResponseWriter) error
+}
+
+type ListPopularBooks200JSONResponse []PopularBookResponse
+
+func (response ListPopularBooks200JSONResponse) VisitListPopularBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPopularBooks400JSONResponse ValidationErrorResponse
+
+func (response ListPopularBooks400JSONResponse) VisitListPopularBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSuggestionsRequestObject struct {
+	Params ListSuggestionsParams
+}
+
+type ListSuggestionsResponseObject interface {
+	VisitListSuggestionsResponse(w http.ResponseWriter) error
+}
+
+type ListSuggestions200ResponseHeaders struct {
+	CacheControl string
+}
+
+type ListSuggestions200JSONResponse struct {
+	Body    []SuggestionResponse
+	Headers ListSuggestions200ResponseHeaders
+}
+
+func (response ListSuggestions200JSONResponse) VisitListSuggestionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ListSuggestions400JSONResponse ValidationErrorResponse
+
+func (response ListSuggestions400JSONResponse) VisitListSuggestionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HealthRequestObject struct {
+}
+
+type HealthResponseObject interface {
+	VisitHealthResponse(w http.ResponseWriter) error
+}
+
+type Health200Response struct {
+}
+
+func (response Health200Response) VisitHealthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Поиск книг с группировкой изданий по произведениям
+	// (GET /api/v1/books)
+	SearchBooks(ctx context.Context, request SearchBooksRequestObject) (SearchBooksResponseObject, error)
+	// Найти книгу по ISBN
+	// (GET /api/v1/books/isbn/{isbn})
+	GetBookByIsbn(ctx context.Context, request GetBookByIsbnRequestObject) (GetBookByIsbnResponseObject, error)
+	// Получить обложку книги
+	// (GET /api/v1/books/{bookUid}/cover)
+	GetBookCover(ctx context.Context, request GetBookCoverRequestObject) (GetBookCoverResponseObject, error)
+	// Получить список городов, в которых есть библиотеки
+	// (GET /api/v1/cities)
+	ListCities(ctx context.Context, request ListCitiesRequestObject) (ListCitiesResponseObject, error)
+	// Получить штрафы пользователя
+	// (GET /api/v1/fines)
+	ListFines(ctx context.Context, request ListFinesRequestObject) (ListFinesResponseObject, error)
+	// Получить тарифы штрафов за просрочку
+	// (GET /api/v1/fines/tariffs)
+	ListFineTariffs(ctx context.Context, request ListFineTariffsRequestObject) (ListFineTariffsResponseObject, error)
+	// Задать тариф штрафа для библиотеки и жанра
+	// (PUT /api/v1/fines/tariffs)
+	SetFineTariff(ctx context.Context, request SetFineTariffRequestObject) (SetFineTariffResponseObject, error)
+	// Отметить штраф оплаченным
+	// (POST /api/v1/fines/{fineUid}/pay)
+	PayFine(ctx context.Context, request PayFineRequestObject) (PayFineResponseObject, error)
+	// Списать штраф
+	// (POST /api/v1/fines/{fineUid}/waive)
+	WaiveFine(ctx context.Context, request WaiveFineRequestObject) (WaiveFineResponseObject, error)
+	// Получить активные заявки пользователя
+	// (GET /api/v1/holds)
+	ListHolds(ctx context.Context, request ListHoldsRequestObject) (ListHoldsResponseObject, error)
+	// Отменить заявку
+	// (DELETE /api/v1/holds/{holdUid})
+	CancelHold(ctx context.Context, request CancelHoldRequestObject) (CancelHoldResponseObject, error)
+	// Получить заявку пользователя
+	// (GET /api/v1/holds/{holdUid})
+	GetHold(ctx context.Context, request GetHoldRequestObject) (GetHoldResponseObject, error)
+	// Получить список библиотек
+	// (GET /api/v1/libraries)
+	ListLibraries(ctx context.Context, request ListLibrariesRequestObject) (ListLibrariesResponseObject, error)
+	// Найти библиотеки рядом с точкой
+	// (GET /api/v1/libraries/nearby)
+	ListNearbyLibraries(ctx context.Context, request ListNearbyLibrariesRequestObject) (ListNearbyLibrariesResponseObject, error)
+	// Получить список книг в выбранной библиотеке
+	// (GET /api/v1/libraries/{libraryUid}/books)
+	ListBooks(ctx context.Context, request ListBooksRequestObject) (ListBooksResponseObject, error)
+	// Встать в очередь на книгу, которой нет в наличии
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/holds)
+	PlaceHold(ctx context.Context, request PlaceHoldRequestObject) (PlaceHoldResponseObject, error)
+	// Получить режим работы библиотеки
+	// (GET /api/v1/libraries/{libraryUid}/schedule)
+	GetLibrarySchedule(ctx context.Context, request GetLibraryScheduleRequestObject) (GetLibraryScheduleResponseObject, error)
+	// Получить рейтинг пользователя
+	// (GET /api/v1/rating)
+	GetRating(ctx context.Context, request GetRatingRequestObject) (GetRatingResponseObject, error)
+	// Получить информацию по всем взятым в прокат книгам пользователя
+	// (GET /api/v1/reservations)
+	ListReservations(ctx context.Context, request ListReservationsRequestObject) (ListReservationsResponseObject, error)
+	// Взять книгу в библиотеке
+	// (POST /api/v1/reservations)
+	TakeBook(ctx context.Context, request TakeBookRequestObject) (TakeBookResponseObject, error)
+	// Отменить бронирование книги
+	// (DELETE /api/v1/reservations/{reservationUid})
+	CancelReservation(ctx context.Context, request CancelReservationRequestObject) (CancelReservationResponseObject, error)
+	// Получить историю изменений бронирования
+	// (GET /api/v1/reservations/{reservationUid}/history)
+	GetReservationHistory(ctx context.Context, request GetReservationHistoryRequestObject) (GetReservationHistoryResponseObject, error)
+	// Выдать заранее забронированную книгу
+	// (POST /api/v1/reservations/{reservationUid}/pickup)
+	PickupBook(ctx context.Context, request PickupBookRequestObject) (PickupBookResponseObject, error)
+	// Продлить бронирование книги
+	// (POST /api/v1/reservations/{reservationUid}/renew)
+	RenewBook(ctx context.Context, request RenewBookRequestObject) (RenewBookResponseObject, error)
+	// Вернуть книгу
+	// (POST /api/v1/reservations/{reservationUid}/return)
+	ReturnBook(ctx context.Context, request ReturnBookRequestObject) (ReturnBookResponseObject, error)
+	// Списать книгу по бронированию как утерянную или испорченную
+	// (POST /api/v1/reservations/{reservationUid}/write-off)
+	WriteOffBook(ctx context.Context, request WriteOffBookRequestObject) (WriteOffBookResponseObject, error)
+	// Получить тома серии и их доступность
+	// (GET /api/v1/series/{seriesUid})
+	GetSeries(ctx context.Context, request GetSeriesRequestObject) (GetSeriesResponseObject, error)
+	// Получить самые популярные книги
+	// (GET /api/v1/statistics/popular-books)
+	ListPopularBooks(ctx context.Context, request ListPopularBooksRequestObject) (ListPopularBooksResponseObject, error)
+	// Подсказки для поиска по названиям, авторам и жанрам
+	// (GET /api/v1/suggestions)
+	ListSuggestions(ctx context.Context, request ListSuggestionsRequestObject) (ListSuggestionsResponseObject, error)
+	// Проверка живости сервиса
+	// (GET /manage/health)
+	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// SearchBooks operation middleware
+func (sh *strictHandler) SearchBooks(ctx echo.Context, params SearchBooksParams) error {
+	var request SearchBooksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchBooks(ctx.Request().Context(), request.(SearchBooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchBooks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SearchBooksResponseObject); ok {
+		return validResponse.VisitSearchBooksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBookByIsbn operation middleware
+func (sh *strictHandler) GetBookByIsbn(ctx echo.Context, isbn string) error {
+	var request GetBookByIsbnRequestObject
+
+	request.Isbn = isbn
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBookByIsbn(ctx.Request().Context(), request.(GetBookByIsbnRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBookByIsbn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBookByIsbnResponseObject); ok {
+		return validResponse.VisitGetBookByIsbnResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBookCover operation middleware
+func (sh *strictHandler) GetBookCover(ctx echo.Context, bookUid openapi_types.UUID, params GetBookCoverParams) error {
+	var request GetBookCoverRequestObject
+
+	request.BookUid = bookUid
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBookCover(ctx.Request().Context(), request.(GetBookCoverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBookCover")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBookCoverResponseObject); ok {
+		return validResponse.VisitGetBookCoverResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListCities operation middleware
+func (sh *strictHandler) ListCities(ctx echo.Context) error {
+	var request ListCitiesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCities(ctx.Request().Context(), request.(ListCitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListCitiesResponseObject); ok {
+		return validResponse.VisitListCitiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListFines operation middleware
+func (sh *strictHandler) ListFines(ctx echo.Context, params ListFinesParams) error {
+	var request ListFinesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFines(ctx.Request().Context(), request.(ListFinesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFines")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListFinesResponseObject); ok {
+		return validResponse.VisitListFinesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListFineTariffs operation middleware
+func (sh *strictHandler) ListFineTariffs(ctx echo.Context) error {
+	var request ListFineTariffsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFineTariffs(ctx.Request().Context(), request.(ListFineTariffsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFineTariffs")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListFineTariffsResponseObject); ok {
+		return validResponse.VisitListFineTariffsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetFineTariff operation middleware
+func (sh *strictHandler) SetFineTariff(ctx echo.Context) error {
+	var request SetFineTariffRequestObject
+
+	var body SetFineTariffJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetFineTariff(ctx.Request().Context(), request.(SetFineTariffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetFineTariff")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetFineTariffResponseObject); ok {
+		return validResponse.VisitSetFineTariffResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PayFine operation middleware
+func (sh *strictHandler) PayFine(ctx echo.Context, fineUid openapi_types.UUID) error {
+	var request PayFineRequestObject
+
+	request.FineUid = fineUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PayFine(ctx.Request().Context(), request.(PayFineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PayFine")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PayFineResponseObject); ok {
+		return validResponse.VisitPayFineResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WaiveFine operation middleware
+func (sh *strictHandler) WaiveFine(ctx echo.Context, fineUid openapi_types.UUID) error {
+	var request WaiveFineRequestObject
+
+	request.FineUid = fineUid
+
+	var body WaiveFineJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WaiveFine(ctx.Request().Context(), request.(WaiveFineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WaiveFine")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WaiveFineResponseObject); ok {
+		return validResponse.VisitWaiveFineResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListHolds operation middleware
+func (sh *strictHandler) ListHolds(ctx echo.Context) error {
+	var request ListHoldsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListHolds(ctx.Request().Context(), request.(ListHoldsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListHolds")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListHoldsResponseObject); ok {
+		return validResponse.VisitListHoldsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CancelHold operation middleware
+func (sh *strictHandler) CancelHold(ctx echo.Context, holdUid openapi_types.UUID) error {
+	var request CancelHoldRequestObject
+
+	request.HoldUid = holdUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelHold(ctx.Request().Context(), request.(CancelHoldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelHold")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CancelHoldResponseObject); ok {
+		return validResponse.VisitCancelHoldResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Получить список библиотек в городе
-	// (GET /api/v1/libraries)
-	ListLibraries(ctx context.Context, request ListLibrariesRequestObject) (ListLibrariesResponseObject, error)
-	// Получить список книг в выбранной библиотеке
-	// (GET /api/v1/libraries/{libraryUid}/books)
-	ListBooks(ctx context.Context, request ListBooksRequestObject) (ListBooksResponseObject, error)
-	// Получить рейтинг пользователя
-	// (GET /api/v1/rating)
-	GetRating(ctx context.Context, request GetRatingRequestObject) (GetRatingResponseObject, error)
-	// Получить информацию по всем взятым в прокат книгам пользователя
-	// (GET /api/v1/reservations)
-	ListReservations(ctx context.Context, request ListReservationsRequestObject) (ListReservationsResponseObject, error)
-	// Взять книгу в библиотеке
-	// (POST /api/v1/reservations)
-	TakeBook(ctx context.Context, request TakeBookRequestObject) (TakeBookResponseObject, error)
-	// Вернуть книгу
-	// (POST /api/v1/reservations/{reservationUid}/return)
-	ReturnBook(ctx context.Context, request ReturnBookRequestObject) (ReturnBookResponseObject, error)
-	// Проверка живости сервиса
-	// (GET /manage/health)
-	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
-}
+// GetHold operation middleware
+func (sh *strictHandler) GetHold(ctx echo.Context, holdUid openapi_types.UUID) error {
+	var request GetHoldRequestObject
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	request.HoldUid = holdUid
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHold(ctx.Request().Context(), request.(GetHoldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHold")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetHoldResponseObject); ok {
+		return validResponse.VisitGetHoldResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
 // ListLibraries operation middleware
@@ -629,6 +3228,31 @@ func (sh *strictHandler) ListLibraries(ctx echo.Context, params ListLibrariesPar
 	return nil
 }
 
+// ListNearbyLibraries operation middleware
+func (sh *strictHandler) ListNearbyLibraries(ctx echo.Context, params ListNearbyLibrariesParams) error {
+	var request ListNearbyLibrariesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListNearbyLibraries(ctx.Request().Context(), request.(ListNearbyLibrariesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListNearbyLibraries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListNearbyLibrariesResponseObject); ok {
+		return validResponse.VisitListNearbyLibrariesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ListBooks operation middleware
 func (sh *strictHandler) ListBooks(ctx echo.Context, libraryUid openapi_types.UUID, params ListBooksParams) error {
 	var request ListBooksRequestObject
@@ -655,6 +3279,57 @@ func (sh *strictHandler) ListBooks(ctx echo.Context, libraryUid openapi_types.UU
 	return nil
 }
 
+// PlaceHold operation middleware
+func (sh *strictHandler) PlaceHold(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error {
+	var request PlaceHoldRequestObject
+
+	request.LibraryUid = libraryUid
+	request.BookUid = bookUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PlaceHold(ctx.Request().Context(), request.(PlaceHoldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PlaceHold")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PlaceHoldResponseObject); ok {
+		return validResponse.VisitPlaceHoldResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetLibrarySchedule operation middleware
+func (sh *strictHandler) GetLibrarySchedule(ctx echo.Context, libraryUid openapi_types.UUID) error {
+	var request GetLibraryScheduleRequestObject
+
+	request.LibraryUid = libraryUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLibrarySchedule(ctx.Request().Context(), request.(GetLibraryScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLibrarySchedule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetLibraryScheduleResponseObject); ok {
+		return validResponse.VisitGetLibraryScheduleResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetRating operation middleware
 func (sh *strictHandler) GetRating(ctx echo.Context) error {
 	var request GetRatingRequestObject
@@ -679,9 +3354,11 @@ func (sh *strictHandler) GetRating(ctx echo.Context) error {
 }
 
 // ListReservations operation middleware
-func (sh *strictHandler) ListReservations(ctx echo.Context) error {
+func (sh *strictHandler) ListReservations(ctx echo.Context, params ListReservationsParams) error {
 	var request ListReservationsRequestObject
 
+	request.Params = params
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.ListReservations(ctx.Request().Context(), request.(ListReservationsRequestObject))
 	}
@@ -730,6 +3407,112 @@ func (sh *strictHandler) TakeBook(ctx echo.Context) error {
 	return nil
 }
 
+// CancelReservation operation middleware
+func (sh *strictHandler) CancelReservation(ctx echo.Context, reservationUid openapi_types.UUID) error {
+	var request CancelReservationRequestObject
+
+	request.ReservationUid = reservationUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelReservation(ctx.Request().Context(), request.(CancelReservationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelReservation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CancelReservationResponseObject); ok {
+		return validResponse.VisitCancelReservationResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetReservationHistory operation middleware
+func (sh *strictHandler) GetReservationHistory(ctx echo.Context, reservationUid openapi_types.UUID) error {
+	var request GetReservationHistoryRequestObject
+
+	request.ReservationUid = reservationUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReservationHistory(ctx.Request().Context(), request.(GetReservationHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReservationHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetReservationHistoryResponseObject); ok {
+		return validResponse.VisitGetReservationHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PickupBook operation middleware
+func (sh *strictHandler) PickupBook(ctx echo.Context, reservationUid openapi_types.UUID) error {
+	var request PickupBookRequestObject
+
+	request.ReservationUid = reservationUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PickupBook(ctx.Request().Context(), request.(PickupBookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PickupBook")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PickupBookResponseObject); ok {
+		return validResponse.VisitPickupBookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// RenewBook operation middleware
+func (sh *strictHandler) RenewBook(ctx echo.Context, reservationUid openapi_types.UUID) error {
+	var request RenewBookRequestObject
+
+	request.ReservationUid = reservationUid
+
+	var body RenewBookJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RenewBook(ctx.Request().Context(), request.(RenewBookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RenewBook")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RenewBookResponseObject); ok {
+		return validResponse.VisitRenewBookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // ReturnBook operation middleware
 func (sh *strictHandler) ReturnBook(ctx echo.Context, reservationUid openapi_types.UUID) error {
 	var request ReturnBookRequestObject
@@ -761,6 +3544,113 @@ func (sh *strictHandler) ReturnBook(ctx echo.Context, reservationUid openapi_typ
 	return nil
 }
 
+// WriteOffBook operation middleware
+func (sh *strictHandler) WriteOffBook(ctx echo.Context, reservationUid openapi_types.UUID) error {
+	var request WriteOffBookRequestObject
+
+	request.ReservationUid = reservationUid
+
+	var body WriteOffBookJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WriteOffBook(ctx.Request().Context(), request.(WriteOffBookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WriteOffBook")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WriteOffBookResponseObject); ok {
+		return validResponse.VisitWriteOffBookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSeries operation middleware
+func (sh *strictHandler) GetSeries(ctx echo.Context, seriesUid openapi_types.UUID, params GetSeriesParams) error {
+	var request GetSeriesRequestObject
+
+	request.SeriesUid = seriesUid
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSeries(ctx.Request().Context(), request.(GetSeriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSeries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSeriesResponseObject); ok {
+		return validResponse.VisitGetSeriesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListPopularBooks operation middleware
+func (sh *strictHandler) ListPopularBooks(ctx echo.Context, params ListPopularBooksParams) error {
+	var request ListPopularBooksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPopularBooks(ctx.Request().Context(), request.(ListPopularBooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPopularBooks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListPopularBooksResponseObject); ok {
+		return validResponse.VisitListPopularBooksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListSuggestions operation middleware
+func (sh *strictHandler) ListSuggestions(ctx echo.Context, params ListSuggestionsParams) error {
+	var request ListSuggestionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSuggestions(ctx.Request().Context(), request.(ListSuggestionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSuggestions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListSuggestionsResponseObject); ok {
+		return validResponse.VisitListSuggestionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Health operation middleware
 func (sh *strictHandler) Health(ctx echo.Context) error {
 	var request HealthRequestObject
@@ -3,7 +3,9 @@ package openapi
 import (
 	"context"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/muhomorfus/ds-lab-02/services/auth/contextutils"
+	"github.com/muhomorfus/ds-lab-02/services/gateway/internal/cache"
 	"github.com/muhomorfus/ds-lab-02/services/gateway/internal/clients/library"
 	"github.com/muhomorfus/ds-lab-02/services/gateway/internal/clients/rating"
 	"github.com/muhomorfus/ds-lab-02/services/gateway/internal/clients/reservation"
@@ -11,31 +13,57 @@ import (
 	"github.com/samber/lo"
 	"log/slog"
 	"net/http"
+	"strings"
+	"time"
 )
 
 type Server struct {
 	library     *library.ClientWithResponses
 	reservation *reservation.ClientWithResponses
 	rating      *rating.ClientWithResponses
+	suggestions *cache.TTL[suggestionsKey, []generated.SuggestionResponse]
 }
 
+type suggestionsKey struct {
+	query string
+	limit int
+}
+
+const (
+	suggestionsTTL       = 30 * time.Second
+	suggestionsCacheSize = 10000
+)
+
 func New(library *library.ClientWithResponses, reservation *reservation.ClientWithResponses, rating *rating.ClientWithResponses) *Server {
-	return &Server{library: library, reservation: reservation, rating: rating}
+	return &Server{
+		library:     library,
+		reservation: reservation,
+		rating:      rating,
+		suggestions: cache.NewTTL[suggestionsKey, []generated.SuggestionResponse](suggestionsTTL, suggestionsCacheSize),
+	}
 }
 
 func (s *Server) ListLibraries(ctx context.Context, request generated.ListLibrariesRequestObject) (generated.ListLibrariesResponseObject, error) {
 	logger := slog.With("handler", "ListLibraries")
 
 	resp, err := s.library.ListLibrariesWithResponse(ctx, &library.ListLibrariesParams{
-		Page: request.Params.Page,
-		Size: request.Params.Size,
-		City: request.Params.City,
+		Page:       request.Params.Page,
+		Size:       request.Params.Size,
+		City:       request.Params.City,
+		NamePrefix: request.Params.NamePrefix,
+		Sort:       (*library.ListLibrariesParamsSort)(request.Params.Sort),
+		Order:      (*library.ListLibrariesParamsOrder)(request.Params.Order),
+		Cursor:     request.Params.Cursor,
 	}, s.token(ctx))
 	if err != nil {
 		logger.Error("list libraries", "error", err)
 		return nil, fmt.Errorf("list libraries: %w", err)
 	}
 
+	if resp.JSON400 != nil {
+		return generated.ListLibraries400JSONResponse(toValidationError(*resp.JSON400)), nil
+	}
+
 	if resp.JSON200 == nil {
 		logger.Error("list libraries unknown status", "status", resp.StatusCode())
 		return nil, fmt.Errorf("list libraries: %s", string(resp.Body))
@@ -48,6 +76,92 @@ func (s *Server) ListLibraries(ctx context.Context, request generated.ListLibrar
 		Page:          resp.JSON200.Page,
 		PageSize:      resp.JSON200.PageSize,
 		TotalElements: resp.JSON200.TotalElements,
+		NextCursor:    resp.JSON200.NextCursor,
+	}, nil
+}
+
+func (s *Server) ListNearbyLibraries(ctx context.Context, request generated.ListNearbyLibrariesRequestObject) (generated.ListNearbyLibrariesResponseObject, error) {
+	logger := slog.With("handler", "ListNearbyLibraries")
+
+	resp, err := s.library.ListNearbyLibrariesWithResponse(ctx, &library.ListNearbyLibrariesParams{
+		Page:      request.Params.Page,
+		Size:      request.Params.Size,
+		Latitude:  request.Params.Latitude,
+		Longitude: request.Params.Longitude,
+		Radius:    request.Params.Radius,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("list nearby libraries", "error", err)
+		return nil, fmt.Errorf("list nearby libraries: %w", err)
+	}
+
+	if resp.JSON400 != nil {
+		return generated.ListNearbyLibraries400JSONResponse(toValidationError(*resp.JSON400)), nil
+	}
+
+	if resp.JSON200 == nil {
+		logger.Error("list nearby libraries unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("list nearby libraries: %s", string(resp.Body))
+	}
+
+	return generated.ListNearbyLibraries200JSONResponse{
+		Items: lo.Map(resp.JSON200.Items, func(item library.LibraryResponse, _ int) generated.LibraryResponse {
+			return generated.LibraryResponse(item)
+		}),
+		Page:          resp.JSON200.Page,
+		PageSize:      resp.JSON200.PageSize,
+		TotalElements: resp.JSON200.TotalElements,
+	}, nil
+}
+
+func (s *Server) ListCities(ctx context.Context, request generated.ListCitiesRequestObject) (generated.ListCitiesResponseObject, error) {
+	logger := slog.With("handler", "ListCities")
+
+	resp, err := s.library.ListCitiesWithResponse(ctx, s.token(ctx))
+	if err != nil {
+		logger.Error("list cities", "error", err)
+		return nil, fmt.Errorf("list cities: %w", err)
+	}
+
+	if resp.JSON200 == nil {
+		logger.Error("list cities unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("list cities: %s", string(resp.Body))
+	}
+
+	return generated.ListCities200JSONResponse(lo.Map(*resp.JSON200, func(item library.CityResponse, _ int) generated.CityResponse {
+		return generated.CityResponse(item)
+	})), nil
+}
+
+func (s *Server) GetLibrarySchedule(ctx context.Context, request generated.GetLibraryScheduleRequestObject) (generated.GetLibraryScheduleResponseObject, error) {
+	logger := slog.With("handler", "GetLibrarySchedule")
+
+	resp, err := s.library.GetLibraryScheduleWithResponse(ctx, request.LibraryUid, s.token(ctx))
+	if err != nil {
+		logger.Error("get library schedule", "error", err)
+		return nil, fmt.Errorf("get library schedule: %w", err)
+	}
+
+	if resp.JSON404 != nil {
+		return generated.GetLibrarySchedule404JSONResponse{
+			Message: resp.JSON404.Message,
+		}, nil
+	}
+
+	if resp.JSON200 == nil {
+		logger.Error("get library schedule unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("get library schedule: %s", string(resp.Body))
+	}
+
+	return generated.GetLibrarySchedule200JSONResponse{
+		LibraryUid: resp.JSON200.LibraryUid,
+		Timezone:   resp.JSON200.Timezone,
+		OpeningHours: lo.Map(resp.JSON200.OpeningHours, func(item library.OpeningHours, _ int) generated.OpeningHours {
+			return generated.OpeningHours(item)
+		}),
+		Holidays: lo.Map(resp.JSON200.Holidays, func(item library.HolidayResponse, _ int) generated.HolidayResponse {
+			return generated.HolidayResponse(item)
+		}),
 	}, nil
 }
 
@@ -55,15 +169,32 @@ func (s *Server) ListBooks(ctx context.Context, request generated.ListBooksReque
 	logger := slog.With("handler", "ListBooks")
 
 	resp, err := s.library.ListBooksWithResponse(ctx, request.LibraryUid, &library.ListBooksParams{
-		Page:    request.Params.Page,
-		Size:    request.Params.Size,
-		ShowAll: request.Params.ShowAll,
+		Page:       request.Params.Page,
+		Size:       request.Params.Size,
+		ShowAll:    request.Params.ShowAll,
+		Genre:      request.Params.Genre,
+		Author:     request.Params.Author,
+		Condition:  (*library.ListBooksParamsCondition)(request.Params.Condition),
+		NamePrefix: request.Params.NamePrefix,
+		Sort:       (*library.ListBooksParamsSort)(request.Params.Sort),
+		Order:      (*library.ListBooksParamsOrder)(request.Params.Order),
+		Cursor:     request.Params.Cursor,
 	}, s.token(ctx))
 	if err != nil {
 		logger.Error("list books", "error", err)
 		return nil, fmt.Errorf("list books: %w", err)
 	}
 
+	if resp.JSON400 != nil {
+		return generated.ListBooks400JSONResponse(toValidationError(*resp.JSON400)), nil
+	}
+
+	if resp.JSON404 != nil {
+		return generated.ListBooks404JSONResponse{
+			Message: resp.JSON404.Message,
+		}, nil
+	}
+
 	if resp.JSON200 == nil {
 		logger.Error("list books unknown status", "status", resp.StatusCode())
 		return nil, fmt.Errorf("list books: %s", string(resp.Body))
@@ -72,293 +203,1495 @@ func (s *Server) ListBooks(ctx context.Context, request generated.ListBooksReque
 	return generated.ListBooks200JSONResponse{
 		Items: lo.Map(resp.JSON200.Items, func(item library.LibraryBookResponse, _ int) generated.LibraryBookResponse {
 			return generated.LibraryBookResponse{
-				Author:         item.Author,
-				AvailableCount: item.AvailableCount,
-				BookUid:        item.BookUid,
-				Condition:      generated.LibraryBookResponseCondition(item.Condition),
-				Genre:          item.Genre,
-				Name:           item.Name,
+				Author:            item.Author,
+				AvailableCount:    item.AvailableCount,
+				BookUid:           item.BookUid,
+				Condition:         generated.LibraryBookResponseCondition(item.Condition),
+				Genre:             item.Genre,
+				Name:              item.Name,
+				Isbn10:            item.Isbn10,
+				Isbn13:            item.Isbn13,
+				PublicationYear:   item.PublicationYear,
+				Publisher:         item.Publisher,
+				Language:          item.Language,
+				PageCount:         item.PageCount,
+				Description:       item.Description,
+				CoverUrl:          item.CoverUrl,
+				CoverThumbnailUrl: item.CoverThumbnailUrl,
 			}
 		}),
 		Page:          resp.JSON200.Page,
 		PageSize:      resp.JSON200.PageSize,
 		TotalElements: resp.JSON200.TotalElements,
+		NextCursor:    resp.JSON200.NextCursor,
 	}, nil
 }
 
-func (s *Server) GetRating(ctx context.Context, request generated.GetRatingRequestObject) (generated.GetRatingResponseObject, error) {
-	logger := slog.With("handler", "GetRating")
+func (s *Server) GetBookCover(ctx context.Context, request generated.GetBookCoverRequestObject) (generated.GetBookCoverResponseObject, error) {
+	logger := slog.With("handler", "GetBookCover")
 
-	resp, err := s.rating.GetWithResponse(ctx, s.token(ctx))
+	resp, err := s.library.GetBookCover(ctx, request.BookUid, &library.GetBookCoverParams{
+		Size:        (*library.GetBookCoverParamsSize)(request.Params.Size),
+		IfNoneMatch: request.Params.IfNoneMatch,
+	}, s.token(ctx))
 	if err != nil {
-		logger.Error("get rating", "error", err)
-		return nil, fmt.Errorf("get rating: %w", err)
+		logger.Error("get book cover", "error", err)
+		return nil, fmt.Errorf("get book cover: %w", err)
 	}
 
-	if resp.JSON200 == nil {
-		logger.Error("get rating unknown status", "status", resp.StatusCode())
-		return nil, fmt.Errorf("get user rating: %s", string(resp.Body))
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return generated.GetBookCover200ImageResponse{
+			Body:          resp.Body,
+			ContentType:   resp.Header.Get("Content-Type"),
+			ContentLength: max(resp.ContentLength, 0),
+			Headers: generated.GetBookCover200ResponseHeaders{
+				CacheControl: resp.Header.Get("Cache-Control"),
+				ETag:         resp.Header.Get("ETag"),
+			},
+		}, nil
+	case http.StatusNotModified:
+		resp.Body.Close()
+		return generated.GetBookCover304Response{
+			Headers: generated.GetBookCover304ResponseHeaders{
+				CacheControl: resp.Header.Get("Cache-Control"),
+				ETag:         resp.Header.Get("ETag"),
+			},
+		}, nil
 	}
 
-	return generated.GetRating200JSONResponse{
-		Stars: resp.JSON200.Stars,
-	}, nil
-}
-
-func (s *Server) ListReservations(ctx context.Context, request generated.ListReservationsRequestObject) (generated.ListReservationsResponseObject, error) {
-	logger := slog.With("handler", "ListReservations")
-
-	resp, err := s.reservation.ListWithResponse(ctx, s.token(ctx))
+	parsed, err := library.ParseGetBookCoverResponse(resp)
 	if err != nil {
-		logger.Error("list reservations", "error", err)
-		return nil, fmt.Errorf("list reservations: %w", err)
+		logger.Error("parse book cover response", "error", err)
+		return nil, fmt.Errorf("parse book cover response: %w", err)
 	}
 
-	if resp.JSON200 == nil {
-		logger.Error("list reservations unknown status", "status", resp.StatusCode())
-		return nil, fmt.Errorf("list reservations: %s", string(resp.Body))
+	if parsed.JSON404 != nil {
+		return generated.GetBookCover404JSONResponse{
+			Message: parsed.JSON404.Message,
+		}, nil
 	}
 
-	var result []generated.BookReservationResponse
-	for _, r := range *resp.JSON200 {
-		book := generated.BookInfo{
-			BookUid: r.BookUid,
-		}
+	logger.Error("get book cover unknown status", "status", parsed.StatusCode())
+	return nil, fmt.Errorf("get book cover: %s", string(parsed.Body))
+}
 
-		bookResp, err := s.library.GetBookWithResponse(ctx, r.BookUid, s.token(ctx))
-		if err == nil && bookResp.JSON200 != nil {
-			book = generated.BookInfo{
-				Author:  bookResp.JSON200.Author,
-				BookUid: bookResp.JSON200.BookUid,
-				Genre:   bookResp.JSON200.Genre,
-				Name:    bookResp.JSON200.Name,
-			}
-		}
+func (s *Server) SearchBooks(ctx context.Context, request generated.SearchBooksRequestObject) (generated.SearchBooksResponseObject, error) {
+	logger := slog.With("handler", "SearchBooks")
 
-		lib := generated.LibraryResponse{
-			LibraryUid: r.LibraryUid,
-		}
+	resp, err := s.library.SearchBooksWithResponse(ctx, &library.SearchBooksParams{
+		Query:          request.Params.Query,
+		ExpandEditions: request.Params.ExpandEditions,
+		Page:           request.Params.Page,
+		Size:           request.Params.Size,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("search books", "error", err)
+		return nil, fmt.Errorf("search books: %w", err)
+	}
 
-		libraryResp, err := s.library.GetLibraryWithResponse(ctx, r.LibraryUid, s.token(ctx))
-		if err == nil && libraryResp.JSON200 != nil {
-			lib = generated.LibraryResponse{
-				Address:    libraryResp.JSON200.Address,
-				City:       libraryResp.JSON200.City,
-				LibraryUid: libraryResp.JSON200.LibraryUid,
-				Name:       libraryResp.JSON200.Name,
-			}
-		}
+	if resp.JSON400 != nil {
+		return generated.SearchBooks400JSONResponse(toValidationError(*resp.JSON400)), nil
+	}
 
-		result = append(result, generated.BookReservationResponse{
-			Book:           book,
-			Library:        lib,
-			ReservationUid: r.ReservationUid,
-			StartDate:      r.StartDate,
-			Status:         generated.BookReservationResponseStatus(r.Status),
-			TillDate:       r.TillDate,
-		})
+	if resp.JSON200 == nil {
+		logger.Error("search books unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("search books: %s", string(resp.Body))
 	}
 
-	return generated.ListReservations200JSONResponse(result), nil
+	return generated.SearchBooks200JSONResponse{
+		Items: lo.Map(resp.JSON200.Items, func(item library.WorkSearchResult, _ int) generated.WorkSearchResult {
+			return generated.WorkSearchResult{
+				WorkUid:       item.WorkUid,
+				Title:         item.Title,
+				Author:        item.Author,
+				Book:          generated.BookInfo(item.Book),
+				EditionsCount: item.EditionsCount,
+				OtherEditions: toBookInfos(item.OtherEditions),
+			}
+		}),
+		Page:          resp.JSON200.Page,
+		PageSize:      resp.JSON200.PageSize,
+		TotalElements: resp.JSON200.TotalElements,
+	}, nil
 }
 
-func (s *Server) TakeBook(ctx context.Context, request generated.TakeBookRequestObject) (generated.TakeBookResponseObject, error) {
-	logger := slog.With("handler", "TakeBook")
+func (s *Server) GetSeries(ctx context.Context, request generated.GetSeriesRequestObject) (generated.GetSeriesResponseObject, error) {
+	logger := slog.With("handler", "GetSeries")
 
-	reservationResp, err := s.reservation.ListWithResponse(ctx, s.token(ctx))
+	resp, err := s.library.GetSeriesWithResponse(ctx, request.SeriesUid, &library.GetSeriesParams{
+		LibraryUid: request.Params.LibraryUid,
+	}, s.token(ctx))
 	if err != nil {
-		logger.Error("get user reservation", "error", err)
-		return nil, fmt.Errorf("get user reservation: %w", err)
+		logger.Error("get series", "error", err)
+		return nil, fmt.Errorf("get series: %w", err)
 	}
 
-	if reservationResp.JSON200 == nil {
-		logger.Error("get user reservation unknown response", "status", reservationResp.StatusCode())
-		return nil, fmt.Errorf("get user reservation: empty response")
+	if resp.JSON404 != nil {
+		return generated.GetSeries404JSONResponse{
+			Message: resp.JSON404.Message,
+		}, nil
 	}
 
-	reserved := lo.Reduce(*reservationResp.JSON200, func(agg int, item reservation.BookReservationResponse, _ int) int {
-		if item.Status == reservation.BookReservationResponseStatusRENTED {
-			return agg + 1
-		}
+	if resp.JSON200 == nil {
+		logger.Error("get series unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("get series: %s", string(resp.Body))
+	}
+
+	return generated.GetSeries200JSONResponse{
+		SeriesUid: resp.JSON200.SeriesUid,
+		Name:      resp.JSON200.Name,
+		Author:    resp.JSON200.Author,
+		Volumes: lo.Map(resp.JSON200.Volumes, func(item library.SeriesVolumeResponse, _ int) generated.SeriesVolumeResponse {
+			return generated.SeriesVolumeResponse{
+				VolumeNumber:   item.VolumeNumber,
+				Book:           generated.BookInfo(item.Book),
+				AvailableCount: item.AvailableCount,
+			}
+		}),
+	}, nil
+}
 
-		return agg
-	}, 0)
+func (s *Server) ListSuggestions(ctx context.Context, request generated.ListSuggestionsRequestObject) (generated.ListSuggestionsResponseObject, error) {
+	logger := slog.With("handler", "ListSuggestions")
 
-	ratingResp, err := s.rating.GetWithResponse(ctx, s.token(ctx))
-	if err != nil {
-		logger.Error("get user rating", "error", err)
-		return nil, fmt.Errorf("get user rating: %w", err)
+	key := suggestionsKey{
+		query: strings.ToLower(strings.TrimSpace(request.Params.Query)),
+		limit: lo.FromPtr(request.Params.Limit),
 	}
 
-	if ratingResp.JSON200 == nil {
-		logger.Error("get user rating unknown response", "status", ratingResp.StatusCode())
-		return nil, fmt.Errorf("get user rating: empty response")
+	headers := generated.ListSuggestions200ResponseHeaders{
+		CacheControl: fmt.Sprintf("public, max-age=%d", int(suggestionsTTL.Seconds())),
 	}
 
-	canReserve := ratingResp.JSON200.Stars - reserved
-	if canReserve < 0 {
-		return generated.TakeBook400JSONResponse{
-			Message: "too many taken books",
+	if suggestions, ok := s.suggestions.Get(key); ok {
+		return generated.ListSuggestions200JSONResponse{
+			Body:    suggestions,
+			Headers: headers,
 		}, nil
 	}
 
-	reservedResp, err := s.reservation.CreateWithResponse(ctx, reservation.CreateJSONRequestBody{
-		BookUid:    request.Body.BookUid,
-		LibraryUid: request.Body.LibraryUid,
-		TillDate:   request.Body.TillDate,
+	resp, err := s.library.ListSuggestionsWithResponse(ctx, &library.ListSuggestionsParams{
+		Query: request.Params.Query,
+		Limit: request.Params.Limit,
 	}, s.token(ctx))
 	if err != nil {
-		logger.Error("reserve book", "error", err)
-		return nil, fmt.Errorf("reserve book: %w", err)
+		logger.Error("list suggestions", "error", err)
+		return nil, fmt.Errorf("list suggestions: %w", err)
 	}
 
-	if reservedResp.JSON400 != nil {
-		return generated.TakeBook400JSONResponse{
-			Message: reservedResp.JSON400.Message,
-		}, nil
+	if resp.JSON400 != nil {
+		return generated.ListSuggestions400JSONResponse(toValidationError(*resp.JSON400)), nil
 	}
 
-	if reservedResp.JSON200 == nil {
-		logger.Error("reserve book unknown status", "status", reservedResp.StatusCode())
-		return nil, fmt.Errorf("reserve book: %s", string(reservedResp.Body))
+	if resp.JSON200 == nil {
+		logger.Error("list suggestions unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("list suggestions: %s", string(resp.Body))
 	}
 
-	bookResp, err := s.library.TakeBookWithResponse(ctx, request.Body.LibraryUid, request.Body.BookUid, s.token(ctx))
+	suggestions := lo.Map(*resp.JSON200, func(item library.SuggestionResponse, _ int) generated.SuggestionResponse {
+		return generated.SuggestionResponse{
+			Text:    item.Text,
+			Kind:    generated.SuggestionResponseKind(item.Kind),
+			BookUid: item.BookUid,
+		}
+	})
+
+	s.suggestions.Set(key, suggestions)
+
+	return generated.ListSuggestions200JSONResponse{
+		Body:    suggestions,
+		Headers: headers,
+	}, nil
+}
+
+func (s *Server) GetBookByIsbn(ctx context.Context, request generated.GetBookByIsbnRequestObject) (generated.GetBookByIsbnResponseObject, error) {
+	logger := slog.With("handler", "GetBookByIsbn")
+
+	resp, err := s.library.GetBookByIsbnWithResponse(ctx, request.Isbn, s.token(ctx))
 	if err != nil {
-		logger.Error("take book", "error", err)
-		return nil, fmt.Errorf("decrease book: %w", err)
+		logger.Error("get book by isbn", "error", err)
+		return nil, fmt.Errorf("get book by isbn: %w", err)
 	}
 
-	if bookResp.JSON400 != nil {
-		return generated.TakeBook400JSONResponse{
-			Message: bookResp.JSON400.Message,
-		}, nil
+	if resp.JSON400 != nil {
+		return generated.GetBookByIsbn400JSONResponse(toValidationError(*resp.JSON400)), nil
 	}
 
-	if bookResp.StatusCode() != http.StatusNoContent {
-		logger.Error("take book unknown status", "status", bookResp.StatusCode())
-		return nil, fmt.Errorf("decrease book: %s", string(bookResp.Body))
+	if resp.JSON404 != nil {
+		return generated.GetBookByIsbn404JSONResponse{
+			Message: resp.JSON404.Message,
+		}, nil
 	}
 
-	book := generated.BookInfo{
-		BookUid: reservedResp.JSON200.BookUid,
+	if resp.JSON200 == nil {
+		logger.Error("get book by isbn unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("get book by isbn: %s", string(resp.Body))
 	}
 
-	bookRespInfo, err := s.library.GetBookWithResponse(ctx, reservedResp.JSON200.BookUid, s.token(ctx))
-	if err == nil && bookRespInfo.JSON200 != nil {
-		book = generated.BookInfo{
-			Author:  bookRespInfo.JSON200.Author,
-			BookUid: bookRespInfo.JSON200.BookUid,
-			Genre:   bookRespInfo.JSON200.Genre,
-			Name:    bookRespInfo.JSON200.Name,
-		}
+	return generated.GetBookByIsbn200JSONResponse(*resp.JSON200), nil
+}
+
+func (s *Server) ListPopularBooks(ctx context.Context, request generated.ListPopularBooksRequestObject) (generated.ListPopularBooksResponseObject, error) {
+	logger := slog.With("handler", "ListPopularBooks")
+
+	resp, err := s.library.ListPopularBooksWithResponse(ctx, &library.ListPopularBooksParams{
+		City:  request.Params.City,
+		Genre: request.Params.Genre,
+		From:  request.Params.From,
+		To:    request.Params.To,
+		Limit: request.Params.Limit,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("list popular books", "error", err)
+		return nil, fmt.Errorf("list popular books: %w", err)
 	}
 
-	lib := generated.LibraryResponse{
-		LibraryUid: reservedResp.JSON200.LibraryUid,
+	if resp.JSON400 != nil {
+		return generated.ListPopularBooks400JSONResponse(toValidationError(*resp.JSON400)), nil
 	}
 
-	libraryResp, err := s.library.GetLibraryWithResponse(ctx, reservedResp.JSON200.LibraryUid, s.token(ctx))
-	if err == nil && libraryResp.JSON200 != nil {
-		lib = generated.LibraryResponse{
-			Address:    libraryResp.JSON200.Address,
-			City:       libraryResp.JSON200.City,
-			LibraryUid: libraryResp.JSON200.LibraryUid,
-			Name:       libraryResp.JSON200.Name,
-		}
+	if resp.JSON200 == nil {
+		logger.Error("list popular books unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("list popular books: %s", string(resp.Body))
 	}
 
-	return generated.TakeBook200JSONResponse{
-		Book:    book,
-		Library: lib,
-		Rating: generated.UserRatingResponse{
-			Stars: ratingResp.JSON200.Stars,
-		},
-		ReservationUid: reservedResp.JSON200.ReservationUid,
-		StartDate:      reservedResp.JSON200.StartDate,
-		Status:         generated.TakeBookResponseStatus(reservedResp.JSON200.Status),
-		TillDate:       reservedResp.JSON200.TillDate,
-	}, nil
+	return generated.ListPopularBooks200JSONResponse(lo.Map(*resp.JSON200, func(item library.PopularBookResponse, _ int) generated.PopularBookResponse {
+		return generated.PopularBookResponse{
+			Book:      generated.BookInfo(item.Book),
+			Checkouts: item.Checkouts,
+			Returns:   item.Returns,
+		}
+	})), nil
 }
 
-func (s *Server) ReturnBook(ctx context.Context, request generated.ReturnBookRequestObject) (generated.ReturnBookResponseObject, error) {
-	logger := slog.With("handler", "ReturnBook")
+func (s *Server) PlaceHold(ctx context.Context, request generated.PlaceHoldRequestObject) (generated.PlaceHoldResponseObject, error) {
+	logger := slog.With("handler", "PlaceHold")
 
-	reservationResp, err := s.reservation.GetWithResponse(ctx, request.ReservationUid, s.token(ctx))
+	s.applyPenalties(ctx)
+
+	ratingResp, err := s.rating.GetWithResponse(ctx, s.token(ctx))
 	if err != nil {
-		logger.Error("get user reservation", "error", err)
-		return nil, fmt.Errorf("get user reservation: %w", err)
+		logger.Error("get user rating", "error", err)
+		return nil, fmt.Errorf("get user rating: %w", err)
 	}
 
-	if reservationResp.JSON200 == nil {
-		logger.Error("get user reservation unknown status", "status", reservationResp.StatusCode())
-		return nil, fmt.Errorf("get user reservation: %s", string(reservationResp.Body))
+	if ratingResp.JSON200 == nil {
+		logger.Error("get user rating unknown response", "status", ratingResp.StatusCode())
+		return nil, fmt.Errorf("get user rating: empty response")
 	}
 
-	violations := 0
-
-	unreservedResp, err := s.reservation.FinishWithResponse(ctx, request.ReservationUid, reservation.FinishJSONRequestBody{Date: request.Body.Date}, s.token(ctx))
+	resp, err := s.library.PlaceHoldWithResponse(ctx, request.LibraryUid, request.BookUid, library.PlaceHoldJSONRequestBody{
+		Rating: lo.ToPtr(min(max(ratingResp.JSON200.Stars, 0), 100)),
+	}, s.token(ctx))
 	if err != nil {
-		logger.Error("finish reservation", "error", err)
-		return nil, fmt.Errorf("finish reservation: %w", err)
+		logger.Error("place hold", "error", err)
+		return nil, fmt.Errorf("place hold: %w", err)
 	}
 
-	if unreservedResp.JSON404 != nil {
-		return generated.ReturnBook404JSONResponse{
-			Message: unreservedResp.JSON404.Message,
+	if resp.JSON404 != nil {
+		return generated.PlaceHold404JSONResponse{
+			Message: resp.JSON404.Message,
 		}, nil
 	}
 
-	if unreservedResp.JSON200 == nil {
-		logger.Error("finish reservation unknown status", "status", unreservedResp.StatusCode())
-		return nil, fmt.Errorf("finish reservation: %s", string(unreservedResp.Body))
+	if resp.JSON409 != nil {
+		return generated.PlaceHold409JSONResponse{
+			Message: resp.JSON409.Message,
+		}, nil
 	}
 
-	if unreservedResp.JSON200.Violation {
-		violations++
+	if resp.JSON201 == nil {
+		logger.Error("place hold unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("place hold: %s", string(resp.Body))
 	}
 
-	makeAvailableResp, err := s.library.ReturnBookWithResponse(ctx, reservationResp.JSON200.LibraryUid, reservationResp.JSON200.BookUid, library.ReturnBookJSONRequestBody{
-		Condition: library.ReturnBookRequestCondition(request.Body.Condition),
-	}, s.token(ctx))
+	return generated.PlaceHold201JSONResponse(s.holdResponse(ctx, *resp.JSON201)), nil
+}
+
+func (s *Server) ListHolds(ctx context.Context, request generated.ListHoldsRequestObject) (generated.ListHoldsResponseObject, error) {
+	logger := slog.With("handler", "ListHolds")
+
+	resp, err := s.library.ListHoldsWithResponse(ctx, s.token(ctx))
 	if err != nil {
-		logger.Error("return book", "error", err)
-		return nil, fmt.Errorf("return book: %w", err)
+		logger.Error("list holds", "error", err)
+		return nil, fmt.Errorf("list holds: %w", err)
 	}
 
-	if makeAvailableResp.JSON200 == nil {
-		logger.Error("return book unknown status", "status", makeAvailableResp.StatusCode())
-		return nil, fmt.Errorf("return book: %s", string(makeAvailableResp.Body))
+	if resp.JSON200 == nil {
+		logger.Error("list holds unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("list holds: %s", string(resp.Body))
 	}
 
-	if makeAvailableResp.JSON200.Violation {
-		violations++
-	}
+	return generated.ListHolds200JSONResponse(lo.Map(*resp.JSON200, func(item library.HoldResponse, _ int) generated.HoldResponse {
+		return s.holdResponse(ctx, item)
+	})), nil
+}
+
+func (s *Server) GetHold(ctx context.Context, request generated.GetHoldRequestObject) (generated.GetHoldResponseObject, error) {
+	logger := slog.With("handler", "GetHold")
 
-	changeRatingResp, err := s.rating.SaveViolationsWithResponse(ctx, &rating.SaveViolationsParams{Count: violations}, s.token(ctx))
+	resp, err := s.library.GetHoldWithResponse(ctx, request.HoldUid, s.token(ctx))
 	if err != nil {
-		logger.Error("save violations", "error", err)
-		return nil, fmt.Errorf("save violations: %w", err)
+		logger.Error("get hold", "error", err)
+		return nil, fmt.Errorf("get hold: %w", err)
 	}
 
-	if changeRatingResp.StatusCode() != http.StatusNoContent {
-		logger.Error("save violations unknown status", "status", changeRatingResp.StatusCode())
-		return nil, fmt.Errorf("save violations: %s", string(changeRatingResp.Body))
+	if resp.JSON404 != nil {
+		return generated.GetHold404JSONResponse{
+			Message: resp.JSON404.Message,
+		}, nil
 	}
 
-	return generated.ReturnBook204Response{}, nil
-}
+	if resp.JSON200 == nil {
+		logger.Error("get hold unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("get hold: %s", string(resp.Body))
+	}
 
-func (s *Server) Health(ctx context.Context, request generated.HealthRequestObject) (generated.HealthResponseObject, error) {
-	return generated.Health200Response{}, nil
+	return generated.GetHold200JSONResponse(s.holdResponse(ctx, *resp.JSON200)), nil
 }
 
-func (s *Server) token(ctx context.Context) func(ctx context.Context, req *http.Request) error {
-	token := contextutils.GetToken(ctx)
+func (s *Server) CancelHold(ctx context.Context, request generated.CancelHoldRequestObject) (generated.CancelHoldResponseObject, error) {
+	logger := slog.With("handler", "CancelHold")
 
-	return func(ctx context.Context, req *http.Request) error {
-		req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := s.library.CancelHoldWithResponse(ctx, request.HoldUid, s.token(ctx))
+	if err != nil {
+		logger.Error("cancel hold", "error", err)
+		return nil, fmt.Errorf("cancel hold: %w", err)
+	}
 
-		return nil
+	if resp.JSON404 != nil {
+		return generated.CancelHold404JSONResponse{
+			Message: resp.JSON404.Message,
+		}, nil
+	}
+
+	if resp.JSON409 != nil {
+		return generated.CancelHold409JSONResponse{
+			Message: resp.JSON409.Message,
+		}, nil
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		logger.Error("cancel hold unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("cancel hold: %s", string(resp.Body))
 	}
+
+	return generated.CancelHold204Response{}, nil
+}
+
+func (s *Server) GetRating(ctx context.Context, request generated.GetRatingRequestObject) (generated.GetRatingResponseObject, error) {
+	logger := slog.With("handler", "GetRating")
+
+	s.applyPenalties(ctx)
+
+	resp, err := s.rating.GetWithResponse(ctx, s.token(ctx))
+	if err != nil {
+		logger.Error("get rating", "error", err)
+		return nil, fmt.Errorf("get rating: %w", err)
+	}
+
+	if resp.JSON200 == nil {
+		logger.Error("get rating unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("get user rating: %s", string(resp.Body))
+	}
+
+	return generated.GetRating200JSONResponse{
+		Stars: resp.JSON200.Stars,
+	}, nil
+}
+
+func (s *Server) ListReservations(ctx context.Context, request generated.ListReservationsRequestObject) (generated.ListReservationsResponseObject, error) {
+	logger := slog.With("handler", "ListReservations")
+
+	s.applyPenalties(ctx)
+
+	var statuses *[]reservation.ListParamsStatus
+	if request.Params.Status != nil {
+		statuses = lo.ToPtr(lo.Map(*request.Params.Status, func(item generated.ListReservationsParamsStatus, _ int) reservation.ListParamsStatus {
+			return reservation.ListParamsStatus(item)
+		}))
+	}
+
+	resp, err := s.reservation.ListWithResponse(ctx, &reservation.ListParams{
+		Page:   request.Params.Page,
+		Size:   request.Params.Size,
+		Status: statuses,
+		Active: request.Params.Active,
+		From:   request.Params.From,
+		To:     request.Params.To,
+		Sort:   (*reservation.ListParamsSort)(request.Params.Sort),
+		Order:  (*reservation.ListParamsOrder)(request.Params.Order),
+		Cursor: request.Params.Cursor,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("list reservations", "error", err)
+		return nil, fmt.Errorf("list reservations: %w", err)
+	}
+
+	if resp.JSON400 != nil {
+		return generated.ListReservations400JSONResponse(toReservationValidationError(*resp.JSON400)), nil
+	}
+
+	if resp.JSON200 == nil {
+		logger.Error("list reservations unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("list reservations: %s", string(resp.Body))
+	}
+
+	return generated.ListReservations200JSONResponse{
+		Items: lo.Map(resp.JSON200.Items, func(item reservation.BookReservationResponse, _ int) generated.BookReservationResponse {
+			return s.reservationResponse(ctx, item)
+		}),
+		Page:          resp.JSON200.Page,
+		PageSize:      resp.JSON200.PageSize,
+		TotalElements: resp.JSON200.TotalElements,
+		NextCursor:    resp.JSON200.NextCursor,
+	}, nil
+}
+
+func (s *Server) GetReservationHistory(ctx context.Context, request generated.GetReservationHistoryRequestObject) (generated.GetReservationHistoryResponseObject, error) {
+	logger := slog.With("handler", "GetReservationHistory")
+
+	resp, err := s.reservation.HistoryWithResponse(ctx, request.ReservationUid, s.token(ctx))
+	if err != nil {
+		logger.Error("get reservation history", "error", err)
+		return nil, fmt.Errorf("get reservation history: %w", err)
+	}
+
+	if resp.JSON404 != nil {
+		return generated.GetReservationHistory404JSONResponse{
+			Message: resp.JSON404.Message,
+		}, nil
+	}
+
+	if resp.JSON200 == nil {
+		logger.Error("get reservation history unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("get reservation history: %s", string(resp.Body))
+	}
+
+	return generated.GetReservationHistory200JSONResponse(lo.Map(*resp.JSON200, func(item reservation.ReservationEventResponse, _ int) generated.ReservationEventResponse {
+		return generated.ReservationEventResponse(item)
+	})), nil
+}
+
+func (s *Server) TakeBook(ctx context.Context, request generated.TakeBookRequestObject) (generated.TakeBookResponseObject, error) {
+	logger := slog.With("handler", "TakeBook")
+
+	s.applyPenalties(ctx)
+
+	ratingResp, err := s.rating.GetWithResponse(ctx, s.token(ctx))
+	if err != nil {
+		logger.Error("get user rating", "error", err)
+		return nil, fmt.Errorf("get user rating: %w", err)
+	}
+
+	if ratingResp.JSON200 == nil {
+		logger.Error("get user rating unknown response", "status", ratingResp.StatusCode())
+		return nil, fmt.Errorf("get user rating: empty response")
+	}
+
+	dueResp, err := s.library.CheckDueDateWithResponse(ctx, request.Body.LibraryUid, &library.CheckDueDateParams{
+		Date: request.Body.TillDate,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("check due date", "error", err)
+		return nil, fmt.Errorf("check due date: %w", err)
+	}
+
+	if dueResp.JSON400 != nil {
+		return generated.TakeBook400JSONResponse(toValidationError(*dueResp.JSON400)), nil
+	}
+
+	if dueResp.JSON404 != nil {
+		return generated.TakeBook404JSONResponse{
+			Message: dueResp.JSON404.Message,
+		}, nil
+	}
+
+	if dueResp.JSON200 == nil {
+		logger.Error("check due date unknown status", "status", dueResp.StatusCode())
+		return nil, fmt.Errorf("check due date: %s", string(dueResp.Body))
+	}
+
+	if request.Body.PickupDate == nil && !dueResp.JSON200.OpenNow {
+		message := "library is closed now"
+		if dueResp.JSON200.NextOpening != nil {
+			message += ", it opens at " + dueResp.JSON200.NextOpening.Format(time.RFC3339)
+		}
+
+		return generated.TakeBook400JSONResponse{
+			Message: message,
+		}, nil
+	}
+
+	reservedResp, err := s.reservation.CreateWithResponse(ctx, reservation.CreateJSONRequestBody{
+		BookUid:    request.Body.BookUid,
+		LibraryUid: request.Body.LibraryUid,
+		TillDate:   dueResp.JSON200.DueDate,
+		PickupDate: request.Body.PickupDate,
+		MaxActive:  ratingResp.JSON200.Stars,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("reserve book", "error", err)
+		return nil, fmt.Errorf("reserve book: %w", err)
+	}
+
+	if reservedResp.JSON400 != nil {
+		return generated.TakeBook400JSONResponse(toReservationValidationError(*reservedResp.JSON400)), nil
+	}
+
+	if reservedResp.JSON409 != nil {
+		return generated.TakeBook409JSONResponse{
+			Message: reservedResp.JSON409.Message,
+		}, nil
+	}
+
+	if reservedResp.JSON200 == nil {
+		logger.Error("reserve book unknown status", "status", reservedResp.StatusCode())
+		return nil, fmt.Errorf("reserve book: %s", string(reservedResp.Body))
+	}
+
+	if request.Body.PickupDate != nil {
+		if reservedResp.JSON200.PickupUntil == nil {
+			logger.Error("reserve book for pickup without deadline", "reservation", reservedResp.JSON200.ReservationUid)
+			s.rollbackReservation(ctx, reservedResp.JSON200.ReservationUid)
+			return nil, fmt.Errorf("reserve book: empty pickup deadline")
+		}
+
+		bookingResp, err := s.library.BookCopyForPickupWithResponse(ctx, request.Body.LibraryUid, request.Body.BookUid, &library.BookCopyForPickupParams{
+			ReservationUid: reservedResp.JSON200.ReservationUid,
+		}, library.BookCopyForPickupJSONRequestBody{
+			PickupDate: *request.Body.PickupDate,
+			ExpiresAt:  *reservedResp.JSON200.PickupUntil,
+		}, s.token(ctx))
+		if err != nil {
+			logger.Error("book copy", "error", err)
+			s.rollbackReservation(ctx, reservedResp.JSON200.ReservationUid)
+			return nil, fmt.Errorf("book copy: %w", err)
+		}
+
+		if bookingResp.JSON400 != nil {
+			s.rollbackReservation(ctx, reservedResp.JSON200.ReservationUid)
+			return generated.TakeBook400JSONResponse(toValidationError(*bookingResp.JSON400)), nil
+		}
+
+		if bookingResp.JSON404 != nil {
+			s.rollbackReservation(ctx, reservedResp.JSON200.ReservationUid)
+			return generated.TakeBook404JSONResponse{
+				Message: bookingResp.JSON404.Message,
+			}, nil
+		}
+
+		if bookingResp.JSON409 != nil {
+			s.rollbackReservation(ctx, reservedResp.JSON200.ReservationUid)
+			return generated.TakeBook409JSONResponse{
+				Message: bookingResp.JSON409.Message,
+			}, nil
+		}
+
+		if bookingResp.JSON201 == nil {
+			logger.Error("book copy unknown status", "status", bookingResp.StatusCode())
+			s.rollbackReservation(ctx, reservedResp.JSON200.ReservationUid)
+			return nil, fmt.Errorf("book copy: %s", string(bookingResp.Body))
+		}
+	} else {
+		bookResp, err := s.library.TakeBookWithResponse(ctx, request.Body.LibraryUid, request.Body.BookUid, &library.TakeBookParams{
+			ReservationUid: &reservedResp.JSON200.ReservationUid,
+		}, s.token(ctx))
+		if err != nil {
+			logger.Error("take book", "error", err)
+			s.rollbackReservation(ctx, reservedResp.JSON200.ReservationUid)
+			return nil, fmt.Errorf("decrease book: %w", err)
+		}
+
+		if bookResp.JSON404 != nil {
+			s.rollbackReservation(ctx, reservedResp.JSON200.ReservationUid)
+			return generated.TakeBook404JSONResponse{
+				Message: bookResp.JSON404.Message,
+			}, nil
+		}
+
+		if bookResp.JSON409 != nil {
+			s.rollbackReservation(ctx, reservedResp.JSON200.ReservationUid)
+			return generated.TakeBook409JSONResponse{
+				Message: bookResp.JSON409.Message,
+			}, nil
+		}
+
+		if bookResp.JSON200 == nil {
+			logger.Error("take book unknown status", "status", bookResp.StatusCode())
+			s.rollbackReservation(ctx, reservedResp.JSON200.ReservationUid)
+			return nil, fmt.Errorf("decrease book: %s", string(bookResp.Body))
+		}
+	}
+
+	book := generated.BookInfo{
+		BookUid: reservedResp.JSON200.BookUid,
+	}
+
+	bookRespInfo, err := s.library.GetBookWithResponse(ctx, reservedResp.JSON200.BookUid, s.token(ctx))
+	if err == nil && bookRespInfo.JSON200 != nil {
+		book = generated.BookInfo(*bookRespInfo.JSON200)
+	}
+
+	lib := generated.LibraryResponse{
+		LibraryUid: reservedResp.JSON200.LibraryUid,
+	}
+
+	libraryResp, err := s.library.GetLibraryWithResponse(ctx, reservedResp.JSON200.LibraryUid, s.token(ctx))
+	if err == nil && libraryResp.JSON200 != nil {
+		lib = generated.LibraryResponse{
+			Address:    libraryResp.JSON200.Address,
+			City:       libraryResp.JSON200.City,
+			LibraryUid: libraryResp.JSON200.LibraryUid,
+			Name:       libraryResp.JSON200.Name,
+		}
+	}
+
+	return generated.TakeBook200JSONResponse{
+		Book:    book,
+		Library: lib,
+		Rating: generated.UserRatingResponse{
+			Stars: ratingResp.JSON200.Stars,
+		},
+		PickupUntil:    reservedResp.JSON200.PickupUntil,
+		ReservationUid: reservedResp.JSON200.ReservationUid,
+		StartDate:      reservedResp.JSON200.StartDate,
+		Status:         generated.TakeBookResponseStatus(reservedResp.JSON200.Status),
+		TillDate:       reservedResp.JSON200.TillDate,
+	}, nil
+}
+
+func (s *Server) ReturnBook(ctx context.Context, request generated.ReturnBookRequestObject) (generated.ReturnBookResponseObject, error) {
+	logger := slog.With("handler", "ReturnBook")
+
+	reservationResp, err := s.reservation.GetWithResponse(ctx, request.ReservationUid, s.token(ctx))
+	if err != nil {
+		logger.Error("get user reservation", "error", err)
+		return nil, fmt.Errorf("get user reservation: %w", err)
+	}
+
+	if reservationResp.JSON404 != nil {
+		return generated.ReturnBook404JSONResponse{
+			Message: reservationResp.JSON404.Message,
+		}, nil
+	}
+
+	if reservationResp.JSON200 == nil {
+		logger.Error("get user reservation unknown status", "status", reservationResp.StatusCode())
+		return nil, fmt.Errorf("get user reservation: %s", string(reservationResp.Body))
+	}
+
+	// Staff can return books of any reader, the violations are counted for
+	// the reader then.
+	reader := reservationResp.JSON200.Username
+	if reader != contextutils.GetUser(ctx) && !contextutils.IsStaff(ctx) {
+		return generated.ReturnBook404JSONResponse{
+			Message: "reservation not found",
+		}, nil
+	}
+
+	switch reservationResp.JSON200.Status {
+	case reservation.BookReservationResponseStatusRENTED, reservation.BookReservationResponseStatusOVERDUE:
+	default:
+		return generated.ReturnBook409JSONResponse{
+			Message: fmt.Sprintf("reservation is already %s", strings.ToLower(string(reservationResp.JSON200.Status))),
+		}, nil
+	}
+
+	if request.Body.Date != nil && !contextutils.IsStaff(ctx) {
+		return generated.ReturnBook403JSONResponse{
+			Message: "only library staff can set return date",
+		}, nil
+	}
+
+	// The copy is returned first, so the reservation is not closed while the
+	// copy stays rented. The library answers the same for the copy returned
+	// by the previous attempt, so the failed return can be retried.
+	makeAvailableResp, err := s.library.ReturnBookWithResponse(ctx, reservationResp.JSON200.LibraryUid, reservationResp.JSON200.BookUid, &library.ReturnBookParams{
+		ReservationUid: &request.ReservationUid,
+	}, library.ReturnBookJSONRequestBody{
+		Condition: library.ReturnBookRequestCondition(request.Body.Condition),
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("return book", "error", err)
+		return nil, fmt.Errorf("return book: %w", err)
+	}
+
+	if makeAvailableResp.JSON404 != nil {
+		return generated.ReturnBook404JSONResponse{
+			Message: makeAvailableResp.JSON404.Message,
+		}, nil
+	}
+
+	if makeAvailableResp.JSON409 != nil {
+		return generated.ReturnBook409JSONResponse{
+			Message: makeAvailableResp.JSON409.Message,
+		}, nil
+	}
+
+	if makeAvailableResp.JSON200 == nil {
+		logger.Error("return book unknown status", "status", makeAvailableResp.StatusCode())
+		return nil, fmt.Errorf("return book: %s", string(makeAvailableResp.Body))
+	}
+
+	violations := 0
+	if makeAvailableResp.JSON200.Violation {
+		violations++
+	}
+
+	var genre *string
+	bookResp, err := s.library.GetBookWithResponse(ctx, reservationResp.JSON200.BookUid, s.token(ctx))
+	if err == nil && bookResp.JSON200 != nil {
+		genre = &bookResp.JSON200.Genre
+	}
+
+	unreservedResp, err := s.reservation.FinishWithResponse(ctx, request.ReservationUid, reservation.FinishJSONRequestBody{
+		Date:  request.Body.Date,
+		Genre: genre,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("finish reservation", "error", err)
+		return nil, fmt.Errorf("finish reservation: %w", err)
+	}
+
+	if unreservedResp.JSON400 != nil {
+		return generated.ReturnBook400JSONResponse(toReservationValidationError(*unreservedResp.JSON400)), nil
+	}
+
+	if unreservedResp.JSON403 != nil {
+		return generated.ReturnBook403JSONResponse{
+			Message: unreservedResp.JSON403.Message,
+		}, nil
+	}
+
+	if unreservedResp.JSON404 != nil {
+		return generated.ReturnBook404JSONResponse{
+			Message: unreservedResp.JSON404.Message,
+		}, nil
+	}
+
+	if unreservedResp.JSON409 != nil {
+		return generated.ReturnBook409JSONResponse{
+			Message: unreservedResp.JSON409.Message,
+		}, nil
+	}
+
+	if unreservedResp.JSON200 == nil {
+		logger.Error("finish reservation unknown status", "status", unreservedResp.StatusCode())
+		return nil, fmt.Errorf("finish reservation: %s", string(unreservedResp.Body))
+	}
+
+	if unreservedResp.JSON200.Violation {
+		violations++
+	}
+
+	changeRatingResp, err := s.rating.SaveViolationsWithResponse(ctx, &rating.SaveViolationsParams{
+		Count:    violations,
+		Username: &reader,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("save violations", "error", err)
+		return nil, fmt.Errorf("save violations: %w", err)
+	}
+
+	if changeRatingResp.StatusCode() != http.StatusNoContent {
+		logger.Error("save violations unknown status", "status", changeRatingResp.StatusCode())
+		return nil, fmt.Errorf("save violations: %s", string(changeRatingResp.Body))
+	}
+
+	return generated.ReturnBook204Response{}, nil
+}
+
+func (s *Server) CancelReservation(ctx context.Context, request generated.CancelReservationRequestObject) (generated.CancelReservationResponseObject, error) {
+	logger := slog.With("handler", "CancelReservation")
+
+	reservationResp, err := s.reservation.GetWithResponse(ctx, request.ReservationUid, s.token(ctx))
+	if err != nil {
+		logger.Error("get user reservation", "error", err)
+		return nil, fmt.Errorf("get user reservation: %w", err)
+	}
+
+	if reservationResp.JSON404 != nil {
+		return generated.CancelReservation404JSONResponse{
+			Message: reservationResp.JSON404.Message,
+		}, nil
+	}
+
+	if reservationResp.JSON200 == nil {
+		logger.Error("get user reservation unknown status", "status", reservationResp.StatusCode())
+		return nil, fmt.Errorf("get user reservation: %s", string(reservationResp.Body))
+	}
+
+	// The reservation which is already cancelled is left by the previous
+	// attempt, whose copy release has failed, so only the release is retried.
+	if reservationResp.JSON200.Status != reservation.BookReservationResponseStatusCANCELLED {
+		cancelResp, err := s.reservation.CancelWithResponse(ctx, request.ReservationUid, s.token(ctx))
+		if err != nil {
+			logger.Error("cancel reservation", "error", err)
+			return nil, fmt.Errorf("cancel reservation: %w", err)
+		}
+
+		if cancelResp.JSON404 != nil {
+			return generated.CancelReservation404JSONResponse{
+				Message: cancelResp.JSON404.Message,
+			}, nil
+		}
+
+		if cancelResp.JSON409 != nil {
+			return generated.CancelReservation409JSONResponse{
+				Message: cancelResp.JSON409.Message,
+			}, nil
+		}
+
+		if cancelResp.StatusCode() != http.StatusNoContent {
+			logger.Error("cancel reservation unknown status", "status", cancelResp.StatusCode())
+			return nil, fmt.Errorf("cancel reservation: %s", string(cancelResp.Body))
+		}
+	}
+
+	releaseResp, err := s.library.ReleaseBookWithResponse(ctx, reservationResp.JSON200.LibraryUid, reservationResp.JSON200.BookUid, &library.ReleaseBookParams{
+		ReservationUid: request.ReservationUid,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("release book", "error", err)
+		return nil, fmt.Errorf("release book: %w", err)
+	}
+
+	if releaseResp.JSON404 != nil {
+		logger.Error("rented copy not found", "reservation", request.ReservationUid)
+		return generated.CancelReservation404JSONResponse{
+			Message: releaseResp.JSON404.Message,
+		}, nil
+	}
+
+	// 204 means the booking is cancelled before its copy was set aside.
+	if releaseResp.JSON200 == nil && releaseResp.StatusCode() != http.StatusNoContent {
+		logger.Error("release book unknown status", "status", releaseResp.StatusCode())
+		return nil, fmt.Errorf("release book: %s", string(releaseResp.Body))
+	}
+
+	return generated.CancelReservation204Response{}, nil
+}
+
+func (s *Server) RenewBook(ctx context.Context, request generated.RenewBookRequestObject) (generated.RenewBookResponseObject, error) {
+	logger := slog.With("handler", "RenewBook")
+
+	reservationResp, err := s.reservation.GetWithResponse(ctx, request.ReservationUid, s.token(ctx))
+	if err != nil {
+		logger.Error("get user reservation", "error", err)
+		return nil, fmt.Errorf("get user reservation: %w", err)
+	}
+
+	if reservationResp.JSON404 != nil {
+		return generated.RenewBook404JSONResponse{
+			Message: reservationResp.JSON404.Message,
+		}, nil
+	}
+
+	if reservationResp.JSON200 == nil {
+		logger.Error("get user reservation unknown status", "status", reservationResp.StatusCode())
+		return nil, fmt.Errorf("get user reservation: %s", string(reservationResp.Body))
+	}
+
+	r := reservationResp.JSON200
+
+	dueResp, err := s.library.CheckDueDateWithResponse(ctx, r.LibraryUid, &library.CheckDueDateParams{
+		Date: request.Body.TillDate,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("check due date", "error", err)
+		return nil, fmt.Errorf("check due date: %w", err)
+	}
+
+	if dueResp.JSON400 != nil {
+		return generated.RenewBook400JSONResponse(toValidationError(*dueResp.JSON400)), nil
+	}
+
+	if dueResp.JSON404 != nil {
+		return generated.RenewBook404JSONResponse{
+			Message: dueResp.JSON404.Message,
+		}, nil
+	}
+
+	if dueResp.JSON200 == nil {
+		logger.Error("check due date unknown status", "status", dueResp.StatusCode())
+		return nil, fmt.Errorf("check due date: %s", string(dueResp.Body))
+	}
+
+	renewResp, err := s.reservation.RenewWithResponse(ctx, request.ReservationUid, reservation.RenewJSONRequestBody{
+		TillDate: dueResp.JSON200.DueDate,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("renew reservation", "error", err)
+		return nil, fmt.Errorf("renew reservation: %w", err)
+	}
+
+	if renewResp.JSON400 != nil {
+		return generated.RenewBook400JSONResponse(toReservationValidationError(*renewResp.JSON400)), nil
+	}
+
+	if renewResp.JSON404 != nil {
+		return generated.RenewBook404JSONResponse{
+			Message: renewResp.JSON404.Message,
+		}, nil
+	}
+
+	if renewResp.JSON409 != nil {
+		return generated.RenewBook409JSONResponse{
+			Message: renewResp.JSON409.Message,
+		}, nil
+	}
+
+	if renewResp.JSON200 == nil {
+		logger.Error("renew reservation unknown status", "status", renewResp.StatusCode())
+		return nil, fmt.Errorf("renew reservation: %s", string(renewResp.Body))
+	}
+
+	return generated.RenewBook200JSONResponse(s.reservationResponse(ctx, *renewResp.JSON200)), nil
+}
+
+func (s *Server) PickupBook(ctx context.Context, request generated.PickupBookRequestObject) (generated.PickupBookResponseObject, error) {
+	logger := slog.With("handler", "PickupBook")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.PickupBook403JSONResponse{
+			Message: "only library staff can hand out booked books",
+		}, nil
+	}
+
+	reservationResp, err := s.reservation.GetWithResponse(ctx, request.ReservationUid, s.token(ctx))
+	if err != nil {
+		logger.Error("get reservation", "error", err)
+		return nil, fmt.Errorf("get reservation: %w", err)
+	}
+
+	if reservationResp.JSON404 != nil {
+		return generated.PickupBook404JSONResponse{
+			Message: reservationResp.JSON404.Message,
+		}, nil
+	}
+
+	if reservationResp.JSON200 == nil {
+		logger.Error("get reservation unknown status", "status", reservationResp.StatusCode())
+		return nil, fmt.Errorf("get reservation: %s", string(reservationResp.Body))
+	}
+
+	booked := reservationResp.JSON200
+	if booked.Status != reservation.BookReservationResponseStatusPENDING {
+		return generated.PickupBook409JSONResponse{
+			Message: fmt.Sprintf("reservation is %s, not waiting for pickup", strings.ToLower(string(booked.Status))),
+		}, nil
+	}
+
+	// The copy is taken first, so the reservation is not marked as picked up
+	// while the book stays in the library. The copy is released back if the
+	// reservation can not be picked up anymore.
+	bookResp, err := s.library.TakeBookWithResponse(ctx, booked.LibraryUid, booked.BookUid, &library.TakeBookParams{
+		ReservationUid: &request.ReservationUid,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("take booked copy", "error", err)
+		return nil, fmt.Errorf("take booked copy: %w", err)
+	}
+
+	if bookResp.JSON404 != nil {
+		return generated.PickupBook404JSONResponse{
+			Message: bookResp.JSON404.Message,
+		}, nil
+	}
+
+	if bookResp.JSON409 != nil {
+		return generated.PickupBook409JSONResponse{
+			Message: bookResp.JSON409.Message,
+		}, nil
+	}
+
+	if bookResp.JSON200 == nil {
+		logger.Error("take booked copy unknown status", "status", bookResp.StatusCode(), "reservation", request.ReservationUid)
+		return nil, fmt.Errorf("take booked copy: %s", string(bookResp.Body))
+	}
+
+	pickupResp, err := s.reservation.PickupWithResponse(ctx, request.ReservationUid, s.token(ctx))
+	if err != nil {
+		logger.Error("pick up reservation", "error", err)
+		s.releaseCopy(ctx, booked.LibraryUid, booked.BookUid, request.ReservationUid)
+		return nil, fmt.Errorf("pick up reservation: %w", err)
+	}
+
+	if pickupResp.JSON200 == nil {
+		s.releaseCopy(ctx, booked.LibraryUid, booked.BookUid, request.ReservationUid)
+	}
+
+	if pickupResp.JSON403 != nil {
+		return generated.PickupBook403JSONResponse{
+			Message: pickupResp.JSON403.Message,
+		}, nil
+	}
+
+	if pickupResp.JSON404 != nil {
+		return generated.PickupBook404JSONResponse{
+			Message: pickupResp.JSON404.Message,
+		}, nil
+	}
+
+	if pickupResp.JSON409 != nil {
+		return generated.PickupBook409JSONResponse{
+			Message: pickupResp.JSON409.Message,
+		}, nil
+	}
+
+	if pickupResp.JSON200 == nil {
+		logger.Error("pick up reservation unknown status", "status", pickupResp.StatusCode())
+		return nil, fmt.Errorf("pick up reservation: %s", string(pickupResp.Body))
+	}
+
+	return generated.PickupBook200JSONResponse(s.reservationResponse(ctx, *pickupResp.JSON200)), nil
+}
+
+func (s *Server) WriteOffBook(ctx context.Context, request generated.WriteOffBookRequestObject) (generated.WriteOffBookResponseObject, error) {
+	logger := slog.With("handler", "WriteOffBook")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.WriteOffBook403JSONResponse{
+			Message: "only library staff can write off books",
+		}, nil
+	}
+
+	reservationResp, err := s.reservation.GetWithResponse(ctx, request.ReservationUid, s.token(ctx))
+	if err != nil {
+		logger.Error("get reservation", "error", err)
+		return nil, fmt.Errorf("get reservation: %w", err)
+	}
+
+	if reservationResp.JSON404 != nil {
+		return generated.WriteOffBook404JSONResponse{
+			Message: reservationResp.JSON404.Message,
+		}, nil
+	}
+
+	if reservationResp.JSON200 == nil {
+		logger.Error("get reservation unknown status", "status", reservationResp.StatusCode())
+		return nil, fmt.Errorf("get reservation: %s", string(reservationResp.Body))
+	}
+
+	loan := reservationResp.JSON200
+	if loan.Status != reservation.BookReservationResponseStatusRENTED && loan.Status != reservation.BookReservationResponseStatusOVERDUE {
+		return generated.WriteOffBook409JSONResponse{
+			Message: fmt.Sprintf("reservation is already %s", strings.ToLower(string(loan.Status))),
+		}, nil
+	}
+
+	// The copy is written off first, so the reservation is not closed while
+	// the copy is still rented. Writing off is repeatable, so the request can
+	// be retried if closing the reservation fails.
+	copyResp, err := s.library.WriteOffBookWithResponse(ctx, loan.LibraryUid, loan.BookUid, &library.WriteOffBookParams{
+		ReservationUid: request.ReservationUid,
+	}, library.WriteOffBookJSONRequestBody{
+		Reason:  library.WriteOffRequestReason(request.Body.Reason),
+		Comment: request.Body.Comment,
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("write off copy", "error", err)
+		return nil, fmt.Errorf("write off copy: %w", err)
+	}
+
+	if copyResp.JSON400 != nil {
+		return generated.WriteOffBook400JSONResponse(toValidationError(*copyResp.JSON400)), nil
+	}
+
+	if copyResp.JSON404 != nil {
+		return generated.WriteOffBook404JSONResponse{
+			Message: copyResp.JSON404.Message,
+		}, nil
+	}
+
+	if copyResp.JSON200 == nil {
+		logger.Error("write off copy unknown status", "status", copyResp.StatusCode())
+		return nil, fmt.Errorf("write off copy: %s", string(copyResp.Body))
+	}
+
+	writeOffResp, err := s.reservation.WriteOffWithResponse(ctx, request.ReservationUid, reservation.WriteOffJSONRequestBody{
+		Status: reservation.WriteOffRequestStatus(request.Body.Reason),
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("write off reservation", "error", err)
+		return nil, fmt.Errorf("write off reservation: %w", err)
+	}
+
+	if writeOffResp.JSON403 != nil {
+		return generated.WriteOffBook403JSONResponse{
+			Message: writeOffResp.JSON403.Message,
+		}, nil
+	}
+
+	if writeOffResp.JSON404 != nil {
+		return generated.WriteOffBook404JSONResponse{
+			Message: writeOffResp.JSON404.Message,
+		}, nil
+	}
+
+	if writeOffResp.JSON409 != nil {
+		return generated.WriteOffBook409JSONResponse{
+			Message: writeOffResp.JSON409.Message,
+		}, nil
+	}
+
+	if writeOffResp.JSON200 == nil {
+		logger.Error("write off reservation unknown status", "status", writeOffResp.StatusCode())
+		return nil, fmt.Errorf("write off reservation: %s", string(writeOffResp.Body))
+	}
+
+	return generated.WriteOffBook204Response{}, nil
+}
+
+func (s *Server) Health(ctx context.Context, request generated.HealthRequestObject) (generated.HealthResponseObject, error) {
+	return generated.Health200Response{}, nil
+}
+
+func toValidationError(resp library.ValidationErrorResponse) generated.ValidationErrorResponse {
+	return generated.ValidationErrorResponse{
+		Message: resp.Message,
+		Errors: lo.Map(resp.Errors, func(item library.ErrorDescription, _ int) generated.ErrorDescription {
+			return generated.ErrorDescription(item)
+		}),
+	}
+}
+
+func toReservationValidationError(resp reservation.ValidationErrorResponse) generated.ValidationErrorResponse {
+	return generated.ValidationErrorResponse{
+		Message: resp.Message,
+		Errors: lo.Map(resp.Errors, func(item reservation.ErrorDescription, _ int) generated.ErrorDescription {
+			return generated.ErrorDescription(item)
+		}),
+	}
+}
+
+func (s *Server) ListFines(ctx context.Context, request generated.ListFinesRequestObject) (generated.ListFinesResponseObject, error) {
+	logger := slog.With("handler", "ListFines")
+
+	resp, err := s.reservation.ListFinesWithResponse(ctx, &reservation.ListFinesParams{
+		Username: request.Params.Username,
+		Status:   (*reservation.ListFinesParamsStatus)(request.Params.Status),
+	}, s.token(ctx))
+	if err != nil {
+		logger.Error("list fines", "error", err)
+		return nil, fmt.Errorf("list fines: %w", err)
+	}
+
+	if resp.JSON403 != nil {
+		return generated.ListFines403JSONResponse{
+			Message: resp.JSON403.Message,
+		}, nil
+	}
+
+	if resp.JSON200 == nil {
+		logger.Error("list fines unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("list fines: %s", string(resp.Body))
+	}
+
+	return generated.ListFines200JSONResponse(lo.Map(*resp.JSON200, func(item reservation.FineResponse, _ int) generated.FineResponse {
+		return toFineResponse(item)
+	})), nil
+}
+
+func (s *Server) PayFine(ctx context.Context, request generated.PayFineRequestObject) (generated.PayFineResponseObject, error) {
+	logger := slog.With("handler", "PayFine")
+
+	resp, err := s.reservation.PayFineWithResponse(ctx, request.FineUid, s.token(ctx))
+	if err != nil {
+		logger.Error("pay fine", "error", err)
+		return nil, fmt.Errorf("pay fine: %w", err)
+	}
+
+	if resp.JSON403 != nil {
+		return generated.PayFine403JSONResponse{
+			Message: resp.JSON403.Message,
+		}, nil
+	}
+
+	if resp.JSON404 != nil {
+		return generated.PayFine404JSONResponse{
+			Message: resp.JSON404.Message,
+		}, nil
+	}
+
+	if resp.JSON409 != nil {
+		return generated.PayFine409JSONResponse{
+			Message: resp.JSON409.Message,
+		}, nil
+	}
+
+	if resp.JSON200 == nil {
+		logger.Error("pay fine unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("pay fine: %s", string(resp.Body))
+	}
+
+	return generated.PayFine200JSONResponse(toFineResponse(*resp.JSON200)), nil
+}
+
+func (s *Server) WaiveFine(ctx context.Context, request generated.WaiveFineRequestObject) (generated.WaiveFineResponseObject, error) {
+	logger := slog.With("handler", "WaiveFine")
+
+	resp, err := s.reservation.WaiveFineWithResponse(ctx, request.FineUid, reservation.WaiveFineJSONRequestBody(*request.Body), s.token(ctx))
+	if err != nil {
+		logger.Error("waive fine", "error", err)
+		return nil, fmt.Errorf("waive fine: %w", err)
+	}
+
+	if resp.JSON400 != nil {
+		return generated.WaiveFine400JSONResponse(toReservationValidationError(*resp.JSON400)), nil
+	}
+
+	if resp.JSON403 != nil {
+		return generated.WaiveFine403JSONResponse{
+			Message: resp.JSON403.Message,
+		}, nil
+	}
+
+	if resp.JSON404 != nil {
+		return generated.WaiveFine404JSONResponse{
+			Message: resp.JSON404.Message,
+		}, nil
+	}
+
+	if resp.JSON409 != nil {
+		return generated.WaiveFine409JSONResponse{
+			Message: resp.JSON409.Message,
+		}, nil
+	}
+
+	if resp.JSON200 == nil {
+		logger.Error("waive fine unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("waive fine: %s", string(resp.Body))
+	}
+
+	return generated.WaiveFine200JSONResponse(toFineResponse(*resp.JSON200)), nil
+}
+
+func (s *Server) ListFineTariffs(ctx context.Context, request generated.ListFineTariffsRequestObject) (generated.ListFineTariffsResponseObject, error) {
+	logger := slog.With("handler", "ListFineTariffs")
+
+	resp, err := s.reservation.ListFineTariffsWithResponse(ctx, s.token(ctx))
+	if err != nil {
+		logger.Error("list fine tariffs", "error", err)
+		return nil, fmt.Errorf("list fine tariffs: %w", err)
+	}
+
+	if resp.JSON200 == nil {
+		logger.Error("list fine tariffs unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("list fine tariffs: %s", string(resp.Body))
+	}
+
+	return generated.ListFineTariffs200JSONResponse(lo.Map(*resp.JSON200, func(item reservation.FineTariffResponse, _ int) generated.FineTariffResponse {
+		return generated.FineTariffResponse(item)
+	})), nil
+}
+
+func (s *Server) SetFineTariff(ctx context.Context, request generated.SetFineTariffRequestObject) (generated.SetFineTariffResponseObject, error) {
+	logger := slog.With("handler", "SetFineTariff")
+
+	resp, err := s.reservation.SetFineTariffWithResponse(ctx, reservation.SetFineTariffJSONRequestBody(*request.Body), s.token(ctx))
+	if err != nil {
+		logger.Error("set fine tariff", "error", err)
+		return nil, fmt.Errorf("set fine tariff: %w", err)
+	}
+
+	if resp.JSON400 != nil {
+		return generated.SetFineTariff400JSONResponse(toReservationValidationError(*resp.JSON400)), nil
+	}
+
+	if resp.JSON403 != nil {
+		return generated.SetFineTariff403JSONResponse{
+			Message: resp.JSON403.Message,
+		}, nil
+	}
+
+	if resp.JSON200 == nil {
+		logger.Error("set fine tariff unknown status", "status", resp.StatusCode())
+		return nil, fmt.Errorf("set fine tariff: %s", string(resp.Body))
+	}
+
+	return generated.SetFineTariff200JSONResponse(*resp.JSON200), nil
+}
+
+func (s *Server) reservationResponse(ctx context.Context, r reservation.BookReservationResponse) generated.BookReservationResponse {
+	book := generated.BookInfo{
+		BookUid: r.BookUid,
+	}
+
+	bookResp, err := s.library.GetBookWithResponse(ctx, r.BookUid, s.token(ctx))
+	if err == nil && bookResp.JSON200 != nil {
+		book = generated.BookInfo(*bookResp.JSON200)
+	}
+
+	lib := generated.LibraryResponse{
+		LibraryUid: r.LibraryUid,
+	}
+
+	libraryResp, err := s.library.GetLibraryWithResponse(ctx, r.LibraryUid, s.token(ctx))
+	if err == nil && libraryResp.JSON200 != nil {
+		lib = generated.LibraryResponse{
+			Address:    libraryResp.JSON200.Address,
+			City:       libraryResp.JSON200.City,
+			LibraryUid: libraryResp.JSON200.LibraryUid,
+			Name:       libraryResp.JSON200.Name,
+		}
+	}
+
+	return generated.BookReservationResponse{
+		Book:           book,
+		Library:        lib,
+		PickupUntil:    r.PickupUntil,
+		ReservationUid: r.ReservationUid,
+		StartDate:      r.StartDate,
+		Status:         generated.BookReservationResponseStatus(r.Status),
+		TillDate:       r.TillDate,
+	}
+}
+
+func (s *Server) holdResponse(ctx context.Context, h library.HoldResponse) generated.HoldResponse {
+	book := generated.BookInfo{
+		BookUid: h.BookUid,
+	}
+
+	bookResp, err := s.library.GetBookWithResponse(ctx, h.BookUid, s.token(ctx))
+	if err == nil && bookResp.JSON200 != nil {
+		book = generated.BookInfo(*bookResp.JSON200)
+	}
+
+	lib := generated.LibraryResponse{
+		LibraryUid: h.LibraryUid,
+	}
+
+	libraryResp, err := s.library.GetLibraryWithResponse(ctx, h.LibraryUid, s.token(ctx))
+	if err == nil && libraryResp.JSON200 != nil {
+		lib = generated.LibraryResponse{
+			Address:    libraryResp.JSON200.Address,
+			City:       libraryResp.JSON200.City,
+			LibraryUid: libraryResp.JSON200.LibraryUid,
+			Name:       libraryResp.JSON200.Name,
+		}
+	}
+
+	return generated.HoldResponse{
+		Book:        book,
+		ExpiresAt:   h.ExpiresAt,
+		HoldUid:     h.HoldUid,
+		Library:     lib,
+		Position:    h.Position,
+		RequestedAt: h.RequestedAt,
+		Status:      generated.HoldResponseStatus(h.Status),
+	}
+}
+
+func toFineResponse(f reservation.FineResponse) generated.FineResponse {
+	return generated.FineResponse{
+		Amount:         f.Amount,
+		ClosedAt:       f.ClosedAt,
+		ClosedBy:       f.ClosedBy,
+		Comment:        f.Comment,
+		CreatedAt:      f.CreatedAt,
+		DaysLate:       f.DaysLate,
+		FineUid:        f.FineUid,
+		ReservationUid: f.ReservationUid,
+		Status:         generated.FineResponseStatus(f.Status),
+		Username:       f.Username,
+	}
+}
+
+func (s *Server) rollbackReservation(ctx context.Context, reservationUid uuid.UUID) {
+	resp, err := s.reservation.CancelWithResponse(ctx, reservationUid, s.token(ctx))
+	if err != nil {
+		slog.Error("cancel reservation", "error", err, "reservation", reservationUid)
+		return
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		slog.Error("cancel reservation unknown status", "status", resp.StatusCode(), "reservation", reservationUid)
+	}
+}
+
+// releaseCopy undoes taking the copy for the reservation, which could not be
+// picked up. The copy taken by the booking is set aside for it again, so the
+// retried pickup gets the same copy.
+func (s *Server) releaseCopy(ctx context.Context, libraryUid, bookUid, reservationUid uuid.UUID) {
+	resp, err := s.library.ReleaseBookWithResponse(ctx, libraryUid, bookUid, &library.ReleaseBookParams{
+		ReservationUid: reservationUid,
+		Rebook:         lo.ToPtr(true),
+	}, s.token(ctx))
+	if err != nil {
+		slog.Error("release book", "error", err, "reservation", reservationUid)
+		return
+	}
+
+	if resp.JSON200 == nil {
+		slog.Error("release book unknown status", "status", resp.StatusCode(), "reservation", reservationUid)
+	}
+}
+
+// applyPenalties lowers the rating of the user for penalties, which were
+// recorded by reservation service, e.g. for overdue or written off books.
+// Penalties are acked only after the rating is saved, so the failed update
+// is retried on one of the next calls.
+func (s *Server) applyPenalties(ctx context.Context) {
+	claimResp, err := s.reservation.ClaimPenaltiesWithResponse(ctx, s.token(ctx))
+	if err != nil {
+		slog.Error("claim penalties", "error", err)
+		return
+	}
+
+	if claimResp.JSON200 == nil {
+		slog.Error("claim penalties unknown status", "status", claimResp.StatusCode())
+		return
+	}
+
+	claim := claimResp.JSON200
+	if claim.Count == 0 && claim.Stars == 0 {
+		return
+	}
+
+	resp, err := s.rating.SaveViolationsWithResponse(ctx, &rating.SaveViolationsParams{
+		Count:   claim.Count,
+		Penalty: lo.ToPtr(claim.Stars),
+	}, s.token(ctx))
+	if err != nil {
+		slog.Error("save violations", "error", err, "count", claim.Count, "stars", claim.Stars)
+		return
+	}
+
+	if resp.StatusCode() != http.StatusNoContent {
+		slog.Error("save violations unknown status", "status", resp.StatusCode(), "count", claim.Count, "stars", claim.Stars)
+		return
+	}
+
+	ackResp, err := s.reservation.AckPenaltiesWithResponse(ctx, claim.ClaimUid, s.token(ctx))
+	if err != nil {
+		slog.Error("ack penalties", "error", err, "claim", claim.ClaimUid)
+		return
+	}
+
+	if ackResp.StatusCode() != http.StatusNoContent {
+		slog.Error("ack penalties unknown status", "status", ackResp.StatusCode(), "claim", claim.ClaimUid)
+	}
+}
+
+func (s *Server) token(ctx context.Context) func(ctx context.Context, req *http.Request) error {
+	token := contextutils.GetToken(ctx)
+
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		return nil
+	}
+}
+
+func toBookInfos(books *[]library.BookInfo) *[]generated.BookInfo {
+	if books == nil {
+		return nil
+	}
+
+	return lo.ToPtr(lo.Map(*books, func(item library.BookInfo, _ int) generated.BookInfo {
+		return generated.BookInfo(item)
+	}))
 }
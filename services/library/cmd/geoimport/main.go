@@ -0,0 +1,130 @@
+// Command geoimport loads library coordinates geocoded offline from a csv
+// file with library_uid,latitude,longitude rows.
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/kelseyhightower/envconfig"
+	_ "github.com/lib/pq"
+	"io"
+	"log/slog"
+	"os"
+	"strconv"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	path := flag.String("file", "", "csv file with library_uid,latitude,longitude rows")
+	flag.Parse()
+
+	if *path == "" {
+		return errors.New("file is required")
+	}
+
+	var cfg config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	file, err := os.Open(*path)
+	if err != nil {
+		return fmt.Errorf("open file: %w", err)
+	}
+	defer file.Close()
+
+	db, err := sqlx.Connect("postgres", cfg.dsn())
+	if err != nil {
+		return fmt.Errorf("connect to db: %w", err)
+	}
+	defer db.Close()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = 3
+
+	updated := 0
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("read line %d: %w", line, err)
+		}
+
+		libraryUID, err := uuid.Parse(record[0])
+		if err != nil {
+			if line == 1 {
+				continue
+			}
+
+			return fmt.Errorf("parse library uid on line %d: %w", line, err)
+		}
+
+		latitude, err := strconv.ParseFloat(record[1], 64)
+		if err != nil {
+			return fmt.Errorf("parse latitude on line %d: %w", line, err)
+		}
+
+		longitude, err := strconv.ParseFloat(record[2], 64)
+		if err != nil {
+			return fmt.Errorf("parse longitude on line %d: %w", line, err)
+		}
+
+		query := `update library set latitude = $2, longitude = $3 where library_uid = $1`
+		res, err := tx.Exec(query, libraryUID, latitude, longitude)
+		if err != nil {
+			return fmt.Errorf("update library on line %d: %w", line, err)
+		}
+
+		if n, _ := res.RowsAffected(); n == 0 {
+			slog.Warn("library not found", "line", line, "library", libraryUID)
+			continue
+		}
+
+		updated++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	slog.Info("coordinates imported", "libraries", updated)
+
+	return nil
+}
+
+type config struct {
+	PostgresHost     string `envconfig:"PGHOST" required:"true"`
+	PostgresPort     int    `envconfig:"PGPORT" required:"true"`
+	PostgresUser     string `envconfig:"PGUSER" required:"true"`
+	PostgresPassword string `envconfig:"PGPASSWORD" required:"true"`
+	PostgresDB       string `envconfig:"PGDB" required:"true"`
+	PostgresSSL      bool   `envconfig:"PGSSL" default:"false"`
+}
+
+func (c config) dsn() string {
+	sslMode := ""
+	if !c.PostgresSSL {
+		sslMode = "sslmode=disable"
+	}
+
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s %s", c.PostgresHost, c.PostgresPort, c.PostgresUser, c.PostgresPassword, c.PostgresDB, sslMode)
+}
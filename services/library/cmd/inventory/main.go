@@ -0,0 +1,114 @@
+// Command inventory exports the inventory report of a library as csv or xlsx.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/kelseyhightower/envconfig"
+	_ "github.com/lib/pq"
+	"github.com/muhomorfus/ds-lab-02/services/library/internal/report"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	libraryFlag := flag.String("library", "", "uuid of the library")
+	format := flag.String("format", string(report.CSV), "report format, csv or xlsx")
+	out := flag.String("out", "", "output file, by default inventory-<library>-<date>.<format>, - for stdout")
+	flag.Parse()
+
+	if *libraryFlag == "" {
+		return errors.New("library is required")
+	}
+
+	libraryUID, err := uuid.Parse(*libraryFlag)
+	if err != nil {
+		return fmt.Errorf("parse library uid: %w", err)
+	}
+
+	if !report.Format(*format).Valid() {
+		return fmt.Errorf("%w: %q", report.ErrUnknownFormat, *format)
+	}
+
+	var cfg config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	db, err := sqlx.Connect("postgres", cfg.dsn())
+	if err != nil {
+		return fmt.Errorf("connect to db: %w", err)
+	}
+	defer db.Close()
+
+	var exists bool
+	if err := db.GetContext(ctx, &exists, `select exists(select 1 from library where library_uid = $1)`, libraryUID); err != nil {
+		return fmt.Errorf("select library: %w", err)
+	}
+
+	if !exists {
+		return fmt.Errorf("library %s not found", libraryUID)
+	}
+
+	path := *out
+	if path == "" {
+		path = report.FileName(libraryUID, time.Now(), report.Format(*format))
+	}
+
+	var w io.Writer = os.Stdout
+	if path != "-" {
+		file, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("create file: %w", err)
+		}
+		defer file.Close()
+
+		w = file
+	}
+
+	if err := report.Inventory(ctx, db, libraryUID, report.Format(*format), w); err != nil {
+		return fmt.Errorf("write report: %w", err)
+	}
+
+	if path != "-" {
+		slog.Info("inventory report written", "file", path)
+	}
+
+	return nil
+}
+
+type config struct {
+	PostgresHost     string `envconfig:"PGHOST" required:"true"`
+	PostgresPort     int    `envconfig:"PGPORT" required:"true"`
+	PostgresUser     string `envconfig:"PGUSER" required:"true"`
+	PostgresPassword string `envconfig:"PGPASSWORD" required:"true"`
+	PostgresDB       string `envconfig:"PGDB" required:"true"`
+	PostgresSSL      bool   `envconfig:"PGSSL" default:"false"`
+}
+
+func (c config) dsn() string {
+	sslMode := ""
+	if !c.PostgresSSL {
+		sslMode = "sslmode=disable"
+	}
+
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s %s", c.PostgresHost, c.PostgresPort, c.PostgresUser, c.PostgresPassword, c.PostgresDB, sslMode)
+}
@@ -0,0 +1,139 @@
+// Command loanbackfill registers rented copies for loans, which were taken
+// before library started to track individual copies. Such loans have no copy
+// in book_copies, so their return could not be matched with stock.
+//
+// Loans are read through the reservation service API, so the command needs
+// a token of library staff. It is safe to run several times.
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/kelseyhightower/envconfig"
+	_ "github.com/lib/pq"
+	"github.com/muhomorfus/ds-lab-02/services/library/internal/clients/reservation"
+	"github.com/samber/lo"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+const pageSize = 100
+
+func run() error {
+	var cfg config
+	if err := envconfig.Process("", &cfg); err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	db, err := sqlx.Connect("postgres", cfg.dsn())
+	if err != nil {
+		return fmt.Errorf("connect to db: %w", err)
+	}
+	defer db.Close()
+
+	reservationClient, err := reservation.NewClientWithResponses(cfg.ReservationAddress, reservation.WithHTTPClient(&http.Client{Timeout: time.Minute}))
+	if err != nil {
+		return fmt.Errorf("create reservation client: %w", err)
+	}
+
+	token := func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+cfg.ReservationToken)
+		return nil
+	}
+
+	query := `insert into book_copies (copy_uid, barcode, book_id, library_id, status, reservation_uid, rented_at)
+		select $1, $2, b.id, l.id, 'RENTED', $3, $4
+		from library l, books b
+		where l.library_uid = $5 and b.book_uid = $6
+		  and not exists(select 1 from book_copies where reservation_uid = $3)`
+
+	var loans, registered int
+	var cursor *string
+	for {
+		resp, err := reservationClient.ListWithResponse(ctx, &reservation.ListParams{
+			Size:     lo.ToPtr(pageSize),
+			Status:   &[]reservation.ListParamsStatus{reservation.ListParamsStatusRENTED, reservation.ListParamsStatusOVERDUE},
+			Sort:     lo.ToPtr(reservation.StartDate),
+			Order:    lo.ToPtr(reservation.Asc),
+			Cursor:   cursor,
+			AllUsers: lo.ToPtr(true),
+		}, token)
+		if err != nil {
+			return fmt.Errorf("list loans: %w", err)
+		}
+
+		if resp.JSON200 == nil {
+			return fmt.Errorf("list loans: status %d: %s", resp.StatusCode(), string(resp.Body))
+		}
+
+		for _, l := range resp.JSON200.Items {
+			rentedAt, err := time.Parse(time.DateOnly, l.StartDate)
+			if err != nil {
+				return fmt.Errorf("parse start date of reservation %s: %w", l.ReservationUid, err)
+			}
+
+			copyUID := uuid.New()
+			barcode := strings.ToUpper(strings.ReplaceAll(copyUID.String(), "-", "")[:12])
+
+			res, err := db.ExecContext(ctx, query, copyUID, barcode, l.ReservationUid, rentedAt, l.LibraryUid, l.BookUid)
+			if err != nil {
+				return fmt.Errorf("insert copy for reservation %s: %w", l.ReservationUid, err)
+			}
+
+			count, err := res.RowsAffected()
+			if err != nil {
+				return fmt.Errorf("count inserted copies: %w", err)
+			}
+
+			loans++
+			registered += int(count)
+		}
+
+		if resp.JSON200.NextCursor == nil {
+			break
+		}
+
+		cursor = resp.JSON200.NextCursor
+	}
+
+	slog.Info("rented copies registered", "loans", loans, "registered", registered)
+
+	return nil
+}
+
+type config struct {
+	PostgresHost       string `envconfig:"PGHOST" required:"true"`
+	PostgresPort       int    `envconfig:"PGPORT" required:"true"`
+	PostgresUser       string `envconfig:"PGUSER" required:"true"`
+	PostgresPassword   string `envconfig:"PGPASSWORD" required:"true"`
+	PostgresDB         string `envconfig:"PGDB" required:"true"`
+	PostgresSSL        bool   `envconfig:"PGSSL" default:"false"`
+	ReservationAddress string `envconfig:"RESERVATION_ADDRESS" required:"true"`
+	ReservationToken   string `envconfig:"RESERVATION_TOKEN" required:"true"`
+}
+
+func (c config) dsn() string {
+	sslMode := ""
+	if !c.PostgresSSL {
+		sslMode = "sslmode=disable"
+	}
+
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s %s", c.PostgresHost, c.PostgresPort, c.PostgresUser, c.PostgresPassword, c.PostgresDB, sslMode)
+}
@@ -9,11 +9,13 @@ import (
 	_ "github.com/lib/pq"
 	"github.com/muhomorfus/ds-lab-02/services/auth/jwt"
 	"github.com/muhomorfus/ds-lab-02/services/library/deployments/migrations"
+	"github.com/muhomorfus/ds-lab-02/services/library/internal/blob"
 	"github.com/muhomorfus/ds-lab-02/services/library/internal/generated"
 	"github.com/muhomorfus/ds-lab-02/services/library/internal/openapi"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 func main() {
@@ -38,7 +40,10 @@ func run() error {
 		return fmt.Errorf("run migrations: %w", err)
 	}
 
-	server := openapi.New(db)
+	server := openapi.New(db, blob.NewFS(cfg.CoversDir), cfg.MaxCoverSize, openapi.HoldPolicy{
+		PickupWindow: cfg.HoldPickupWindow,
+		RatingWeight: cfg.HoldRatingWeight,
+	})
 	router := echo.New()
 	router.Use(jwt.Middleware(cfg.JWKsURI))
 	generated.RegisterHandlers(router, generated.NewStrictHandler(server, nil))
@@ -46,6 +51,8 @@ func run() error {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
+	go server.RunExpiry(ctx, cfg.ExpiryInterval)
+
 	go func() {
 		<-ctx.Done()
 
@@ -61,14 +68,19 @@ func run() error {
 }
 
 type config struct {
-	PostgresHost     string `envconfig:"PGHOST" required:"true"`
-	PostgresPort     int    `envconfig:"PGPORT" required:"true"`
-	PostgresUser     string `envconfig:"PGUSER" required:"true"`
-	PostgresPassword string `envconfig:"PGPASSWORD" required:"true"`
-	PostgresDB       string `envconfig:"PGDB" required:"true"`
-	PostgresSSL      bool   `envconfig:"PGSSL" default:"false"`
-	Port             string `envconfig:"PORT" required:"true"`
-	JWKsURI          string `envconfig:"JWKS_URI" required:"true"`
+	PostgresHost     string        `envconfig:"PGHOST" required:"true"`
+	PostgresPort     int           `envconfig:"PGPORT" required:"true"`
+	PostgresUser     string        `envconfig:"PGUSER" required:"true"`
+	PostgresPassword string        `envconfig:"PGPASSWORD" required:"true"`
+	PostgresDB       string        `envconfig:"PGDB" required:"true"`
+	PostgresSSL      bool          `envconfig:"PGSSL" default:"false"`
+	Port             string        `envconfig:"PORT" required:"true"`
+	JWKsURI          string        `envconfig:"JWKS_URI" required:"true"`
+	CoversDir        string        `envconfig:"COVERS_DIR" default:"/var/lib/library/covers"`
+	MaxCoverSize     int64         `envconfig:"MAX_COVER_SIZE" default:"5242880"`
+	HoldPickupWindow time.Duration `envconfig:"HOLD_PICKUP_WINDOW" default:"72h"`
+	HoldRatingWeight time.Duration `envconfig:"HOLD_RATING_WEIGHT" default:"0"`
+	ExpiryInterval   time.Duration `envconfig:"EXPIRY_INTERVAL" default:"1m"`
 }
 
 func (c config) dsn() string {
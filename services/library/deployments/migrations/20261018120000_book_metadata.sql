@@ -0,0 +1,71 @@
+-- +goose Up
+-- +goose StatementBegin
+create function isbn10_valid(isbn text) returns boolean as
+$$
+declare
+    total int := 0;
+    digit int;
+begin
+    if isbn !~ '^[0-9]{9}[0-9X]$' then
+        return false;
+    end if;
+
+    for i in 1..10 loop
+        if substr(isbn, i, 1) = 'X' then
+            digit := 10;
+        else
+            digit := substr(isbn, i, 1)::int;
+        end if;
+
+        total := total + digit * (11 - i);
+    end loop;
+
+    return total % 11 = 0;
+end;
+$$ language plpgsql immutable;
+
+create function isbn13_valid(isbn text) returns boolean as
+$$
+declare
+    total int := 0;
+begin
+    if isbn !~ '^97[89][0-9]{10}$' then
+        return false;
+    end if;
+
+    for i in 1..13 loop
+        total := total + substr(isbn, i, 1)::int * (case when i % 2 = 0 then 3 else 1 end);
+    end loop;
+
+    return total % 10 = 0;
+end;
+$$ language plpgsql immutable;
+
+alter table books
+    add column isbn10           varchar(10) unique
+        check (isbn10_valid(isbn10)),
+    add column isbn13           varchar(13) unique
+        check (isbn13_valid(isbn13)),
+    add column publication_year int
+        check (publication_year between 1450 and 2100),
+    add column publisher        varchar(255),
+    add column language         varchar(35),
+    add column page_count       int
+        check (page_count > 0),
+    add column description      text;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table books
+    drop column isbn10,
+    drop column isbn13,
+    drop column publication_year,
+    drop column publisher,
+    drop column language,
+    drop column page_count,
+    drop column description;
+
+drop function isbn13_valid(text);
+drop function isbn10_valid(text);
+-- +goose StatementEnd
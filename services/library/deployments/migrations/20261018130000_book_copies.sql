@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+create table book_copies
+(
+    id              serial primary key,
+    copy_uid        uuid unique        not null,
+    barcode         varchar(32) unique not null,
+    book_id         int                not null references books (id),
+    library_id      int                not null references library (id),
+    condition       varchar(20)        not null default 'EXCELLENT'
+        check (condition in ('EXCELLENT', 'GOOD', 'BAD')),
+    status          varchar(20)        not null default 'AVAILABLE'
+        check (status in ('AVAILABLE', 'RENTED')),
+    reservation_uid uuid
+);
+
+create index book_copies_library_book_idx on book_copies (library_id, book_id);
+create index book_copies_reservation_uid_idx on book_copies (reservation_uid);
+
+insert into book_copies (copy_uid, barcode, book_id, library_id, condition, status)
+select c.copy_uid, upper(substr(replace(c.copy_uid::text, '-', ''), 1, 12)), c.book_id, c.library_id, c.condition, 'AVAILABLE'
+from (select gen_random_uuid() as copy_uid, lb.book_id, lb.library_id, coalesce(b.condition, 'EXCELLENT') as condition
+      from library_books lb
+               join books b on b.id = lb.book_id
+               cross join generate_series(1, lb.available_count)) c;
+
+drop table library_books;
+
+alter table books
+    drop column condition;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table books
+    add column condition varchar(20) default 'EXCELLENT'
+        check (condition in ('EXCELLENT', 'GOOD', 'BAD'));
+
+update books b
+set condition = c.condition
+from (select distinct on (book_id) book_id, condition from book_copies order by book_id, id) c
+where c.book_id = b.id;
+
+create table library_books
+(
+    book_id         int references books (id),
+    library_id      int references library (id),
+    available_count int not null
+);
+
+insert into library_books (book_id, library_id, available_count)
+select book_id, library_id, count(*) filter (where status = 'AVAILABLE')
+from book_copies
+group by book_id, library_id;
+
+drop table book_copies;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+create table copy_condition_history
+(
+    id              serial primary key,
+    copy_id         int         not null references book_copies (id),
+    old_condition   varchar(20) not null
+        check (old_condition in ('EXCELLENT', 'GOOD', 'BAD')),
+    new_condition   varchar(20) not null
+        check (new_condition in ('EXCELLENT', 'GOOD', 'BAD')),
+    reservation_uid uuid,
+    changed_by      varchar(80) not null,
+    changed_at      timestamp   not null default now()
+);
+
+create index copy_condition_history_copy_id_idx on copy_condition_history (copy_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table copy_condition_history;
+-- +goose StatementEnd
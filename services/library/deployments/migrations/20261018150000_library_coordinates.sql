@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table library
+    add column latitude  double precision
+        check (latitude between -90 and 90),
+    add column longitude double precision
+        check (longitude between -180 and 180),
+    add constraint library_coordinates_check
+        check ((latitude is null) = (longitude is null));
+
+create index library_coordinates_idx on library (latitude, longitude);
+create index library_city_idx on library (city);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index library_city_idx;
+drop index library_coordinates_idx;
+
+alter table library
+    drop constraint library_coordinates_check,
+    drop column latitude,
+    drop column longitude;
+-- +goose StatementEnd
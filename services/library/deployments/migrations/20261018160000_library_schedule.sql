@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table library
+    add column timezone varchar(64) not null default 'Europe/Moscow';
+
+create table library_opening_hours
+(
+    library_id int      not null references library (id) on delete cascade,
+    weekday    smallint not null check (weekday between 1 and 7),
+    opens_at   time     not null,
+    closes_at  time     not null,
+    primary key (library_id, weekday),
+    check (opens_at < closes_at)
+);
+
+create table library_holidays
+(
+    id         serial primary key,
+    library_id int  not null references library (id) on delete cascade,
+    date       date not null,
+    reason     varchar(255),
+    unique (library_id, date)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table library_holidays;
+drop table library_opening_hours;
+
+alter table library
+    drop column timezone;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table book_copies
+    drop constraint book_copies_status_check,
+    add constraint book_copies_status_check
+        check (status in ('AVAILABLE', 'RENTED', 'WITHDRAWN'));
+
+create table stock_movements
+(
+    id              serial primary key,
+    library_id      int          not null references library (id),
+    book_id         int          not null references books (id),
+    copy_id         int references book_copies (id),
+    actor           varchar(80)  not null,
+    reason          varchar(20)  not null
+        check (reason in ('CHECKOUT', 'RETURN', 'ADJUST', 'TRANSFER')),
+    delta           int          not null check (delta <> 0),
+    resulting_count int          not null check (resulting_count >= 0),
+    correlation_uid uuid,
+    comment         varchar(255),
+    created_at      timestamptz  not null default now()
+);
+
+create index stock_movements_library_book_idx on stock_movements (library_id, book_id, id);
+create index stock_movements_correlation_uid_idx on stock_movements (correlation_uid);
+
+create rule stock_movements_no_update as on update to stock_movements do instead nothing;
+create rule stock_movements_no_delete as on delete to stock_movements do instead nothing;
+
+insert into stock_movements (library_id, book_id, actor, reason, delta, resulting_count, comment)
+select library_id, book_id, 'system', 'ADJUST', count(*), count(*), 'initial stock'
+from book_copies
+where status = 'AVAILABLE'
+group by library_id, book_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table stock_movements;
+
+update book_copies
+set status = 'AVAILABLE'
+where status = 'WITHDRAWN';
+
+alter table book_copies
+    drop constraint book_copies_status_check,
+    add constraint book_copies_status_check
+        check (status in ('AVAILABLE', 'RENTED'));
+-- +goose StatementEnd
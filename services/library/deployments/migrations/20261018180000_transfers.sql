@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table book_copies
+    drop constraint book_copies_status_check,
+    add constraint book_copies_status_check
+        check (status in ('AVAILABLE', 'RENTED', 'WITHDRAWN', 'IN_TRANSIT'));
+
+create table transfers
+(
+    id                     serial primary key,
+    transfer_uid           uuid unique not null,
+    book_id                int         not null references books (id),
+    source_library_id      int         not null references library (id),
+    destination_library_id int         not null references library (id),
+    quantity               int         not null check (quantity > 0),
+    status                 varchar(20) not null default 'REQUESTED'
+        check (status in ('REQUESTED', 'IN_TRANSIT', 'RECEIVED', 'CANCELLED')),
+    requested_by           varchar(80) not null,
+    requested_at           timestamptz not null default now(),
+    dispatched_at          timestamptz,
+    received_at            timestamptz,
+    cancelled_at           timestamptz,
+    check (source_library_id <> destination_library_id)
+);
+
+create index transfers_source_library_idx on transfers (source_library_id, id);
+create index transfers_destination_library_idx on transfers (destination_library_id, id);
+
+create table transfer_copies
+(
+    transfer_id int not null references transfers (id),
+    copy_id     int not null references book_copies (id),
+    primary key (transfer_id, copy_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table transfer_copies;
+drop table transfers;
+
+update book_copies
+set status = 'AVAILABLE'
+where status = 'IN_TRANSIT';
+
+alter table book_copies
+    drop constraint book_copies_status_check,
+    add constraint book_copies_status_check
+        check (status in ('AVAILABLE', 'RENTED', 'WITHDRAWN'));
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table book_copies
+    add column rented_at timestamptz;
+
+update book_copies c
+set rented_at = m.created_at
+from (select distinct on (copy_id) copy_id, created_at
+      from stock_movements
+      where reason = 'CHECKOUT'
+      order by copy_id, id desc) m
+where m.copy_id = c.id
+  and c.status = 'RENTED';
+
+create table circulation_daily
+(
+    library_id      int    not null references library (id),
+    book_id         int    not null references books (id),
+    day             date   not null,
+    checkouts       int    not null default 0,
+    returns         int    not null default 0,
+    loan_seconds    bigint not null default 0,
+    completed_loans int    not null default 0,
+    primary key (library_id, book_id, day)
+);
+
+create index circulation_daily_day_idx on circulation_daily (day);
+
+insert into circulation_daily (library_id, book_id, day, checkouts, returns)
+select m.library_id, m.book_id, (m.created_at at time zone l.timezone)::date,
+       count(*) filter (where m.reason = 'CHECKOUT'),
+       count(*) filter (where m.reason = 'RETURN')
+from stock_movements m
+         join library l on l.id = m.library_id
+where m.reason in ('CHECKOUT', 'RETURN')
+group by m.library_id, m.book_id, (m.created_at at time zone l.timezone)::date;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table circulation_daily;
+
+alter table book_copies
+    drop column rented_at;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table books
+    add column cover_hash         varchar(64),
+    add column cover_content_type varchar(32),
+    add column cover_updated_at   timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table books
+    drop column cover_hash,
+    drop column cover_content_type,
+    drop column cover_updated_at;
+-- +goose StatementEnd
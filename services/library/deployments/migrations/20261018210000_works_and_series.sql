@@ -0,0 +1,67 @@
+-- +goose Up
+-- +goose StatementBegin
+create table works
+(
+    id       serial primary key,
+    work_uid uuid unique  not null,
+    title    varchar(255) not null,
+    author   varchar(255)
+);
+
+create table series
+(
+    id         serial primary key,
+    series_uid uuid unique  not null,
+    name       varchar(255) not null,
+    author     varchar(255)
+);
+
+alter table books
+    add column work_id       int references works (id),
+    add column series_id     int references series (id),
+    add column volume_number int check (volume_number > 0),
+    add constraint books_series_volume_check
+        check ((series_id is null) = (volume_number is null)),
+    add constraint books_series_volume_key
+        unique (series_id, volume_number);
+
+create temporary table book_works on commit drop as
+select id as book_id, gen_random_uuid() as work_uid, name, author
+from books;
+
+insert into works (work_uid, title, author)
+select work_uid, name, author
+from book_works;
+
+update books b
+set work_id = w.id
+from book_works bw
+         join works w on w.work_uid = bw.work_uid
+where bw.book_id = b.id;
+
+alter table books
+    alter column work_id set not null;
+
+create index books_work_id_idx on books (work_id);
+
+insert into series (series_uid, name, author)
+values ('2f3c7c8e-6d1a-4f7b-9a55-1c0b9f7e3a21', 'Краткий курс C++', 'Бьерн Страуструп');
+
+update books
+set series_id     = (select id from series where series_uid = '2f3c7c8e-6d1a-4f7b-9a55-1c0b9f7e3a21'),
+    volume_number = 1
+where book_uid = 'f7cdc58f-2caf-4b15-9727-f89dcc629b27';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table books
+    drop constraint books_series_volume_key,
+    drop constraint books_series_volume_check,
+    drop column volume_number,
+    drop column series_id,
+    drop column work_id;
+
+drop table series;
+drop table works;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+create table copy_write_offs
+(
+    id              serial primary key,
+    copy_id         int          not null unique references book_copies (id),
+    reservation_uid uuid,
+    reason          varchar(20)  not null
+        check (reason in ('LOST', 'DAMAGED')),
+    actor           varchar(80)  not null,
+    comment         varchar(255),
+    created_at      timestamptz  not null default now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table copy_write_offs;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+create extension if not exists pg_trgm;
+
+create index books_name_trgm_idx on books using gin (name gin_trgm_ops);
+create index books_author_trgm_idx on books using gin (author gin_trgm_ops);
+create index books_genre_trgm_idx on books using gin (genre gin_trgm_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index books_genre_trgm_idx;
+drop index books_author_trgm_idx;
+drop index books_name_trgm_idx;
+-- +goose StatementEnd
@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table book_copies
+    drop constraint book_copies_status_check,
+    add constraint book_copies_status_check
+        check (status in ('AVAILABLE', 'RENTED', 'WITHDRAWN', 'IN_TRANSIT', 'ON_HOLD'));
+
+alter table stock_movements
+    drop constraint stock_movements_reason_check,
+    add constraint stock_movements_reason_check
+        check (reason in ('CHECKOUT', 'RETURN', 'ADJUST', 'TRANSFER', 'HOLD'));
+
+create table holds
+(
+    id           serial primary key,
+    hold_uid     uuid unique  not null,
+    library_id   int          not null references library (id),
+    book_id      int          not null references books (id),
+    username     varchar(80)  not null,
+    rating       int          not null default 0,
+    priority     timestamptz  not null,
+    status       varchar(20)  not null
+        check (status in ('WAITING', 'READY', 'FULFILLED', 'CANCELLED', 'EXPIRED')),
+    copy_id      int references book_copies (id),
+    requested_at timestamptz  not null default now(),
+    ready_at     timestamptz,
+    expires_at   timestamptz,
+    closed_at    timestamptz,
+    check ((status = 'READY') = (copy_id is not null and expires_at is not null))
+);
+
+create unique index holds_active_user_idx on holds (library_id, book_id, username)
+    where status in ('WAITING', 'READY');
+create index holds_queue_idx on holds (library_id, book_id, priority, id)
+    where status = 'WAITING';
+create index holds_expires_at_idx on holds (expires_at)
+    where status = 'READY';
+create index holds_username_idx on holds (username);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+update book_copies c
+set status = 'AVAILABLE'
+where status = 'ON_HOLD';
+
+drop table holds;
+
+alter table stock_movements
+    drop constraint stock_movements_reason_check,
+    add constraint stock_movements_reason_check
+        check (reason in ('CHECKOUT', 'RETURN', 'ADJUST', 'TRANSFER')) not valid;
+
+alter table book_copies
+    drop constraint book_copies_status_check,
+    add constraint book_copies_status_check
+        check (status in ('AVAILABLE', 'RENTED', 'WITHDRAWN', 'IN_TRANSIT'));
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table stock_movements
+    drop constraint stock_movements_reason_check,
+    add constraint stock_movements_reason_check
+        check (reason in ('CHECKOUT', 'RETURN', 'ADJUST', 'TRANSFER', 'HOLD', 'CANCEL'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table stock_movements
+    drop constraint stock_movements_reason_check,
+    add constraint stock_movements_reason_check
+        check (reason in ('CHECKOUT', 'RETURN', 'ADJUST', 'TRANSFER', 'HOLD')) not valid;
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table book_copies
+    drop constraint book_copies_status_check,
+    add constraint book_copies_status_check
+        check (status in ('AVAILABLE', 'RENTED', 'WITHDRAWN', 'IN_TRANSIT', 'ON_HOLD', 'BOOKED'));
+
+alter table stock_movements
+    drop constraint stock_movements_reason_check,
+    add constraint stock_movements_reason_check
+        check (reason in ('CHECKOUT', 'RETURN', 'ADJUST', 'TRANSFER', 'HOLD', 'CANCEL', 'BOOKING'));
+
+create table copy_bookings
+(
+    id              serial primary key,
+    reservation_uid uuid unique not null,
+    library_id      int         not null references library (id),
+    book_id         int         not null references books (id),
+    copy_id         int         not null references book_copies (id),
+    username        varchar(80) not null,
+    pickup_date     date        not null,
+    status          varchar(20) not null
+        check (status in ('BOOKED', 'PICKED_UP', 'CANCELLED', 'EXPIRED')),
+    expires_at      timestamptz not null,
+    created_at      timestamptz not null default now(),
+    closed_at       timestamptz
+);
+
+create index copy_bookings_expires_at_idx on copy_bookings (expires_at)
+    where status = 'BOOKED';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+update book_copies
+set status          = 'AVAILABLE',
+    reservation_uid = null
+where status = 'BOOKED';
+
+drop table copy_bookings;
+
+alter table stock_movements
+    drop constraint stock_movements_reason_check,
+    add constraint stock_movements_reason_check
+        check (reason in ('CHECKOUT', 'RETURN', 'ADJUST', 'TRANSFER', 'HOLD', 'CANCEL')) not valid;
+
+alter table book_copies
+    drop constraint book_copies_status_check,
+    add constraint book_copies_status_check
+        check (status in ('AVAILABLE', 'RENTED', 'WITHDRAWN', 'IN_TRANSIT', 'ON_HOLD'));
+-- +goose StatementEnd
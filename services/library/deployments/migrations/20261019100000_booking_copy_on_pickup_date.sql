@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Bookings hold stock from the pickup date: the copy is set aside on that
+-- date, until then the booking has no copy. Bookings made before keep the
+-- copies they have already set aside.
+alter table copy_bookings
+    alter column copy_id drop not null;
+
+create index copy_bookings_pickup_date_idx on copy_bookings (pickup_date)
+    where status = 'BOOKED' and copy_id is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index copy_bookings_pickup_date_idx;
+
+delete
+from copy_bookings
+where copy_id is null;
+
+alter table copy_bookings
+    alter column copy_id set not null;
+-- +goose StatementEnd
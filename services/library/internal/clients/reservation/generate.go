@@ -0,0 +1,3 @@
+package reservation
+
+//go:generate oapi-codegen --config=oapi.yaml ../../../../reservation/api/service.yaml
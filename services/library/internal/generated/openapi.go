@@ -7,7 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
@@ -15,6 +17,54 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for BookCopyResponseCondition.
+const (
+	BookCopyResponseConditionBAD       BookCopyResponseCondition = "BAD"
+	BookCopyResponseConditionEXCELLENT BookCopyResponseCondition = "EXCELLENT"
+	BookCopyResponseConditionGOOD      BookCopyResponseCondition = "GOOD"
+)
+
+// Defines values for BookCopyResponseStatus.
+const (
+	BookCopyResponseStatusAVAILABLE BookCopyResponseStatus = "AVAILABLE"
+	BookCopyResponseStatusBOOKED    BookCopyResponseStatus = "BOOKED"
+	BookCopyResponseStatusINTRANSIT BookCopyResponseStatus = "IN_TRANSIT"
+	BookCopyResponseStatusONHOLD    BookCopyResponseStatus = "ON_HOLD"
+	BookCopyResponseStatusRENTED    BookCopyResponseStatus = "RENTED"
+	BookCopyResponseStatusWITHDRAWN BookCopyResponseStatus = "WITHDRAWN"
+)
+
+// Defines values for BookingResponseStatus.
+const (
+	BookingResponseStatusBOOKED    BookingResponseStatus = "BOOKED"
+	BookingResponseStatusCANCELLED BookingResponseStatus = "CANCELLED"
+	BookingResponseStatusEXPIRED   BookingResponseStatus = "EXPIRED"
+	BookingResponseStatusPICKEDUP  BookingResponseStatus = "PICKED_UP"
+)
+
+// Defines values for ConditionChangeResponseNewCondition.
+const (
+	ConditionChangeResponseNewConditionBAD       ConditionChangeResponseNewCondition = "BAD"
+	ConditionChangeResponseNewConditionEXCELLENT ConditionChangeResponseNewCondition = "EXCELLENT"
+	ConditionChangeResponseNewConditionGOOD      ConditionChangeResponseNewCondition = "GOOD"
+)
+
+// Defines values for ConditionChangeResponseOldCondition.
+const (
+	ConditionChangeResponseOldConditionBAD       ConditionChangeResponseOldCondition = "BAD"
+	ConditionChangeResponseOldConditionEXCELLENT ConditionChangeResponseOldCondition = "EXCELLENT"
+	ConditionChangeResponseOldConditionGOOD      ConditionChangeResponseOldCondition = "GOOD"
+)
+
+// Defines values for HoldResponseStatus.
+const (
+	HoldResponseStatusCANCELLED HoldResponseStatus = "CANCELLED"
+	HoldResponseStatusEXPIRED   HoldResponseStatus = "EXPIRED"
+	HoldResponseStatusFULFILLED HoldResponseStatus = "FULFILLED"
+	HoldResponseStatusREADY     HoldResponseStatus = "READY"
+	HoldResponseStatusWAITING   HoldResponseStatus = "WAITING"
+)
+
 // Defines values for LibraryBookResponseCondition.
 const (
 	LibraryBookResponseConditionBAD       LibraryBookResponseCondition = "BAD"
@@ -29,6 +79,142 @@ const (
 	ReturnBookRequestConditionGOOD      ReturnBookRequestCondition = "GOOD"
 )
 
+// Defines values for StockAdjustmentRequestCondition.
+const (
+	StockAdjustmentRequestConditionBAD       StockAdjustmentRequestCondition = "BAD"
+	StockAdjustmentRequestConditionEXCELLENT StockAdjustmentRequestCondition = "EXCELLENT"
+	StockAdjustmentRequestConditionGOOD      StockAdjustmentRequestCondition = "GOOD"
+)
+
+// Defines values for StockMovementResponseReason.
+const (
+	StockMovementResponseReasonADJUST   StockMovementResponseReason = "ADJUST"
+	StockMovementResponseReasonBOOKING  StockMovementResponseReason = "BOOKING"
+	StockMovementResponseReasonCANCEL   StockMovementResponseReason = "CANCEL"
+	StockMovementResponseReasonCHECKOUT StockMovementResponseReason = "CHECKOUT"
+	StockMovementResponseReasonHOLD     StockMovementResponseReason = "HOLD"
+	StockMovementResponseReasonRETURN   StockMovementResponseReason = "RETURN"
+	StockMovementResponseReasonTRANSFER StockMovementResponseReason = "TRANSFER"
+)
+
+// Defines values for SuggestionResponseKind.
+const (
+	AUTHOR SuggestionResponseKind = "AUTHOR"
+	BOOK   SuggestionResponseKind = "BOOK"
+	GENRE  SuggestionResponseKind = "GENRE"
+)
+
+// Defines values for TransferResponseStatus.
+const (
+	TransferResponseStatusCANCELLED TransferResponseStatus = "CANCELLED"
+	TransferResponseStatusINTRANSIT TransferResponseStatus = "IN_TRANSIT"
+	TransferResponseStatusRECEIVED  TransferResponseStatus = "RECEIVED"
+	TransferResponseStatusREQUESTED TransferResponseStatus = "REQUESTED"
+)
+
+// Defines values for WriteOffRequestReason.
+const (
+	DAMAGED WriteOffRequestReason = "DAMAGED"
+	LOST    WriteOffRequestReason = "LOST"
+)
+
+// Defines values for GetBookCoverParamsSize.
+const (
+	Original  GetBookCoverParamsSize = "original"
+	Thumbnail GetBookCoverParamsSize = "thumbnail"
+)
+
+// Defines values for ListLibrariesParamsSort.
+const (
+	ListLibrariesParamsSortCity ListLibrariesParamsSort = "city"
+	ListLibrariesParamsSortName ListLibrariesParamsSort = "name"
+)
+
+// Defines values for ListLibrariesParamsOrder.
+const (
+	ListLibrariesParamsOrderAsc  ListLibrariesParamsOrder = "asc"
+	ListLibrariesParamsOrderDesc ListLibrariesParamsOrder = "desc"
+)
+
+// Defines values for ListBooksParamsCondition.
+const (
+	BAD       ListBooksParamsCondition = "BAD"
+	EXCELLENT ListBooksParamsCondition = "EXCELLENT"
+	GOOD      ListBooksParamsCondition = "GOOD"
+)
+
+// Defines values for ListBooksParamsSort.
+const (
+	ListBooksParamsSortAuthor         ListBooksParamsSort = "author"
+	ListBooksParamsSortAvailableCount ListBooksParamsSort = "availableCount"
+	ListBooksParamsSortGenre          ListBooksParamsSort = "genre"
+	ListBooksParamsSortName           ListBooksParamsSort = "name"
+)
+
+// Defines values for ListBooksParamsOrder.
+const (
+	ListBooksParamsOrderAsc  ListBooksParamsOrder = "asc"
+	ListBooksParamsOrderDesc ListBooksParamsOrder = "desc"
+)
+
+// Defines values for GetInventoryReportParamsFormat.
+const (
+	Csv  GetInventoryReportParamsFormat = "csv"
+	Xlsx GetInventoryReportParamsFormat = "xlsx"
+)
+
+// Defines values for ListStockMovementsParamsReason.
+const (
+	ListStockMovementsParamsReasonADJUST   ListStockMovementsParamsReason = "ADJUST"
+	ListStockMovementsParamsReasonBOOKING  ListStockMovementsParamsReason = "BOOKING"
+	ListStockMovementsParamsReasonCANCEL   ListStockMovementsParamsReason = "CANCEL"
+	ListStockMovementsParamsReasonCHECKOUT ListStockMovementsParamsReason = "CHECKOUT"
+	ListStockMovementsParamsReasonHOLD     ListStockMovementsParamsReason = "HOLD"
+	ListStockMovementsParamsReasonRETURN   ListStockMovementsParamsReason = "RETURN"
+	ListStockMovementsParamsReasonTRANSFER ListStockMovementsParamsReason = "TRANSFER"
+)
+
+// Defines values for ListLibraryTransfersParamsDirection.
+const (
+	Incoming ListLibraryTransfersParamsDirection = "incoming"
+	Outgoing ListLibraryTransfersParamsDirection = "outgoing"
+)
+
+// Defines values for ListLibraryTransfersParamsStatus.
+const (
+	CANCELLED ListLibraryTransfersParamsStatus = "CANCELLED"
+	INTRANSIT ListLibraryTransfersParamsStatus = "IN_TRANSIT"
+	RECEIVED  ListLibraryTransfersParamsStatus = "RECEIVED"
+	REQUESTED ListLibraryTransfersParamsStatus = "REQUESTED"
+)
+
+// BookCopyResponse defines model for BookCopyResponse.
+type BookCopyResponse struct {
+	// Barcode Штрихкод экземпляра
+	Barcode string `json:"barcode"`
+
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// Condition Состояние экземпляра
+	Condition BookCopyResponseCondition `json:"condition"`
+
+	// CopyUid UUID экземпляра
+	CopyUid openapi_types.UUID `json:"copyUid"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+
+	// Status Статус экземпляра
+	Status BookCopyResponseStatus `json:"status"`
+}
+
+// BookCopyResponseCondition Состояние экземпляра
+type BookCopyResponseCondition string
+
+// BookCopyResponseStatus Статус экземпляра
+type BookCopyResponseStatus string
+
 // BookInfo defines model for BookInfo.
 type BookInfo struct {
 	// Author Автор
@@ -37,11 +223,129 @@ type BookInfo struct {
 	// BookUid UUID книги
 	BookUid openapi_types.UUID `json:"bookUid"`
 
+	// CoverThumbnailUrl Ссылка на миниатюру обложки книги
+	CoverThumbnailUrl *string `json:"coverThumbnailUrl,omitempty"`
+
+	// CoverUrl Ссылка на обложку книги
+	CoverUrl *string `json:"coverUrl,omitempty"`
+
+	// Description Аннотация
+	Description *string `json:"description,omitempty"`
+
 	// Genre Жанр
 	Genre string `json:"genre"`
 
+	// Isbn10 ISBN-10
+	Isbn10 *string `json:"isbn10,omitempty"`
+
+	// Isbn13 ISBN-13
+	Isbn13 *string `json:"isbn13,omitempty"`
+
+	// Language Язык издания
+	Language *string `json:"language,omitempty"`
+
 	// Name Название книги
 	Name string `json:"name"`
+
+	// PageCount Количество страниц
+	PageCount *int `json:"pageCount,omitempty"`
+
+	// PublicationYear Год издания
+	PublicationYear *int `json:"publicationYear,omitempty"`
+
+	// Publisher Издательство
+	Publisher *string `json:"publisher,omitempty"`
+
+	// SeriesUid UUID серии
+	SeriesUid *openapi_types.UUID `json:"seriesUid,omitempty"`
+
+	// VolumeNumber Номер тома в серии
+	VolumeNumber *int `json:"volumeNumber,omitempty"`
+
+	// WorkUid UUID произведения
+	WorkUid *openapi_types.UUID `json:"workUid,omitempty"`
+}
+
+// BookingRequest defines model for BookingRequest.
+type BookingRequest struct {
+	// ExpiresAt До какого времени экземпляр ждет пользователя
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// PickupDate Дата получения книги
+	PickupDate string `json:"pickupDate"`
+}
+
+// BookingResponse defines model for BookingResponse.
+type BookingResponse struct {
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+	Copy    *BookCopyResponse  `json:"copy,omitempty"`
+
+	// ExpiresAt До какого времени экземпляр ждет пользователя
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+
+	// PickupDate Дата получения книги
+	PickupDate string `json:"pickupDate"`
+
+	// ReservationUid UUID бронирования
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+
+	// Status Статус брони
+	Status BookingResponseStatus `json:"status"`
+}
+
+// BookingResponseStatus Статус брони
+type BookingResponseStatus string
+
+// CityResponse defines model for CityResponse.
+type CityResponse struct {
+	// City Город
+	City string `json:"city"`
+
+	// LibrariesCount Количество библиотек в городе
+	LibrariesCount int `json:"librariesCount"`
+}
+
+// ConditionChangeResponse defines model for ConditionChangeResponse.
+type ConditionChangeResponse struct {
+	// ChangedAt Время изменения
+	ChangedAt time.Time `json:"changedAt"`
+
+	// ChangedBy Пользователь, зафиксировавший изменение
+	ChangedBy string `json:"changedBy"`
+
+	// NewCondition Состояние после изменения
+	NewCondition ConditionChangeResponseNewCondition `json:"newCondition"`
+
+	// OldCondition Состояние до изменения
+	OldCondition ConditionChangeResponseOldCondition `json:"oldCondition"`
+
+	// ReservationUid UUID бронирования, при возврате по которому изменилось состояние
+	ReservationUid *openapi_types.UUID `json:"reservationUid,omitempty"`
+}
+
+// ConditionChangeResponseNewCondition Состояние после изменения
+type ConditionChangeResponseNewCondition string
+
+// ConditionChangeResponseOldCondition Состояние до изменения
+type ConditionChangeResponseOldCondition string
+
+// DueDateResponse defines model for DueDateResponse.
+type DueDateResponse struct {
+	// DueDate Ближайший рабочий день библиотеки
+	DueDate string `json:"dueDate"`
+
+	// NextOpening Время ближайшего открытия библиотеки
+	NextOpening *time.Time `json:"nextOpening,omitempty"`
+
+	// OpenNow Открыта ли библиотека сейчас
+	OpenNow bool `json:"openNow"`
+
+	// RequestedDate Запрошенная дата возврата
+	RequestedDate string `json:"requestedDate"`
 }
 
 // ErrorDescription defines model for ErrorDescription.
@@ -50,10 +354,67 @@ type ErrorDescription struct {
 	Field string `json:"field"`
 }
 
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	// Message Информация об ошибке
+	Message string `json:"message"`
+}
+
+// HoldQueueResponse defines model for HoldQueueResponse.
+type HoldQueueResponse struct {
+	// Waiting Количество ожидающих заявок
+	Waiting int `json:"waiting"`
+}
+
+// HoldRequest defines model for HoldRequest.
+type HoldRequest struct {
+	// Rating Рейтинг пользователя, повышает место в очереди
+	Rating *int `json:"rating,omitempty"`
+}
+
+// HoldResponse defines model for HoldResponse.
+type HoldResponse struct {
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// ExpiresAt До какого времени отложенный экземпляр ждет пользователя
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// HoldUid UUID заявки
+	HoldUid openapi_types.UUID `json:"holdUid"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+
+	// Position Место в очереди, 0 если заявка не ожидает
+	Position int `json:"position"`
+
+	// RequestedAt Время постановки в очередь
+	RequestedAt time.Time `json:"requestedAt"`
+
+	// Status Статус заявки
+	Status HoldResponseStatus `json:"status"`
+}
+
+// HoldResponseStatus Статус заявки
+type HoldResponseStatus string
+
+// HolidayResponse defines model for HolidayResponse.
+type HolidayResponse struct {
+	// Date Дата выходного дня
+	Date string `json:"date"`
+
+	// Reason Причина закрытия
+	Reason *string `json:"reason,omitempty"`
+}
+
 // LibraryBookPaginationResponse defines model for LibraryBookPaginationResponse.
 type LibraryBookPaginationResponse struct {
 	Items []LibraryBookResponse `json:"items"`
 
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string `json:"nextCursor,omitempty"`
+
 	// Page Номер страницы
 	Page *int `json:"page,omitempty"`
 
@@ -78,11 +439,38 @@ type LibraryBookResponse struct {
 	// Condition Состояние книги
 	Condition LibraryBookResponseCondition `json:"condition"`
 
+	// CoverThumbnailUrl Ссылка на миниатюру обложки книги
+	CoverThumbnailUrl *string `json:"coverThumbnailUrl,omitempty"`
+
+	// CoverUrl Ссылка на обложку книги
+	CoverUrl *string `json:"coverUrl,omitempty"`
+
+	// Description Аннотация
+	Description *string `json:"description,omitempty"`
+
 	// Genre Жанр
 	Genre string `json:"genre"`
 
+	// Isbn10 ISBN-10
+	Isbn10 *string `json:"isbn10,omitempty"`
+
+	// Isbn13 ISBN-13
+	Isbn13 *string `json:"isbn13,omitempty"`
+
+	// Language Язык издания
+	Language *string `json:"language,omitempty"`
+
 	// Name Название книги
 	Name string `json:"name"`
+
+	// PageCount Количество страниц
+	PageCount *int `json:"pageCount,omitempty"`
+
+	// PublicationYear Год издания
+	PublicationYear *int `json:"publicationYear,omitempty"`
+
+	// Publisher Издательство
+	Publisher *string `json:"publisher,omitempty"`
 }
 
 // LibraryBookResponseCondition Состояние книги
@@ -92,6 +480,9 @@ type LibraryBookResponseCondition string
 type LibraryPaginationResponse struct {
 	Items []LibraryResponse `json:"items"`
 
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string `json:"nextCursor,omitempty"`
+
 	// Page Номер страницы
 	Page *int `json:"page,omitempty"`
 
@@ -110,612 +501,5380 @@ type LibraryResponse struct {
 	// City Город, в котором находится библиотека
 	City string `json:"city"`
 
+	// Distance Расстояние до точки поиска в километрах
+	Distance *float64 `json:"distance,omitempty"`
+
+	// Latitude Широта
+	Latitude *float64 `json:"latitude,omitempty"`
+
 	// LibraryUid UUID библиотеки
 	LibraryUid openapi_types.UUID `json:"libraryUid"`
 
+	// Longitude Долгота
+	Longitude *float64 `json:"longitude,omitempty"`
+
 	// Name Название библиотеки
 	Name string `json:"name"`
 }
 
+// LibraryScheduleResponse defines model for LibraryScheduleResponse.
+type LibraryScheduleResponse struct {
+	// Holidays Ближайшие выходные дни
+	Holidays []HolidayResponse `json:"holidays"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid   openapi_types.UUID `json:"libraryUid"`
+	OpeningHours []OpeningHours     `json:"openingHours"`
+
+	// Timezone Часовой пояс библиотеки
+	Timezone string `json:"timezone"`
+}
+
+// LibraryStatisticsResponse defines model for LibraryStatisticsResponse.
+type LibraryStatisticsResponse struct {
+	// AverageLoanDays Средняя длительность выдачи в днях по возвратам за период
+	AverageLoanDays *float64 `json:"averageLoanDays,omitempty"`
+
+	// BookUid UUID книги
+	BookUid *openapi_types.UUID `json:"bookUid,omitempty"`
+
+	// Checkouts Количество выдач за период
+	Checkouts int `json:"checkouts"`
+
+	// CopiesOut Количество выданных сейчас экземпляров
+	CopiesOut int `json:"copiesOut"`
+
+	// From Начало периода
+	From string `json:"from"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+
+	// Returns Количество возвратов за период
+	Returns int `json:"returns"`
+
+	// To Конец периода
+	To string `json:"to"`
+
+	// TotalCopies Количество экземпляров в фонде
+	TotalCopies int `json:"totalCopies"`
+
+	// Utilization Доля выданных сейчас экземпляров
+	Utilization float64 `json:"utilization"`
+}
+
+// OpeningHours defines model for OpeningHours.
+type OpeningHours struct {
+	// ClosesAt Время закрытия в формате HH:MM
+	ClosesAt string `json:"closesAt"`
+
+	// OpensAt Время открытия в формате HH:MM
+	OpensAt string `json:"opensAt"`
+
+	// Weekday День недели (1 - понедельник, 7 - воскресенье)
+	Weekday int `json:"weekday"`
+}
+
+// OpeningHoursRequest defines model for OpeningHoursRequest.
+type OpeningHoursRequest struct {
+	// OpeningHours Часы работы по дням недели, пустой массив означает круглосуточную работу
+	OpeningHours []OpeningHours `json:"openingHours"`
+
+	// Timezone Часовой пояс библиотеки в формате IANA
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// PopularBookResponse defines model for PopularBookResponse.
+type PopularBookResponse struct {
+	Book BookInfo `json:"book"`
+
+	// Checkouts Количество выдач за период
+	Checkouts int `json:"checkouts"`
+
+	// Returns Количество возвратов за период
+	Returns int `json:"returns"`
+}
+
 // ReturnBookRequest defines model for ReturnBookRequest.
 type ReturnBookRequest struct {
 	// Condition Состояние книги
 	Condition ReturnBookRequestCondition `json:"condition"`
+
+	// CopyUid UUID возвращаемого экземпляра
+	CopyUid *openapi_types.UUID `json:"copyUid,omitempty"`
 }
 
 // ReturnBookRequestCondition Состояние книги
 type ReturnBookRequestCondition string
 
-// ValidationErrorResponse defines model for ValidationErrorResponse.
-type ValidationErrorResponse struct {
-	// Errors Массив полей с описанием ошибки
-	Errors []ErrorDescription `json:"errors"`
+// SeriesRequest defines model for SeriesRequest.
+type SeriesRequest struct {
+	// Author Автор
+	Author *string `json:"author,omitempty"`
 
-	// Message Информация об ошибке
-	Message string `json:"message"`
+	// Name Название серии
+	Name string `json:"name"`
 }
 
-// ViolationStatus defines model for ViolationStatus.
-type ViolationStatus struct {
-	// Violation Нарушены ли правила возврата книг
-	Violation bool `json:"violation"`
-}
+// SeriesResponse defines model for SeriesResponse.
+type SeriesResponse struct {
+	// Author Автор
+	Author *string `json:"author,omitempty"`
 
-// ListLibrariesParams defines parameters for ListLibraries.
-type ListLibrariesParams struct {
-	Page *int `form:"page,omitempty" json:"page,omitempty"`
-	Size *int `form:"size,omitempty" json:"size,omitempty"`
+	// Name Название серии
+	Name string `json:"name"`
 
-	// City Город
-	City string `form:"city" json:"city"`
+	// SeriesUid UUID серии
+	SeriesUid openapi_types.UUID `json:"seriesUid"`
+
+	// Volumes Тома серии по порядку
+	Volumes []SeriesVolumeResponse `json:"volumes"`
 }
 
-// ListBooksParams defines parameters for ListBooks.
-type ListBooksParams struct {
-	Page    *int  `form:"page,omitempty" json:"page,omitempty"`
-	Size    *int  `form:"size,omitempty" json:"size,omitempty"`
-	ShowAll *bool `form:"showAll,omitempty" json:"showAll,omitempty"`
+// SeriesVolumeRequest defines model for SeriesVolumeRequest.
+type SeriesVolumeRequest struct {
+	// VolumeNumber Номер тома
+	VolumeNumber int `json:"volumeNumber"`
 }
 
-// ReturnBookJSONRequestBody defines body for ReturnBook for application/json ContentType.
-type ReturnBookJSONRequestBody = ReturnBookRequest
+// SeriesVolumeResponse defines model for SeriesVolumeResponse.
+type SeriesVolumeResponse struct {
+	// AvailableCount Количество доступных экземпляров тома
+	AvailableCount int      `json:"availableCount"`
+	Book           BookInfo `json:"book"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Получить информацию о книге
-	// (GET /api/v1/books/{bookUid})
-	GetBook(ctx echo.Context, bookUid openapi_types.UUID) error
-	// Получить список библиотек в городе
-	// (GET /api/v1/libraries)
-	ListLibraries(ctx echo.Context, params ListLibrariesParams) error
-	// Получить информацию о библиотеке
-	// (GET /api/v1/libraries/{libraryUid})
-	GetLibrary(ctx echo.Context, libraryUid openapi_types.UUID) error
-	// Получить список книг в выбранной библиотеке
-	// (GET /api/v1/libraries/{libraryUid}/books)
-	ListBooks(ctx echo.Context, libraryUid openapi_types.UUID, params ListBooksParams) error
-	// Взять книгу в библиотеке
-	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid})
-	TakeBook(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error
-	// Вернуть книгу в библиотеку
-	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/return)
-	ReturnBook(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error
-	// Проверка живости сервиса
-	// (GET /manage/health)
-	Health(ctx echo.Context) error
+	// VolumeNumber Номер тома
+	VolumeNumber int `json:"volumeNumber"`
 }
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// StockAdjustmentRequest defines model for StockAdjustmentRequest.
+type StockAdjustmentRequest struct {
+	// Comment Комментарий к корректировке
+	Comment *string `json:"comment,omitempty"`
+
+	// Condition Состояние добавляемых экземпляров
+	Condition *StockAdjustmentRequestCondition `json:"condition,omitempty"`
+
+	// Delta Изменение количества доступных экземпляров
+	Delta int `json:"delta"`
 }
 
-// GetBook converts echo context to params.
-func (w *ServerInterfaceWrapper) GetBook(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "bookUid" -------------
-	var bookUid openapi_types.UUID
+// StockAdjustmentRequestCondition Состояние добавляемых экземпляров
+type StockAdjustmentRequestCondition string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
-	}
+// StockConsistencyResponse defines model for StockConsistencyResponse.
+type StockConsistencyResponse struct {
+	// CheckedBooks Количество проверенных книг
+	CheckedBooks int `json:"checkedBooks"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetBook(ctx, bookUid)
-	return err
-}
+	// Consistent Совпадает ли журнал с текущим количеством экземпляров
+	Consistent    bool               `json:"consistent"`
+	Discrepancies []StockDiscrepancy `json:"discrepancies"`
 
-// ListLibraries converts echo context to params.
-func (w *ServerInterfaceWrapper) ListLibraries(ctx echo.Context) error {
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+}
+
+// StockDiscrepancy defines model for StockDiscrepancy.
+type StockDiscrepancy struct {
+	// ActualCount Текущее количество доступных экземпляров
+	ActualCount int `json:"actualCount"`
+
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// BrokenMovements Количество записей, у которых итоговое количество не совпадает с накопленной суммой изменений
+	BrokenMovements int `json:"brokenMovements"`
+
+	// LedgerCount Количество доступных экземпляров по сумме изменений в журнале
+	LedgerCount int `json:"ledgerCount"`
+
+	// RecordedCount Количество доступных экземпляров по последней записи журнала
+	RecordedCount int `json:"recordedCount"`
+}
+
+// StockMovementPaginationResponse defines model for StockMovementPaginationResponse.
+type StockMovementPaginationResponse struct {
+	Items []StockMovementResponse `json:"items"`
+
+	// Page Номер страницы
+	Page *int `json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// TotalElements Общее количество элементов
+	TotalElements int `json:"totalElements"`
+}
+
+// StockMovementResponse defines model for StockMovementResponse.
+type StockMovementResponse struct {
+	// Actor Пользователь, выполнивший операцию
+	Actor string `json:"actor"`
+
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// Comment Комментарий
+	Comment *string `json:"comment,omitempty"`
+
+	// CopyUid UUID экземпляра
+	CopyUid *openapi_types.UUID `json:"copyUid,omitempty"`
+
+	// CorrelationUid UUID бронирования или перемещения
+	CorrelationUid *openapi_types.UUID `json:"correlationUid,omitempty"`
+
+	// CreatedAt Время операции
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Delta Изменение количества доступных экземпляров
+	Delta int `json:"delta"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+
+	// Reason Причина движения
+	Reason StockMovementResponseReason `json:"reason"`
+
+	// ResultingCount Количество доступных экземпляров после операции
+	ResultingCount int `json:"resultingCount"`
+}
+
+// StockMovementResponseReason Причина движения
+type StockMovementResponseReason string
+
+// SuggestionResponse defines model for SuggestionResponse.
+type SuggestionResponse struct {
+	// BookUid UUID книги, заполняется для подсказок-книг
+	BookUid *openapi_types.UUID `json:"bookUid,omitempty"`
+
+	// Kind Что подсказывается
+	Kind SuggestionResponseKind `json:"kind"`
+
+	// Text Текст подсказки
+	Text string `json:"text"`
+}
+
+// SuggestionResponseKind Что подсказывается
+type SuggestionResponseKind string
+
+// TransferPaginationResponse defines model for TransferPaginationResponse.
+type TransferPaginationResponse struct {
+	Items []TransferResponse `json:"items"`
+
+	// Page Номер страницы
+	Page *int `json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// TotalElements Общее количество элементов
+	TotalElements int `json:"totalElements"`
+}
+
+// TransferRequest defines model for TransferRequest.
+type TransferRequest struct {
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// DestinationLibraryUid UUID библиотеки-получателя
+	DestinationLibraryUid openapi_types.UUID `json:"destinationLibraryUid"`
+
+	// Quantity Количество экземпляров
+	Quantity int `json:"quantity"`
+
+	// SourceLibraryUid UUID библиотеки-источника
+	SourceLibraryUid openapi_types.UUID `json:"sourceLibraryUid"`
+}
+
+// TransferResponse defines model for TransferResponse.
+type TransferResponse struct {
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// CancelledAt Время отмены
+	CancelledAt *time.Time `json:"cancelledAt,omitempty"`
+
+	// DestinationLibraryUid UUID библиотеки-получателя
+	DestinationLibraryUid openapi_types.UUID `json:"destinationLibraryUid"`
+
+	// DispatchedAt Время отправки
+	DispatchedAt *time.Time `json:"dispatchedAt,omitempty"`
+
+	// Quantity Количество экземпляров
+	Quantity int `json:"quantity"`
+
+	// ReceivedAt Время получения
+	ReceivedAt *time.Time `json:"receivedAt,omitempty"`
+
+	// RequestedAt Время создания заявки
+	RequestedAt time.Time `json:"requestedAt"`
+
+	// RequestedBy Пользователь, создавший заявку
+	RequestedBy string `json:"requestedBy"`
+
+	// SourceLibraryUid UUID библиотеки-источника
+	SourceLibraryUid openapi_types.UUID `json:"sourceLibraryUid"`
+
+	// Status Статус перемещения
+	Status TransferResponseStatus `json:"status"`
+
+	// TransferUid UUID перемещения
+	TransferUid openapi_types.UUID `json:"transferUid"`
+}
+
+// TransferResponseStatus Статус перемещения
+type TransferResponseStatus string
+
+// ValidationErrorResponse defines model for ValidationErrorResponse.
+type ValidationErrorResponse struct {
+	// Errors Массив полей с описанием ошибки
+	Errors []ErrorDescription `json:"errors"`
+
+	// Message Информация об ошибке
+	Message string `json:"message"`
+}
+
+// ViolationStatus defines model for ViolationStatus.
+type ViolationStatus struct {
+	// Violation Нарушены ли правила возврата книг
+	Violation bool `json:"violation"`
+}
+
+// WorkResponse defines model for WorkResponse.
+type WorkResponse struct {
+	// Author Автор
+	Author *string `json:"author,omitempty"`
+
+	// Editions Издания, от новых к старым
+	Editions []BookInfo `json:"editions"`
+
+	// Title Название произведения
+	Title string `json:"title"`
+
+	// WorkUid UUID произведения
+	WorkUid openapi_types.UUID `json:"workUid"`
+}
+
+// WorkSearchPaginationResponse defines model for WorkSearchPaginationResponse.
+type WorkSearchPaginationResponse struct {
+	Items []WorkSearchResult `json:"items"`
+
+	// Page Номер страницы
+	Page *int `json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// TotalElements Общее количество элементов
+	TotalElements int `json:"totalElements"`
+}
+
+// WorkSearchResult defines model for WorkSearchResult.
+type WorkSearchResult struct {
+	// Author Автор
+	Author *string  `json:"author,omitempty"`
+	Book   BookInfo `json:"book"`
+
+	// EditionsCount Количество изданий произведения
+	EditionsCount int `json:"editionsCount"`
+
+	// OtherEditions Остальные издания, заполняется при expandEditions=true
+	OtherEditions *[]BookInfo `json:"otherEditions,omitempty"`
+
+	// Title Название произведения
+	Title string `json:"title"`
+
+	// WorkUid UUID произведения
+	WorkUid openapi_types.UUID `json:"workUid"`
+}
+
+// WriteOffRequest defines model for WriteOffRequest.
+type WriteOffRequest struct {
+	// Comment Комментарий
+	Comment *string `json:"comment,omitempty"`
+
+	// Reason Причина списания
+	Reason WriteOffRequestReason `json:"reason"`
+}
+
+// WriteOffRequestReason Причина списания
+type WriteOffRequestReason string
+
+// SearchBooksParams defines parameters for SearchBooks.
+type SearchBooksParams struct {
+	// Query Строка поиска по названию и автору
+	Query *string `form:"query,omitempty" json:"query,omitempty"`
+
+	// ExpandEditions Вернуть остальные издания произведения
+	ExpandEditions *bool `form:"expandEditions,omitempty" json:"expandEditions,omitempty"`
+	Page           *int  `form:"page,omitempty" json:"page,omitempty"`
+	Size           *int  `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// GetBookCoverParams defines parameters for GetBookCover.
+type GetBookCoverParams struct {
+	// Size Размер изображения
+	Size *GetBookCoverParamsSize `form:"size,omitempty" json:"size,omitempty"`
+
+	// IfNoneMatch ETag закешированной обложки
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// GetBookCoverParamsSize defines parameters for GetBookCover.
+type GetBookCoverParamsSize string
+
+// ListLibrariesParams defines parameters for ListLibraries.
+type ListLibrariesParams struct {
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+
+	// City Город
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+
+	// NamePrefix Начало названия библиотеки
+	NamePrefix *string `form:"namePrefix,omitempty" json:"namePrefix,omitempty"`
+
+	// Sort Поле сортировки
+	Sort *ListLibrariesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки
+	Order *ListLibrariesParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор, полученный в nextCursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListLibrariesParamsSort defines parameters for ListLibraries.
+type ListLibrariesParamsSort string
+
+// ListLibrariesParamsOrder defines parameters for ListLibraries.
+type ListLibrariesParamsOrder string
+
+// ListNearbyLibrariesParams defines parameters for ListNearbyLibraries.
+type ListNearbyLibrariesParams struct {
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+
+	// Latitude Широта точки
+	Latitude float64 `form:"latitude" json:"latitude"`
+
+	// Longitude Долгота точки
+	Longitude float64 `form:"longitude" json:"longitude"`
+
+	// Radius Радиус поиска в километрах, по умолчанию 10
+	Radius *float64 `form:"radius,omitempty" json:"radius,omitempty"`
+}
+
+// ListBooksParams defines parameters for ListBooks.
+type ListBooksParams struct {
+	Page    *int  `form:"page,omitempty" json:"page,omitempty"`
+	Size    *int  `form:"size,omitempty" json:"size,omitempty"`
+	ShowAll *bool `form:"showAll,omitempty" json:"showAll,omitempty"`
+
+	// Genre Жанр
+	Genre *string `form:"genre,omitempty" json:"genre,omitempty"`
+
+	// Author Автор или его часть
+	Author *string `form:"author,omitempty" json:"author,omitempty"`
+
+	// Condition Состояние экземпляров
+	Condition *ListBooksParamsCondition `form:"condition,omitempty" json:"condition,omitempty"`
+
+	// NamePrefix Начало названия книги
+	NamePrefix *string `form:"namePrefix,omitempty" json:"namePrefix,omitempty"`
+
+	// Sort Поле сортировки
+	Sort *ListBooksParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки
+	Order *ListBooksParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор, полученный в nextCursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListBooksParamsCondition defines parameters for ListBooks.
+type ListBooksParamsCondition string
+
+// ListBooksParamsSort defines parameters for ListBooks.
+type ListBooksParamsSort string
+
+// ListBooksParamsOrder defines parameters for ListBooks.
+type ListBooksParamsOrder string
+
+// TakeBookParams defines parameters for TakeBook.
+type TakeBookParams struct {
+	// ReservationUid UUID бронирования, к которому привязывается экземпляр
+	ReservationUid *openapi_types.UUID `form:"reservationUid,omitempty" json:"reservationUid,omitempty"`
+}
+
+// BookCopyForPickupParams defines parameters for BookCopyForPickup.
+type BookCopyForPickupParams struct {
+	// ReservationUid UUID бронирования, к которому привязывается экземпляр
+	ReservationUid openapi_types.UUID `form:"reservationUid" json:"reservationUid"`
+}
+
+// ReleaseBookParams defines parameters for ReleaseBook.
+type ReleaseBookParams struct {
+	// ReservationUid UUID отмененного бронирования
+	ReservationUid openapi_types.UUID `form:"reservationUid" json:"reservationUid"`
+
+	// Rebook Если экземпляр был выдан по брони, но выдача не завершилась в сервисе бронирований, он снова откладывается по брони, а не возвращается в фонд
+	Rebook *bool `form:"rebook,omitempty" json:"rebook,omitempty"`
+}
+
+// ReturnBookParams defines parameters for ReturnBook.
+type ReturnBookParams struct {
+	// ReservationUid UUID бронирования, к которому привязан экземпляр
+	ReservationUid *openapi_types.UUID `form:"reservationUid,omitempty" json:"reservationUid,omitempty"`
+}
+
+// WriteOffBookParams defines parameters for WriteOffBook.
+type WriteOffBookParams struct {
+	// ReservationUid UUID бронирования, к которому привязан экземпляр
+	ReservationUid openapi_types.UUID `form:"reservationUid" json:"reservationUid"`
+}
+
+// GetInventoryReportParams defines parameters for GetInventoryReport.
+type GetInventoryReportParams struct {
+	// Format Формат отчета
+	Format *GetInventoryReportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetInventoryReportParamsFormat defines parameters for GetInventoryReport.
+type GetInventoryReportParamsFormat string
+
+// CheckDueDateParams defines parameters for CheckDueDate.
+type CheckDueDateParams struct {
+	// Date Желаемая дата возврата
+	Date string `form:"date" json:"date"`
+}
+
+// GetLibraryStatisticsParams defines parameters for GetLibraryStatistics.
+type GetLibraryStatisticsParams struct {
+	// BookUid UUID книги
+	BookUid *openapi_types.UUID `form:"bookUid,omitempty" json:"bookUid,omitempty"`
+
+	// From Начало периода в формате YYYY-MM-DD, по умолчанию 30 дней назад
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода в формате YYYY-MM-DD, по умолчанию сегодня
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ListStockMovementsParams defines parameters for ListStockMovements.
+type ListStockMovementsParams struct {
+	// BookUid UUID книги
+	BookUid *openapi_types.UUID `form:"bookUid,omitempty" json:"bookUid,omitempty"`
+
+	// Reason Причина движения
+	Reason *ListStockMovementsParamsReason `form:"reason,omitempty" json:"reason,omitempty"`
+
+	// CorrelationUid UUID бронирования или перемещения
+	CorrelationUid *openapi_types.UUID `form:"correlationUid,omitempty" json:"correlationUid,omitempty"`
+	Page           *int                `form:"page,omitempty" json:"page,omitempty"`
+	Size           *int                `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListStockMovementsParamsReason defines parameters for ListStockMovements.
+type ListStockMovementsParamsReason string
+
+// ListLibraryTransfersParams defines parameters for ListLibraryTransfers.
+type ListLibraryTransfersParams struct {
+	// Direction Входящие или исходящие перемещения, по умолчанию все
+	Direction *ListLibraryTransfersParamsDirection `form:"direction,omitempty" json:"direction,omitempty"`
+
+	// Status Статус перемещения
+	Status *ListLibraryTransfersParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Page   *int                              `form:"page,omitempty" json:"page,omitempty"`
+	Size   *int                              `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// ListLibraryTransfersParamsDirection defines parameters for ListLibraryTransfers.
+type ListLibraryTransfersParamsDirection string
+
+// ListLibraryTransfersParamsStatus defines parameters for ListLibraryTransfers.
+type ListLibraryTransfersParamsStatus string
+
+// GetSeriesParams defines parameters for GetSeries.
+type GetSeriesParams struct {
+	// LibraryUid UUID библиотеки, в которой считать доступные экземпляры
+	LibraryUid *openapi_types.UUID `form:"libraryUid,omitempty" json:"libraryUid,omitempty"`
+}
+
+// ListPopularBooksParams defines parameters for ListPopularBooks.
+type ListPopularBooksParams struct {
+	// City Город
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+
+	// Genre Жанр
+	Genre *string `form:"genre,omitempty" json:"genre,omitempty"`
+
+	// From Начало периода в формате YYYY-MM-DD, по умолчанию 30 дней назад
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода в формате YYYY-MM-DD, по умолчанию сегодня
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Количество книг
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListSuggestionsParams defines parameters for ListSuggestions.
+type ListSuggestionsParams struct {
+	// Query Введенная пользователем строка
+	Query string `form:"query" json:"query"`
+
+	// Limit Количество подсказок
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// BookCopyForPickupJSONRequestBody defines body for BookCopyForPickup for application/json ContentType.
+type BookCopyForPickupJSONRequestBody = BookingRequest
+
+// PlaceHoldJSONRequestBody defines body for PlaceHold for application/json ContentType.
+type PlaceHoldJSONRequestBody = HoldRequest
+
+// ReturnBookJSONRequestBody defines body for ReturnBook for application/json ContentType.
+type ReturnBookJSONRequestBody = ReturnBookRequest
+
+// AdjustStockJSONRequestBody defines body for AdjustStock for application/json ContentType.
+type AdjustStockJSONRequestBody = StockAdjustmentRequest
+
+// WriteOffBookJSONRequestBody defines body for WriteOffBook for application/json ContentType.
+type WriteOffBookJSONRequestBody = WriteOffRequest
+
+// AddHolidayJSONRequestBody defines body for AddHoliday for application/json ContentType.
+type AddHolidayJSONRequestBody = HolidayResponse
+
+// SetOpeningHoursJSONRequestBody defines body for SetOpeningHours for application/json ContentType.
+type SetOpeningHoursJSONRequestBody = OpeningHoursRequest
+
+// CreateSeriesJSONRequestBody defines body for CreateSeries for application/json ContentType.
+type CreateSeriesJSONRequestBody = SeriesRequest
+
+// SetSeriesVolumeJSONRequestBody defines body for SetSeriesVolume for application/json ContentType.
+type SetSeriesVolumeJSONRequestBody = SeriesVolumeRequest
+
+// CreateTransferJSONRequestBody defines body for CreateTransfer for application/json ContentType.
+type CreateTransferJSONRequestBody = TransferRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Поиск книг с группировкой изданий по произведениям
+	// (GET /api/v1/books)
+	SearchBooks(ctx echo.Context, params SearchBooksParams) error
+	// Найти книгу по ISBN
+	// (GET /api/v1/books/isbn/{isbn})
+	GetBookByIsbn(ctx echo.Context, isbn string) error
+	// Получить информацию о книге
+	// (GET /api/v1/books/{bookUid})
+	GetBook(ctx echo.Context, bookUid openapi_types.UUID) error
+	// Удалить обложку книги
+	// (DELETE /api/v1/books/{bookUid}/cover)
+	DeleteBookCover(ctx echo.Context, bookUid openapi_types.UUID) error
+	// Получить обложку книги
+	// (GET /api/v1/books/{bookUid}/cover)
+	GetBookCover(ctx echo.Context, bookUid openapi_types.UUID, params GetBookCoverParams) error
+	// Загрузить обложку книги
+	// (PUT /api/v1/books/{bookUid}/cover)
+	SetBookCover(ctx echo.Context, bookUid openapi_types.UUID) error
+	// Получить список городов, в которых есть библиотеки
+	// (GET /api/v1/cities)
+	ListCities(ctx echo.Context) error
+	// Получить историю изменения состояния экземпляра
+	// (GET /api/v1/copies/{copyUid}/condition-history)
+	GetCopyConditionHistory(ctx echo.Context, copyUid openapi_types.UUID) error
+	// Получить активные заявки пользователя в очередях на книги
+	// (GET /api/v1/holds)
+	ListHolds(ctx echo.Context) error
+	// Отменить заявку
+	// (DELETE /api/v1/holds/{holdUid})
+	CancelHold(ctx echo.Context, holdUid openapi_types.UUID) error
+	// Получить заявку и место в очереди
+	// (GET /api/v1/holds/{holdUid})
+	GetHold(ctx echo.Context, holdUid openapi_types.UUID) error
+	// Получить список библиотек
+	// (GET /api/v1/libraries)
+	ListLibraries(ctx echo.Context, params ListLibrariesParams) error
+	// Найти библиотеки рядом с точкой
+	// (GET /api/v1/libraries/nearby)
+	ListNearbyLibraries(ctx echo.Context, params ListNearbyLibrariesParams) error
+	// Получить информацию о библиотеке
+	// (GET /api/v1/libraries/{libraryUid})
+	GetLibrary(ctx echo.Context, libraryUid openapi_types.UUID) error
+	// Получить список книг в выбранной библиотеке
+	// (GET /api/v1/libraries/{libraryUid}/books)
+	ListBooks(ctx echo.Context, libraryUid openapi_types.UUID, params ListBooksParams) error
+	// Взять книгу в библиотеке
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid})
+	TakeBook(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params TakeBookParams) error
+	// Забронировать экземпляр книги к дате получения
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/bookings)
+	BookCopyForPickup(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params BookCopyForPickupParams) error
+	// Получить список экземпляров книги в библиотеке
+	// (GET /api/v1/libraries/{libraryUid}/books/{bookUid}/copies)
+	ListBookCopies(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error
+	// Получить длину очереди на книгу
+	// (GET /api/v1/libraries/{libraryUid}/books/{bookUid}/holds)
+	GetHoldQueue(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error
+	// Встать в очередь на книгу, которой нет в наличии
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/holds)
+	PlaceHold(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error
+	// Вернуть в фонд экземпляр по отмененному бронированию
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/release)
+	ReleaseBook(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params ReleaseBookParams) error
+	// Вернуть книгу в библиотеку
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/return)
+	ReturnBook(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params ReturnBookParams) error
+	// Скорректировать количество экземпляров книги
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/stock-adjustments)
+	AdjustStock(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error
+	// Списать выданный экземпляр как утерянный или испорченный
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/write-off)
+	WriteOffBook(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params WriteOffBookParams) error
+	// Выгрузить инвентаризационный отчет библиотеки
+	// (GET /api/v1/libraries/{libraryUid}/reports/inventory)
+	GetInventoryReport(ctx echo.Context, libraryUid openapi_types.UUID, params GetInventoryReportParams) error
+	// Получить режим работы библиотеки
+	// (GET /api/v1/libraries/{libraryUid}/schedule)
+	GetLibrarySchedule(ctx echo.Context, libraryUid openapi_types.UUID) error
+	// Проверить режим работы для выдачи книги
+	// (GET /api/v1/libraries/{libraryUid}/schedule/due-date)
+	CheckDueDate(ctx echo.Context, libraryUid openapi_types.UUID, params CheckDueDateParams) error
+	// Добавить выходной день
+	// (POST /api/v1/libraries/{libraryUid}/schedule/holidays)
+	AddHoliday(ctx echo.Context, libraryUid openapi_types.UUID) error
+	// Удалить выходной день
+	// (DELETE /api/v1/libraries/{libraryUid}/schedule/holidays/{date})
+	DeleteHoliday(ctx echo.Context, libraryUid openapi_types.UUID, date string) error
+	// Задать часы работы библиотеки
+	// (PUT /api/v1/libraries/{libraryUid}/schedule/opening-hours)
+	SetOpeningHours(ctx echo.Context, libraryUid openapi_types.UUID) error
+	// Получить статистику выдачи книг в библиотеке
+	// (GET /api/v1/libraries/{libraryUid}/statistics)
+	GetLibraryStatistics(ctx echo.Context, libraryUid openapi_types.UUID, params GetLibraryStatisticsParams) error
+	// Получить журнал движения экземпляров библиотеки
+	// (GET /api/v1/libraries/{libraryUid}/stock-movements)
+	ListStockMovements(ctx echo.Context, libraryUid openapi_types.UUID, params ListStockMovementsParams) error
+	// Сверить журнал движения с текущим количеством экземпляров
+	// (GET /api/v1/libraries/{libraryUid}/stock-movements/consistency)
+	CheckStockConsistency(ctx echo.Context, libraryUid openapi_types.UUID) error
+	// Получить список перемещений библиотеки
+	// (GET /api/v1/libraries/{libraryUid}/transfers)
+	ListLibraryTransfers(ctx echo.Context, libraryUid openapi_types.UUID, params ListLibraryTransfersParams) error
+	// Создать серию
+	// (POST /api/v1/series)
+	CreateSeries(ctx echo.Context) error
+	// Получить тома серии и их доступность
+	// (GET /api/v1/series/{seriesUid})
+	GetSeries(ctx echo.Context, seriesUid openapi_types.UUID, params GetSeriesParams) error
+	// Удалить том из серии
+	// (DELETE /api/v1/series/{seriesUid}/volumes/{bookUid})
+	RemoveSeriesVolume(ctx echo.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID) error
+	// Добавить книгу в серию как том
+	// (PUT /api/v1/series/{seriesUid}/volumes/{bookUid})
+	SetSeriesVolume(ctx echo.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID) error
+	// Получить самые популярные книги
+	// (GET /api/v1/statistics/popular-books)
+	ListPopularBooks(ctx echo.Context, params ListPopularBooksParams) error
+	// Подсказки для поиска по названиям, авторам и жанрам
+	// (GET /api/v1/suggestions)
+	ListSuggestions(ctx echo.Context, params ListSuggestionsParams) error
+	// Создать заявку на перемещение экземпляров между библиотеками
+	// (POST /api/v1/transfers)
+	CreateTransfer(ctx echo.Context) error
+	// Получить информацию о перемещении
+	// (GET /api/v1/transfers/{transferUid})
+	GetTransfer(ctx echo.Context, transferUid openapi_types.UUID) error
+	// Отменить перемещение до отправки
+	// (POST /api/v1/transfers/{transferUid}/cancel)
+	CancelTransfer(ctx echo.Context, transferUid openapi_types.UUID) error
+	// Отправить экземпляры из библиотеки-источника
+	// (POST /api/v1/transfers/{transferUid}/dispatch)
+	DispatchTransfer(ctx echo.Context, transferUid openapi_types.UUID) error
+	// Принять экземпляры в библиотеке-получателе
+	// (POST /api/v1/transfers/{transferUid}/receive)
+	ReceiveTransfer(ctx echo.Context, transferUid openapi_types.UUID) error
+	// Получить произведение и все его издания
+	// (GET /api/v1/works/{workUid})
+	GetWork(ctx echo.Context, workUid openapi_types.UUID) error
+	// Выделить издание в отдельное произведение
+	// (DELETE /api/v1/works/{workUid}/editions/{bookUid})
+	RemoveWorkEdition(ctx echo.Context, workUid openapi_types.UUID, bookUid openapi_types.UUID) error
+	// Добавить книгу в произведение как издание
+	// (PUT /api/v1/works/{workUid}/editions/{bookUid})
+	AddWorkEdition(ctx echo.Context, workUid openapi_types.UUID, bookUid openapi_types.UUID) error
+	// Проверка живости сервиса
+	// (GET /manage/health)
+	Health(ctx echo.Context) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// SearchBooks converts echo context to params.
+func (w *ServerInterfaceWrapper) SearchBooks(ctx echo.Context) error {
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListLibrariesParams
-	// ------------- Optional query parameter "page" -------------
+	var params SearchBooksParams
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// ------------- Optional query parameter "expandEditions" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expandEditions", ctx.QueryParams(), &params.ExpandEditions)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter expandEditions: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SearchBooks(ctx, params)
+	return err
+}
+
+// GetBookByIsbn converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBookByIsbn(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "isbn" -------------
+	var isbn string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "isbn", ctx.Param("isbn"), &isbn, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter isbn: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBookByIsbn(ctx, isbn)
+	return err
+}
+
+// GetBook converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBook(ctx, bookUid)
+	return err
+}
+
+// DeleteBookCover converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBookCover(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteBookCover(ctx, bookUid)
+	return err
+}
+
+// GetBookCover converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBookCover(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBookCoverParams
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBookCover(ctx, bookUid, params)
+	return err
+}
+
+// SetBookCover converts echo context to params.
+func (w *ServerInterfaceWrapper) SetBookCover(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetBookCover(ctx, bookUid)
+	return err
+}
+
+// ListCities converts echo context to params.
+func (w *ServerInterfaceWrapper) ListCities(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListCities(ctx)
+	return err
+}
+
+// GetCopyConditionHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCopyConditionHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "copyUid" -------------
+	var copyUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "copyUid", ctx.Param("copyUid"), &copyUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter copyUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCopyConditionHistory(ctx, copyUid)
+	return err
+}
+
+// ListHolds converts echo context to params.
+func (w *ServerInterfaceWrapper) ListHolds(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListHolds(ctx)
+	return err
+}
+
+// CancelHold converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelHold(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "holdUid" -------------
+	var holdUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "holdUid", ctx.Param("holdUid"), &holdUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter holdUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelHold(ctx, holdUid)
+	return err
+}
+
+// GetHold converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHold(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "holdUid" -------------
+	var holdUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "holdUid", ctx.Param("holdUid"), &holdUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter holdUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHold(ctx, holdUid)
+	return err
+}
+
+// ListLibraries converts echo context to params.
+func (w *ServerInterfaceWrapper) ListLibraries(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLibrariesParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", ctx.QueryParams(), &params.City)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter city: %s", err))
+	}
+
+	// ------------- Optional query parameter "namePrefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namePrefix", ctx.QueryParams(), &params.NamePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namePrefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListLibraries(ctx, params)
+	return err
+}
+
+// ListNearbyLibraries converts echo context to params.
+func (w *ServerInterfaceWrapper) ListNearbyLibraries(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNearbyLibrariesParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Required query parameter "latitude" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "latitude", ctx.QueryParams(), &params.Latitude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter latitude: %s", err))
+	}
+
+	// ------------- Required query parameter "longitude" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "longitude", ctx.QueryParams(), &params.Longitude)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter longitude: %s", err))
+	}
+
+	// ------------- Optional query parameter "radius" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "radius", ctx.QueryParams(), &params.Radius)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter radius: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListNearbyLibraries(ctx, params)
+	return err
+}
+
+// GetLibrary converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLibrary(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLibrary(ctx, libraryUid)
+	return err
+}
+
+// ListBooks converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBooks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListBooksParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "showAll" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "showAll", ctx.QueryParams(), &params.ShowAll)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showAll: %s", err))
+	}
+
+	// ------------- Optional query parameter "genre" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "genre", ctx.QueryParams(), &params.Genre)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter genre: %s", err))
+	}
+
+	// ------------- Optional query parameter "author" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author", ctx.QueryParams(), &params.Author)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter author: %s", err))
+	}
+
+	// ------------- Optional query parameter "condition" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "condition", ctx.QueryParams(), &params.Condition)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter condition: %s", err))
+	}
+
+	// ------------- Optional query parameter "namePrefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namePrefix", ctx.QueryParams(), &params.NamePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namePrefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBooks(ctx, libraryUid, params)
+	return err
+}
+
+// TakeBook converts echo context to params.
+func (w *ServerInterfaceWrapper) TakeBook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TakeBookParams
+	// ------------- Optional query parameter "reservationUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reservationUid", ctx.QueryParams(), &params.ReservationUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.TakeBook(ctx, libraryUid, bookUid, params)
+	return err
+}
+
+// BookCopyForPickup converts echo context to params.
+func (w *ServerInterfaceWrapper) BookCopyForPickup(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BookCopyForPickupParams
+	// ------------- Required query parameter "reservationUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "reservationUid", ctx.QueryParams(), &params.ReservationUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.BookCopyForPickup(ctx, libraryUid, bookUid, params)
+	return err
+}
+
+// ListBookCopies converts echo context to params.
+func (w *ServerInterfaceWrapper) ListBookCopies(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListBookCopies(ctx, libraryUid, bookUid)
+	return err
+}
+
+// GetHoldQueue converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHoldQueue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHoldQueue(ctx, libraryUid, bookUid)
+	return err
+}
+
+// PlaceHold converts echo context to params.
+func (w *ServerInterfaceWrapper) PlaceHold(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PlaceHold(ctx, libraryUid, bookUid)
+	return err
+}
+
+// ReleaseBook converts echo context to params.
+func (w *ServerInterfaceWrapper) ReleaseBook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReleaseBookParams
+	// ------------- Required query parameter "reservationUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "reservationUid", ctx.QueryParams(), &params.ReservationUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// ------------- Optional query parameter "rebook" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rebook", ctx.QueryParams(), &params.Rebook)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rebook: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReleaseBook(ctx, libraryUid, bookUid, params)
+	return err
+}
+
+// ReturnBook converts echo context to params.
+func (w *ServerInterfaceWrapper) ReturnBook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReturnBookParams
+	// ------------- Optional query parameter "reservationUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reservationUid", ctx.QueryParams(), &params.ReservationUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReturnBook(ctx, libraryUid, bookUid, params)
+	return err
+}
+
+// AdjustStock converts echo context to params.
+func (w *ServerInterfaceWrapper) AdjustStock(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AdjustStock(ctx, libraryUid, bookUid)
+	return err
+}
+
+// WriteOffBook converts echo context to params.
+func (w *ServerInterfaceWrapper) WriteOffBook(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WriteOffBookParams
+	// ------------- Required query parameter "reservationUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "reservationUid", ctx.QueryParams(), &params.ReservationUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WriteOffBook(ctx, libraryUid, bookUid, params)
+	return err
+}
+
+// GetInventoryReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInventoryReport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInventoryReportParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInventoryReport(ctx, libraryUid, params)
+	return err
+}
+
+// GetLibrarySchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLibrarySchedule(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLibrarySchedule(ctx, libraryUid)
+	return err
+}
+
+// CheckDueDate converts echo context to params.
+func (w *ServerInterfaceWrapper) CheckDueDate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CheckDueDateParams
+	// ------------- Required query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "date", ctx.QueryParams(), &params.Date)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CheckDueDate(ctx, libraryUid, params)
+	return err
+}
+
+// AddHoliday converts echo context to params.
+func (w *ServerInterfaceWrapper) AddHoliday(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddHoliday(ctx, libraryUid)
+	return err
+}
+
+// DeleteHoliday converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteHoliday(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// ------------- Path parameter "date" -------------
+	var date string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "date", ctx.Param("date"), &date, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteHoliday(ctx, libraryUid, date)
+	return err
+}
+
+// SetOpeningHours converts echo context to params.
+func (w *ServerInterfaceWrapper) SetOpeningHours(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetOpeningHours(ctx, libraryUid)
+	return err
+}
+
+// GetLibraryStatistics converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLibraryStatistics(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLibraryStatisticsParams
+	// ------------- Optional query parameter "bookUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bookUid", ctx.QueryParams(), &params.BookUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLibraryStatistics(ctx, libraryUid, params)
+	return err
+}
+
+// ListStockMovements converts echo context to params.
+func (w *ServerInterfaceWrapper) ListStockMovements(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListStockMovementsParams
+	// ------------- Optional query parameter "bookUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bookUid", ctx.QueryParams(), &params.BookUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// ------------- Optional query parameter "reason" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reason", ctx.QueryParams(), &params.Reason)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reason: %s", err))
+	}
+
+	// ------------- Optional query parameter "correlationUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "correlationUid", ctx.QueryParams(), &params.CorrelationUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter correlationUid: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListStockMovements(ctx, libraryUid, params)
+	return err
+}
+
+// CheckStockConsistency converts echo context to params.
+func (w *ServerInterfaceWrapper) CheckStockConsistency(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CheckStockConsistency(ctx, libraryUid)
+	return err
+}
+
+// ListLibraryTransfers converts echo context to params.
+func (w *ServerInterfaceWrapper) ListLibraryTransfers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "libraryUid" -------------
+	var libraryUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListLibraryTransfersParams
+	// ------------- Optional query parameter "direction" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "direction", ctx.QueryParams(), &params.Direction)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter direction: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListLibraryTransfers(ctx, libraryUid, params)
+	return err
+}
+
+// CreateSeries converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateSeries(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateSeries(ctx)
+	return err
+}
+
+// GetSeries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSeries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "seriesUid" -------------
+	var seriesUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "seriesUid", ctx.Param("seriesUid"), &seriesUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seriesUid: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSeriesParams
+	// ------------- Optional query parameter "libraryUid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "libraryUid", ctx.QueryParams(), &params.LibraryUid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSeries(ctx, seriesUid, params)
+	return err
+}
+
+// RemoveSeriesVolume converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveSeriesVolume(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "seriesUid" -------------
+	var seriesUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "seriesUid", ctx.Param("seriesUid"), &seriesUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seriesUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemoveSeriesVolume(ctx, seriesUid, bookUid)
+	return err
+}
+
+// SetSeriesVolume converts echo context to params.
+func (w *ServerInterfaceWrapper) SetSeriesVolume(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "seriesUid" -------------
+	var seriesUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "seriesUid", ctx.Param("seriesUid"), &seriesUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seriesUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetSeriesVolume(ctx, seriesUid, bookUid)
+	return err
+}
+
+// ListPopularBooks converts echo context to params.
+func (w *ServerInterfaceWrapper) ListPopularBooks(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPopularBooksParams
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", ctx.QueryParams(), &params.City)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter city: %s", err))
+	}
+
+	// ------------- Optional query parameter "genre" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "genre", ctx.QueryParams(), &params.Genre)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter genre: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListPopularBooks(ctx, params)
+	return err
+}
+
+// ListSuggestions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListSuggestions(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSuggestionsParams
+	// ------------- Required query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "query", ctx.QueryParams(), &params.Query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter query: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListSuggestions(ctx, params)
+	return err
+}
+
+// CreateTransfer converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateTransfer(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateTransfer(ctx)
+	return err
+}
+
+// GetTransfer converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransfer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "transferUid" -------------
+	var transferUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferUid", ctx.Param("transferUid"), &transferUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter transferUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransfer(ctx, transferUid)
+	return err
+}
+
+// CancelTransfer converts echo context to params.
+func (w *ServerInterfaceWrapper) CancelTransfer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "transferUid" -------------
+	var transferUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferUid", ctx.Param("transferUid"), &transferUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter transferUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CancelTransfer(ctx, transferUid)
+	return err
+}
+
+// DispatchTransfer converts echo context to params.
+func (w *ServerInterfaceWrapper) DispatchTransfer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "transferUid" -------------
+	var transferUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferUid", ctx.Param("transferUid"), &transferUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter transferUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DispatchTransfer(ctx, transferUid)
+	return err
+}
+
+// ReceiveTransfer converts echo context to params.
+func (w *ServerInterfaceWrapper) ReceiveTransfer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "transferUid" -------------
+	var transferUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferUid", ctx.Param("transferUid"), &transferUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter transferUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ReceiveTransfer(ctx, transferUid)
+	return err
+}
+
+// GetWork converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWork(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workUid" -------------
+	var workUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workUid", ctx.Param("workUid"), &workUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWork(ctx, workUid)
+	return err
+}
+
+// RemoveWorkEdition converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveWorkEdition(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workUid" -------------
+	var workUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workUid", ctx.Param("workUid"), &workUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RemoveWorkEdition(ctx, workUid, bookUid)
+	return err
+}
+
+// AddWorkEdition converts echo context to params.
+func (w *ServerInterfaceWrapper) AddWorkEdition(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "workUid" -------------
+	var workUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workUid", ctx.Param("workUid"), &workUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter workUid: %s", err))
+	}
+
+	// ------------- Path parameter "bookUid" -------------
+	var bookUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AddWorkEdition(ctx, workUid, bookUid)
+	return err
+}
+
+// Health converts echo context to params.
+func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Health(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/api/v1/books", wrapper.SearchBooks)
+	router.GET(baseURL+"/api/v1/books/isbn/:isbn", wrapper.GetBookByIsbn)
+	router.GET(baseURL+"/api/v1/books/:bookUid", wrapper.GetBook)
+	router.DELETE(baseURL+"/api/v1/books/:bookUid/cover", wrapper.DeleteBookCover)
+	router.GET(baseURL+"/api/v1/books/:bookUid/cover", wrapper.GetBookCover)
+	router.PUT(baseURL+"/api/v1/books/:bookUid/cover", wrapper.SetBookCover)
+	router.GET(baseURL+"/api/v1/cities", wrapper.ListCities)
+	router.GET(baseURL+"/api/v1/copies/:copyUid/condition-history", wrapper.GetCopyConditionHistory)
+	router.GET(baseURL+"/api/v1/holds", wrapper.ListHolds)
+	router.DELETE(baseURL+"/api/v1/holds/:holdUid", wrapper.CancelHold)
+	router.GET(baseURL+"/api/v1/holds/:holdUid", wrapper.GetHold)
+	router.GET(baseURL+"/api/v1/libraries", wrapper.ListLibraries)
+	router.GET(baseURL+"/api/v1/libraries/nearby", wrapper.ListNearbyLibraries)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid", wrapper.GetLibrary)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid/books", wrapper.ListBooks)
+	router.POST(baseURL+"/api/v1/libraries/:libraryUid/books/:bookUid", wrapper.TakeBook)
+	router.POST(baseURL+"/api/v1/libraries/:libraryUid/books/:bookUid/bookings", wrapper.BookCopyForPickup)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid/books/:bookUid/copies", wrapper.ListBookCopies)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid/books/:bookUid/holds", wrapper.GetHoldQueue)
+	router.POST(baseURL+"/api/v1/libraries/:libraryUid/books/:bookUid/holds", wrapper.PlaceHold)
+	router.POST(baseURL+"/api/v1/libraries/:libraryUid/books/:bookUid/release", wrapper.ReleaseBook)
+	router.POST(baseURL+"/api/v1/libraries/:libraryUid/books/:bookUid/return", wrapper.ReturnBook)
+	router.POST(baseURL+"/api/v1/libraries/:libraryUid/books/:bookUid/stock-adjustments", wrapper.AdjustStock)
+	router.POST(baseURL+"/api/v1/libraries/:libraryUid/books/:bookUid/write-off", wrapper.WriteOffBook)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid/reports/inventory", wrapper.GetInventoryReport)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid/schedule", wrapper.GetLibrarySchedule)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid/schedule/due-date", wrapper.CheckDueDate)
+	router.POST(baseURL+"/api/v1/libraries/:libraryUid/schedule/holidays", wrapper.AddHoliday)
+	router.DELETE(baseURL+"/api/v1/libraries/:libraryUid/schedule/holidays/:date", wrapper.DeleteHoliday)
+	router.PUT(baseURL+"/api/v1/libraries/:libraryUid/schedule/opening-hours", wrapper.SetOpeningHours)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid/statistics", wrapper.GetLibraryStatistics)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid/stock-movements", wrapper.ListStockMovements)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid/stock-movements/consistency", wrapper.CheckStockConsistency)
+	router.GET(baseURL+"/api/v1/libraries/:libraryUid/transfers", wrapper.ListLibraryTransfers)
+	router.POST(baseURL+"/api/v1/series", wrapper.CreateSeries)
+	router.GET(baseURL+"/api/v1/series/:seriesUid", wrapper.GetSeries)
+	router.DELETE(baseURL+"/api/v1/series/:seriesUid/volumes/:bookUid", wrapper.RemoveSeriesVolume)
+	router.PUT(baseURL+"/api/v1/series/:seriesUid/volumes/:bookUid", wrapper.SetSeriesVolume)
+	router.GET(baseURL+"/api/v1/statistics/popular-books", wrapper.ListPopularBooks)
+	router.GET(baseURL+"/api/v1/suggestions", wrapper.ListSuggestions)
+	router.POST(baseURL+"/api/v1/transfers", wrapper.CreateTransfer)
+	router.GET(baseURL+"/api/v1/transfers/:transferUid", wrapper.GetTransfer)
+	router.POST(baseURL+"/api/v1/transfers/:transferUid/cancel", wrapper.CancelTransfer)
+	router.POST(baseURL+"/api/v1/transfers/:transferUid/dispatch", wrapper.DispatchTransfer)
+	router.POST(baseURL+"/api/v1/transfers/:transferUid/receive", wrapper.ReceiveTransfer)
+	router.GET(baseURL+"/api/v1/works/:workUid", wrapper.GetWork)
+	router.DELETE(baseURL+"/api/v1/works/:workUid/editions/:bookUid", wrapper.RemoveWorkEdition)
+	router.PUT(baseURL+"/api/v1/works/:workUid/editions/:bookUid", wrapper.AddWorkEdition)
+	router.GET(baseURL+"/manage/health", wrapper.Health)
+
+}
+
+type SearchBooksRequestObject struct {
+	Params SearchBooksParams
+}
+
+type SearchBooksResponseObject interface {
+	VisitSearchBooksResponse(w http.ResponseWriter) error
+}
+
+type SearchBooks200JSONResponse WorkSearchPaginationResponse
+
+func (response SearchBooks200JSONResponse) VisitSearchBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchBooks400JSONResponse ValidationErrorResponse
+
+func (response SearchBooks400JSONResponse) VisitSearchBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookByIsbnRequestObject struct {
+	Isbn string `json:"isbn"`
+}
+
+type GetBookByIsbnResponseObject interface {
+	VisitGetBookByIsbnResponse(w http.ResponseWriter) error
+}
+
+type GetBookByIsbn200JSONResponse BookInfo
+
+func (response GetBookByIsbn200JSONResponse) VisitGetBookByIsbnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookByIsbn400JSONResponse ValidationErrorResponse
+
+func (response GetBookByIsbn400JSONResponse) VisitGetBookByIsbnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookByIsbn404JSONResponse ErrorResponse
+
+func (response GetBookByIsbn404JSONResponse) VisitGetBookByIsbnResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookRequestObject struct {
+	BookUid openapi_types.UUID `json:"bookUid"`
+}
+
+type GetBookResponseObject interface {
+	VisitGetBookResponse(w http.ResponseWriter) error
+}
+
+type GetBook200JSONResponse BookInfo
+
+func (response GetBook200JSONResponse) VisitGetBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBook404JSONResponse ErrorResponse
+
+func (response GetBook404JSONResponse) VisitGetBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBookCoverRequestObject struct {
+	BookUid openapi_types.UUID `json:"bookUid"`
+}
+
+type DeleteBookCoverResponseObject interface {
+	VisitDeleteBookCoverResponse(w http.ResponseWriter) error
+}
+
+type DeleteBookCover204Response struct {
+}
+
+func (response DeleteBookCover204Response) VisitDeleteBookCoverResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBookCover403JSONResponse ErrorResponse
+
+func (response DeleteBookCover403JSONResponse) VisitDeleteBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBookCover404JSONResponse ErrorResponse
+
+func (response DeleteBookCover404JSONResponse) VisitDeleteBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBookCoverRequestObject struct {
+	BookUid openapi_types.UUID `json:"bookUid"`
+	Params  GetBookCoverParams
+}
+
+type GetBookCoverResponseObject interface {
+	VisitGetBookCoverResponse(w http.ResponseWriter) error
+}
+
+type GetBookCover200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+
+type GetBookCover200ImageResponse struct {
+	Body          io.Reader
+	Headers       GetBookCover200ResponseHeaders
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetBookCover200ImageResponse) VisitGetBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetBookCover304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+}
+
+type GetBookCover304Response struct {
+	Headers GetBookCover304ResponseHeaders
+}
+
+func (response GetBookCover304Response) VisitGetBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetBookCover404JSONResponse ErrorResponse
+
+func (response GetBookCover404JSONResponse) VisitGetBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetBookCoverRequestObject struct {
+	BookUid openapi_types.UUID `json:"bookUid"`
+	Body    io.Reader
+}
+
+type SetBookCoverResponseObject interface {
+	VisitSetBookCoverResponse(w http.ResponseWriter) error
+}
+
+type SetBookCover200JSONResponse BookInfo
+
+func (response SetBookCover200JSONResponse) VisitSetBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetBookCover403JSONResponse ErrorResponse
+
+func (response SetBookCover403JSONResponse) VisitSetBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetBookCover404JSONResponse ErrorResponse
+
+func (response SetBookCover404JSONResponse) VisitSetBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetBookCover413JSONResponse ErrorResponse
+
+func (response SetBookCover413JSONResponse) VisitSetBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetBookCover415JSONResponse ErrorResponse
+
+func (response SetBookCover415JSONResponse) VisitSetBookCoverResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(415)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCitiesRequestObject struct {
+}
+
+type ListCitiesResponseObject interface {
+	VisitListCitiesResponse(w http.ResponseWriter) error
+}
+
+type ListCities200JSONResponse []CityResponse
+
+func (response ListCities200JSONResponse) VisitListCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCopyConditionHistoryRequestObject struct {
+	CopyUid openapi_types.UUID `json:"copyUid"`
+}
+
+type GetCopyConditionHistoryResponseObject interface {
+	VisitGetCopyConditionHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetCopyConditionHistory200JSONResponse []ConditionChangeResponse
+
+func (response GetCopyConditionHistory200JSONResponse) VisitGetCopyConditionHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCopyConditionHistory404JSONResponse ErrorResponse
+
+func (response GetCopyConditionHistory404JSONResponse) VisitGetCopyConditionHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListHoldsRequestObject struct {
+}
+
+type ListHoldsResponseObject interface {
+	VisitListHoldsResponse(w http.ResponseWriter) error
+}
+
+type ListHolds200JSONResponse []HoldResponse
+
+func (response ListHolds200JSONResponse) VisitListHoldsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelHoldRequestObject struct {
+	HoldUid openapi_types.UUID `json:"holdUid"`
+}
+
+type CancelHoldResponseObject interface {
+	VisitCancelHoldResponse(w http.ResponseWriter) error
+}
+
+type CancelHold204Response struct {
+}
+
+func (response CancelHold204Response) VisitCancelHoldResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelHold404JSONResponse ErrorResponse
+
+func (response CancelHold404JSONResponse) VisitCancelHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelHold409JSONResponse ErrorResponse
+
+func (response CancelHold409JSONResponse) VisitCancelHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHoldRequestObject struct {
+	HoldUid openapi_types.UUID `json:"holdUid"`
+}
+
+type GetHoldResponseObject interface {
+	VisitGetHoldResponse(w http.ResponseWriter) error
+}
+
+type GetHold200JSONResponse HoldResponse
+
+func (response GetHold200JSONResponse) VisitGetHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHold404JSONResponse ErrorResponse
+
+func (response GetHold404JSONResponse) VisitGetHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLibrariesRequestObject struct {
+	Params ListLibrariesParams
+}
+
+type ListLibrariesResponseObject interface {
+	VisitListLibrariesResponse(w http.ResponseWriter) error
+}
+
+type ListLibraries200JSONResponse LibraryPaginationResponse
+
+func (response ListLibraries200JSONResponse) VisitListLibrariesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLibraries400JSONResponse ValidationErrorResponse
+
+func (response ListLibraries400JSONResponse) VisitListLibrariesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNearbyLibrariesRequestObject struct {
+	Params ListNearbyLibrariesParams
+}
+
+type ListNearbyLibrariesResponseObject interface {
+	VisitListNearbyLibrariesResponse(w http.ResponseWriter) error
+}
+
+type ListNearbyLibraries200JSONResponse LibraryPaginationResponse
+
+func (response ListNearbyLibraries200JSONResponse) VisitListNearbyLibrariesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListNearbyLibraries400JSONResponse ValidationErrorResponse
+
+func (response ListNearbyLibraries400JSONResponse) VisitListNearbyLibrariesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLibraryRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+}
+
+type GetLibraryResponseObject interface {
+	VisitGetLibraryResponse(w http.ResponseWriter) error
+}
+
+type GetLibrary200JSONResponse LibraryResponse
+
+func (response GetLibrary200JSONResponse) VisitGetLibraryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLibrary404JSONResponse ErrorResponse
+
+func (response GetLibrary404JSONResponse) VisitGetLibraryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBooksRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	Params     ListBooksParams
+}
+
+type ListBooksResponseObject interface {
+	VisitListBooksResponse(w http.ResponseWriter) error
+}
+
+type ListBooks200JSONResponse LibraryBookPaginationResponse
+
+func (response ListBooks200JSONResponse) VisitListBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBooks400JSONResponse ValidationErrorResponse
+
+func (response ListBooks400JSONResponse) VisitListBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBooks404JSONResponse ErrorResponse
+
+func (response ListBooks404JSONResponse) VisitListBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeBookRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	BookUid    openapi_types.UUID `json:"bookUid"`
+	Params     TakeBookParams
+}
+
+type TakeBookResponseObject interface {
+	VisitTakeBookResponse(w http.ResponseWriter) error
+}
+
+type TakeBook200JSONResponse BookCopyResponse
+
+func (response TakeBook200JSONResponse) VisitTakeBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeBook404JSONResponse ErrorResponse
+
+func (response TakeBook404JSONResponse) VisitTakeBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TakeBook409JSONResponse ErrorResponse
+
+func (response TakeBook409JSONResponse) VisitTakeBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BookCopyForPickupRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	BookUid    openapi_types.UUID `json:"bookUid"`
+	Params     BookCopyForPickupParams
+	Body       *BookCopyForPickupJSONRequestBody
+}
+
+type BookCopyForPickupResponseObject interface {
+	VisitBookCopyForPickupResponse(w http.ResponseWriter) error
+}
+
+type BookCopyForPickup201JSONResponse BookingResponse
+
+func (response BookCopyForPickup201JSONResponse) VisitBookCopyForPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BookCopyForPickup400JSONResponse ValidationErrorResponse
+
+func (response BookCopyForPickup400JSONResponse) VisitBookCopyForPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BookCopyForPickup404JSONResponse ErrorResponse
+
+func (response BookCopyForPickup404JSONResponse) VisitBookCopyForPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BookCopyForPickup409JSONResponse ErrorResponse
+
+func (response BookCopyForPickup409JSONResponse) VisitBookCopyForPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBookCopiesRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	BookUid    openapi_types.UUID `json:"bookUid"`
+}
+
+type ListBookCopiesResponseObject interface {
+	VisitListBookCopiesResponse(w http.ResponseWriter) error
+}
+
+type ListBookCopies200JSONResponse []BookCopyResponse
+
+func (response ListBookCopies200JSONResponse) VisitListBookCopiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBookCopies404JSONResponse ErrorResponse
+
+func (response ListBookCopies404JSONResponse) VisitListBookCopiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHoldQueueRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	BookUid    openapi_types.UUID `json:"bookUid"`
+}
+
+type GetHoldQueueResponseObject interface {
+	VisitGetHoldQueueResponse(w http.ResponseWriter) error
+}
+
+type GetHoldQueue200JSONResponse HoldQueueResponse
+
+func (response GetHoldQueue200JSONResponse) VisitGetHoldQueueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceHoldRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	BookUid    openapi_types.UUID `json:"bookUid"`
+	Body       *PlaceHoldJSONRequestBody
+}
+
+type PlaceHoldResponseObject interface {
+	VisitPlaceHoldResponse(w http.ResponseWriter) error
+}
+
+type PlaceHold201JSONResponse HoldResponse
+
+func (response PlaceHold201JSONResponse) VisitPlaceHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceHold400JSONResponse ValidationErrorResponse
+
+func (response PlaceHold400JSONResponse) VisitPlaceHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceHold404JSONResponse ErrorResponse
+
+func (response PlaceHold404JSONResponse) VisitPlaceHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlaceHold409JSONResponse ErrorResponse
+
+func (response PlaceHold409JSONResponse) VisitPlaceHoldResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReleaseBookRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	BookUid    openapi_types.UUID `json:"bookUid"`
+	Params     ReleaseBookParams
+}
+
+type ReleaseBookResponseObject interface {
+	VisitReleaseBookResponse(w http.ResponseWriter) error
+}
+
+type ReleaseBook200JSONResponse BookCopyResponse
+
+func (response ReleaseBook200JSONResponse) VisitReleaseBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReleaseBook204Response struct {
+}
+
+func (response ReleaseBook204Response) VisitReleaseBookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ReleaseBook404JSONResponse ErrorResponse
+
+func (response ReleaseBook404JSONResponse) VisitReleaseBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBookRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	BookUid    openapi_types.UUID `json:"bookUid"`
+	Params     ReturnBookParams
+	Body       *ReturnBookJSONRequestBody
+}
+
+type ReturnBookResponseObject interface {
+	VisitReturnBookResponse(w http.ResponseWriter) error
+}
+
+type ReturnBook200JSONResponse ViolationStatus
+
+func (response ReturnBook200JSONResponse) VisitReturnBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBook400JSONResponse ValidationErrorResponse
+
+func (response ReturnBook400JSONResponse) VisitReturnBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBook404JSONResponse ErrorResponse
+
+func (response ReturnBook404JSONResponse) VisitReturnBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReturnBook409JSONResponse ErrorResponse
+
+func (response ReturnBook409JSONResponse) VisitReturnBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustStockRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	BookUid    openapi_types.UUID `json:"bookUid"`
+	Body       *AdjustStockJSONRequestBody
+}
+
+type AdjustStockResponseObject interface {
+	VisitAdjustStockResponse(w http.ResponseWriter) error
+}
+
+type AdjustStock200JSONResponse StockMovementResponse
+
+func (response AdjustStock200JSONResponse) VisitAdjustStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustStock400JSONResponse ValidationErrorResponse
+
+func (response AdjustStock400JSONResponse) VisitAdjustStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustStock403JSONResponse ErrorResponse
+
+func (response AdjustStock403JSONResponse) VisitAdjustStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustStock404JSONResponse ErrorResponse
+
+func (response AdjustStock404JSONResponse) VisitAdjustStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AdjustStock409JSONResponse ErrorResponse
+
+func (response AdjustStock409JSONResponse) VisitAdjustStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WriteOffBookRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	BookUid    openapi_types.UUID `json:"bookUid"`
+	Params     WriteOffBookParams
+	Body       *WriteOffBookJSONRequestBody
+}
+
+type WriteOffBookResponseObject interface {
+	VisitWriteOffBookResponse(w http.ResponseWriter) error
+}
+
+type WriteOffBook200JSONResponse BookCopyResponse
+
+func (response WriteOffBook200JSONResponse) VisitWriteOffBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WriteOffBook400JSONResponse ValidationErrorResponse
+
+func (response WriteOffBook400JSONResponse) VisitWriteOffBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WriteOffBook403JSONResponse ErrorResponse
+
+func (response WriteOffBook403JSONResponse) VisitWriteOffBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WriteOffBook404JSONResponse ErrorResponse
+
+func (response WriteOffBook404JSONResponse) VisitWriteOffBookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInventoryReportRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	Params     GetInventoryReportParams
+}
+
+type GetInventoryReportResponseObject interface {
+	VisitGetInventoryReportResponse(w http.ResponseWriter) error
+}
+
+type GetInventoryReport200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetInventoryReport200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse struct {
+	Body          io.Reader
+	Headers       GetInventoryReport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetInventoryReport200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse) VisitGetInventoryReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetInventoryReport200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetInventoryReport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetInventoryReport200TextcsvResponse) VisitGetInventoryReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetInventoryReport400JSONResponse ValidationErrorResponse
+
+func (response GetInventoryReport400JSONResponse) VisitGetInventoryReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInventoryReport403JSONResponse ErrorResponse
+
+func (response GetInventoryReport403JSONResponse) VisitGetInventoryReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInventoryReport404JSONResponse ErrorResponse
+
+func (response GetInventoryReport404JSONResponse) VisitGetInventoryReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLibraryScheduleRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+}
+
+type GetLibraryScheduleResponseObject interface {
+	VisitGetLibraryScheduleResponse(w http.ResponseWriter) error
+}
+
+type GetLibrarySchedule200JSONResponse LibraryScheduleResponse
+
+func (response GetLibrarySchedule200JSONResponse) VisitGetLibraryScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLibrarySchedule404JSONResponse ErrorResponse
+
+func (response GetLibrarySchedule404JSONResponse) VisitGetLibraryScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckDueDateRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	Params     CheckDueDateParams
+}
+
+type CheckDueDateResponseObject interface {
+	VisitCheckDueDateResponse(w http.ResponseWriter) error
+}
+
+type CheckDueDate200JSONResponse DueDateResponse
+
+func (response CheckDueDate200JSONResponse) VisitCheckDueDateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckDueDate400JSONResponse ValidationErrorResponse
+
+func (response CheckDueDate400JSONResponse) VisitCheckDueDateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckDueDate404JSONResponse ErrorResponse
+
+func (response CheckDueDate404JSONResponse) VisitCheckDueDateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddHolidayRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	Body       *AddHolidayJSONRequestBody
+}
+
+type AddHolidayResponseObject interface {
+	VisitAddHolidayResponse(w http.ResponseWriter) error
+}
+
+type AddHoliday201JSONResponse HolidayResponse
+
+func (response AddHoliday201JSONResponse) VisitAddHolidayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddHoliday400JSONResponse ValidationErrorResponse
+
+func (response AddHoliday400JSONResponse) VisitAddHolidayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddHoliday403JSONResponse ErrorResponse
+
+func (response AddHoliday403JSONResponse) VisitAddHolidayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddHoliday404JSONResponse ErrorResponse
+
+func (response AddHoliday404JSONResponse) VisitAddHolidayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddHoliday409JSONResponse ErrorResponse
+
+func (response AddHoliday409JSONResponse) VisitAddHolidayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHolidayRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	Date       string             `json:"date"`
+}
+
+type DeleteHolidayResponseObject interface {
+	VisitDeleteHolidayResponse(w http.ResponseWriter) error
+}
+
+type DeleteHoliday204Response struct {
+}
+
+func (response DeleteHoliday204Response) VisitDeleteHolidayResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteHoliday400JSONResponse ValidationErrorResponse
+
+func (response DeleteHoliday400JSONResponse) VisitDeleteHolidayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHoliday403JSONResponse ErrorResponse
+
+func (response DeleteHoliday403JSONResponse) VisitDeleteHolidayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHoliday404JSONResponse ErrorResponse
+
+func (response DeleteHoliday404JSONResponse) VisitDeleteHolidayResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOpeningHoursRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	Body       *SetOpeningHoursJSONRequestBody
+}
+
+type SetOpeningHoursResponseObject interface {
+	VisitSetOpeningHoursResponse(w http.ResponseWriter) error
+}
+
+type SetOpeningHours200JSONResponse LibraryScheduleResponse
+
+func (response SetOpeningHours200JSONResponse) VisitSetOpeningHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOpeningHours400JSONResponse ValidationErrorResponse
+
+func (response SetOpeningHours400JSONResponse) VisitSetOpeningHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOpeningHours403JSONResponse ErrorResponse
+
+func (response SetOpeningHours403JSONResponse) VisitSetOpeningHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetOpeningHours404JSONResponse ErrorResponse
+
+func (response SetOpeningHours404JSONResponse) VisitSetOpeningHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLibraryStatisticsRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	Params     GetLibraryStatisticsParams
+}
+
+type GetLibraryStatisticsResponseObject interface {
+	VisitGetLibraryStatisticsResponse(w http.ResponseWriter) error
+}
+
+type GetLibraryStatistics200JSONResponse LibraryStatisticsResponse
+
+func (response GetLibraryStatistics200JSONResponse) VisitGetLibraryStatisticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLibraryStatistics400JSONResponse ValidationErrorResponse
+
+func (response GetLibraryStatistics400JSONResponse) VisitGetLibraryStatisticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLibraryStatistics404JSONResponse ErrorResponse
+
+func (response GetLibraryStatistics404JSONResponse) VisitGetLibraryStatisticsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStockMovementsRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	Params     ListStockMovementsParams
+}
+
+type ListStockMovementsResponseObject interface {
+	VisitListStockMovementsResponse(w http.ResponseWriter) error
+}
+
+type ListStockMovements200JSONResponse StockMovementPaginationResponse
+
+func (response ListStockMovements200JSONResponse) VisitListStockMovementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStockMovements400JSONResponse ValidationErrorResponse
+
+func (response ListStockMovements400JSONResponse) VisitListStockMovementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListStockMovements403JSONResponse ErrorResponse
+
+func (response ListStockMovements403JSONResponse) VisitListStockMovementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckStockConsistencyRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+}
+
+type CheckStockConsistencyResponseObject interface {
+	VisitCheckStockConsistencyResponse(w http.ResponseWriter) error
+}
+
+type CheckStockConsistency200JSONResponse StockConsistencyResponse
+
+func (response CheckStockConsistency200JSONResponse) VisitCheckStockConsistencyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckStockConsistency403JSONResponse ErrorResponse
+
+func (response CheckStockConsistency403JSONResponse) VisitCheckStockConsistencyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckStockConsistency404JSONResponse ErrorResponse
+
+func (response CheckStockConsistency404JSONResponse) VisitCheckStockConsistencyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLibraryTransfersRequestObject struct {
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+	Params     ListLibraryTransfersParams
+}
+
+type ListLibraryTransfersResponseObject interface {
+	VisitListLibraryTransfersResponse(w http.ResponseWriter) error
+}
+
+type ListLibraryTransfers200JSONResponse TransferPaginationResponse
+
+func (response ListLibraryTransfers200JSONResponse) VisitListLibraryTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListLibraryTransfers400JSONResponse ValidationErrorResponse
+
+func (response ListLibraryTransfers400JSONResponse) VisitListLibraryTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSeriesRequestObject struct {
+	Body *CreateSeriesJSONRequestBody
+}
+
+type CreateSeriesResponseObject interface {
+	VisitCreateSeriesResponse(w http.ResponseWriter) error
+}
+
+type CreateSeries201JSONResponse SeriesResponse
+
+func (response CreateSeries201JSONResponse) VisitCreateSeriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSeries400JSONResponse ValidationErrorResponse
+
+func (response CreateSeries400JSONResponse) VisitCreateSeriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSeries403JSONResponse ErrorResponse
+
+func (response CreateSeries403JSONResponse) VisitCreateSeriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSeriesRequestObject struct {
+	SeriesUid openapi_types.UUID `json:"seriesUid"`
+	Params    GetSeriesParams
+}
+
+type GetSeriesResponseObject interface {
+	VisitGetSeriesResponse(w http.ResponseWriter) error
+}
+
+type GetSeries200JSONResponse SeriesResponse
+
+func (response GetSeries200JSONResponse) VisitGetSeriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSeries404JSONResponse ErrorResponse
+
+func (response GetSeries404JSONResponse) VisitGetSeriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveSeriesVolumeRequestObject struct {
+	SeriesUid openapi_types.UUID `json:"seriesUid"`
+	BookUid   openapi_types.UUID `json:"bookUid"`
+}
+
+type RemoveSeriesVolumeResponseObject interface {
+	VisitRemoveSeriesVolumeResponse(w http.ResponseWriter) error
+}
+
+type RemoveSeriesVolume204Response struct {
+}
+
+func (response RemoveSeriesVolume204Response) VisitRemoveSeriesVolumeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveSeriesVolume403JSONResponse ErrorResponse
+
+func (response RemoveSeriesVolume403JSONResponse) VisitRemoveSeriesVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveSeriesVolume404JSONResponse ErrorResponse
+
+func (response RemoveSeriesVolume404JSONResponse) VisitRemoveSeriesVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSeriesVolumeRequestObject struct {
+	SeriesUid openapi_types.UUID `json:"seriesUid"`
+	BookUid   openapi_types.UUID `json:"bookUid"`
+	Body      *SetSeriesVolumeJSONRequestBody
+}
+
+type SetSeriesVolumeResponseObject interface {
+	VisitSetSeriesVolumeResponse(w http.ResponseWriter) error
+}
+
+type SetSeriesVolume200JSONResponse SeriesResponse
+
+func (response SetSeriesVolume200JSONResponse) VisitSetSeriesVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSeriesVolume400JSONResponse ValidationErrorResponse
+
+func (response SetSeriesVolume400JSONResponse) VisitSetSeriesVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSeriesVolume403JSONResponse ErrorResponse
+
+func (response SetSeriesVolume403JSONResponse) VisitSetSeriesVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSeriesVolume404JSONResponse ErrorResponse
+
+func (response SetSeriesVolume404JSONResponse) VisitSetSeriesVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSeriesVolume409JSONResponse ErrorResponse
+
+func (response SetSeriesVolume409JSONResponse) VisitSetSeriesVolumeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPopularBooksRequestObject struct {
+	Params ListPopularBooksParams
+}
+
+type ListPopularBooksResponseObject interface {
+	VisitListPopularBooksResponse(w http.ResponseWriter) error
+}
+
+type ListPopularBooks200JSONResponse []PopularBookResponse
+
+func (response ListPopularBooks200JSONResponse) VisitListPopularBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPopularBooks400JSONResponse ValidationErrorResponse
+
+func (response ListPopularBooks400JSONResponse) VisitListPopularBooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSuggestionsRequestObject struct {
+	Params ListSuggestionsParams
+}
+
+type ListSuggestionsResponseObject interface {
+	VisitListSuggestionsResponse(w http.ResponseWriter) error
+}
+
+type ListSuggestions200JSONResponse []SuggestionResponse
+
+func (response ListSuggestions200JSONResponse) VisitListSuggestionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSuggestions400JSONResponse ValidationErrorResponse
+
+func (response ListSuggestions400JSONResponse) VisitListSuggestionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransferRequestObject struct {
+	Body *CreateTransferJSONRequestBody
+}
+
+type CreateTransferResponseObject interface {
+	VisitCreateTransferResponse(w http.ResponseWriter) error
+}
+
+type CreateTransfer201JSONResponse TransferResponse
+
+func (response CreateTransfer201JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer400JSONResponse ValidationErrorResponse
+
+func (response CreateTransfer400JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer403JSONResponse ErrorResponse
+
+func (response CreateTransfer403JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTransfer404JSONResponse ErrorResponse
+
+func (response CreateTransfer404JSONResponse) VisitCreateTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransferRequestObject struct {
+	TransferUid openapi_types.UUID `json:"transferUid"`
+}
+
+type GetTransferResponseObject interface {
+	VisitGetTransferResponse(w http.ResponseWriter) error
+}
+
+type GetTransfer200JSONResponse TransferResponse
+
+func (response GetTransfer200JSONResponse) VisitGetTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfer404JSONResponse ErrorResponse
+
+func (response GetTransfer404JSONResponse) VisitGetTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelTransferRequestObject struct {
+	TransferUid openapi_types.UUID `json:"transferUid"`
+}
+
+type CancelTransferResponseObject interface {
+	VisitCancelTransferResponse(w http.ResponseWriter) error
+}
+
+type CancelTransfer200JSONResponse TransferResponse
+
+func (response CancelTransfer200JSONResponse) VisitCancelTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelTransfer403JSONResponse ErrorResponse
+
+func (response CancelTransfer403JSONResponse) VisitCancelTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelTransfer404JSONResponse ErrorResponse
+
+func (response CancelTransfer404JSONResponse) VisitCancelTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelTransfer409JSONResponse ErrorResponse
+
+func (response CancelTransfer409JSONResponse) VisitCancelTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DispatchTransferRequestObject struct {
+	TransferUid openapi_types.UUID `json:"transferUid"`
+}
+
+type DispatchTransferResponseObject interface {
+	VisitDispatchTransferResponse(w http.ResponseWriter) error
+}
+
+type DispatchTransfer200JSONResponse TransferResponse
+
+func (response DispatchTransfer200JSONResponse) VisitDispatchTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DispatchTransfer403JSONResponse ErrorResponse
+
+func (response DispatchTransfer403JSONResponse) VisitDispatchTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DispatchTransfer404JSONResponse ErrorResponse
+
+func (response DispatchTransfer404JSONResponse) VisitDispatchTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DispatchTransfer409JSONResponse ErrorResponse
+
+func (response DispatchTransfer409JSONResponse) VisitDispatchTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceiveTransferRequestObject struct {
+	TransferUid openapi_types.UUID `json:"transferUid"`
+}
+
+type ReceiveTransferResponseObject interface {
+	VisitReceiveTransferResponse(w http.ResponseWriter) error
+}
+
+type ReceiveTransfer200JSONResponse TransferResponse
+
+func (response ReceiveTransfer200JSONResponse) VisitReceiveTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceiveTransfer403JSONResponse ErrorResponse
+
+func (response ReceiveTransfer403JSONResponse) VisitReceiveTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceiveTransfer404JSONResponse ErrorResponse
+
+func (response ReceiveTransfer404JSONResponse) VisitReceiveTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReceiveTransfer409JSONResponse ErrorResponse
+
+func (response ReceiveTransfer409JSONResponse) VisitReceiveTransferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkRequestObject struct {
+	WorkUid openapi_types.UUID `json:"workUid"`
+}
+
+type GetWorkResponseObject interface {
+	VisitGetWorkResponse(w http.ResponseWriter) error
+}
+
+type GetWork200JSONResponse WorkResponse
+
+func (response GetWork200JSONResponse) VisitGetWorkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWork404JSONResponse ErrorResponse
+
+func (response GetWork404JSONResponse) VisitGetWorkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveWorkEditionRequestObject struct {
+	WorkUid openapi_types.UUID `json:"workUid"`
+	BookUid openapi_types.UUID `json:"bookUid"`
+}
+
+type RemoveWorkEditionResponseObject interface {
+	VisitRemoveWorkEditionResponse(w http.ResponseWriter) error
+}
+
+type RemoveWorkEdition204Response struct {
+}
+
+func (response RemoveWorkEdition204Response) VisitRemoveWorkEditionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveWorkEdition403JSONResponse ErrorResponse
+
+func (response RemoveWorkEdition403JSONResponse) VisitRemoveWorkEditionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveWorkEdition404JSONResponse ErrorResponse
+
+func (response RemoveWorkEdition404JSONResponse) VisitRemoveWorkEditionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveWorkEdition409JSONResponse ErrorResponse
+
+func (response RemoveWorkEdition409JSONResponse) VisitRemoveWorkEditionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddWorkEditionRequestObject struct {
+	WorkUid openapi_types.UUID `json:"workUid"`
+	BookUid openapi_types.UUID `json:"bookUid"`
+}
+
+type AddWorkEditionResponseObject interface {
+	VisitAddWorkEditionResponse(w http.ResponseWriter) error
+}
+
+type AddWorkEdition200JSONResponse WorkResponse
+
+func (response AddWorkEdition200JSONResponse) VisitAddWorkEditionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddWorkEdition403JSONResponse ErrorResponse
+
+func (response AddWorkEdition403JSONResponse) VisitAddWorkEditionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddWorkEdition404JSONResponse ErrorResponse
+
+func (response AddWorkEdition404JSONResponse) VisitAddWorkEditionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HealthRequestObject struct {
+}
+
+type HealthResponseObject interface {
+	VisitHealthResponse(w http.ResponseWriter) error
+}
+
+type Health200Response struct {
+}
+
+func (response Health200Response) VisitHealthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Поиск книг с группировкой изданий по произведениям
+	// (GET /api/v1/books)
+	SearchBooks(ctx context.Context, request SearchBooksRequestObject) (SearchBooksResponseObject, error)
+	// Найти книгу по ISBN
+	// (GET /api/v1/books/isbn/{isbn})
+	GetBookByIsbn(ctx context.Context, request GetBookByIsbnRequestObject) (GetBookByIsbnResponseObject, error)
+	// Получить информацию о книге
+	// (GET /api/v1/books/{bookUid})
+	GetBook(ctx context.Context, request GetBookRequestObject) (GetBookResponseObject, error)
+	// Удалить обложку книги
+	// (DELETE /api/v1/books/{bookUid}/cover)
+	DeleteBookCover(ctx context.Context, request DeleteBookCoverRequestObject) (DeleteBookCoverResponseObject, error)
+	// Получить обложку книги
+	// (GET /api/v1/books/{bookUid}/cover)
+	GetBookCover(ctx context.Context, request GetBookCoverRequestObject) (GetBookCoverResponseObject, error)
+	// Загрузить обложку книги
+	// (PUT /api/v1/books/{bookUid}/cover)
+	SetBookCover(ctx context.Context, request SetBookCoverRequestObject) (SetBookCoverResponseObject, error)
+	// Получить список городов, в которых есть библиотеки
+	// (GET /api/v1/cities)
+	ListCities(ctx context.Context, request ListCitiesRequestObject) (ListCitiesResponseObject, error)
+	// Получить историю изменения состояния экземпляра
+	// (GET /api/v1/copies/{copyUid}/condition-history)
+	GetCopyConditionHistory(ctx context.Context, request GetCopyConditionHistoryRequestObject) (GetCopyConditionHistoryResponseObject, error)
+	// Получить активные заявки пользователя в очередях на книги
+	// (GET /api/v1/holds)
+	ListHolds(ctx context.Context, request ListHoldsRequestObject) (ListHoldsResponseObject, error)
+	// Отменить заявку
+	// (DELETE /api/v1/holds/{holdUid})
+	CancelHold(ctx context.Context, request CancelHoldRequestObject) (CancelHoldResponseObject, error)
+	// Получить заявку и место в очереди
+	// (GET /api/v1/holds/{holdUid})
+	GetHold(ctx context.Context, request GetHoldRequestObject) (GetHoldResponseObject, error)
+	// Получить список библиотек
+	// (GET /api/v1/libraries)
+	ListLibraries(ctx context.Context, request ListLibrariesRequestObject) (ListLibrariesResponseObject, error)
+	// Найти библиотеки рядом с точкой
+	// (GET /api/v1/libraries/nearby)
+	ListNearbyLibraries(ctx context.Context, request ListNearbyLibrariesRequestObject) (ListNearbyLibrariesResponseObject, error)
+	// Получить информацию о библиотеке
+	// (GET /api/v1/libraries/{libraryUid})
+	GetLibrary(ctx context.Context, request GetLibraryRequestObject) (GetLibraryResponseObject, error)
+	// Получить список книг в выбранной библиотеке
+	// (GET /api/v1/libraries/{libraryUid}/books)
+	ListBooks(ctx context.Context, request ListBooksRequestObject) (ListBooksResponseObject, error)
+	// Взять книгу в библиотеке
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid})
+	TakeBook(ctx context.Context, request TakeBookRequestObject) (TakeBookResponseObject, error)
+	// Забронировать экземпляр книги к дате получения
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/bookings)
+	BookCopyForPickup(ctx context.Context, request BookCopyForPickupRequestObject) (BookCopyForPickupResponseObject, error)
+	// Получить список экземпляров книги в библиотеке
+	// (GET /api/v1/libraries/{libraryUid}/books/{bookUid}/copies)
+	ListBookCopies(ctx context.Context, request ListBookCopiesRequestObject) (ListBookCopiesResponseObject, error)
+	// Получить длину очереди на книгу
+	// (GET /api/v1/libraries/{libraryUid}/books/{bookUid}/holds)
+	GetHoldQueue(ctx context.Context, request GetHoldQueueRequestObject) (GetHoldQueueResponseObject, error)
+	// Встать в очередь на книгу, которой нет в наличии
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/holds)
+	PlaceHold(ctx context.Context, request PlaceHoldRequestObject) (PlaceHoldResponseObject, error)
+	// Вернуть в фонд экземпляр по отмененному бронированию
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/release)
+	ReleaseBook(ctx context.Context, request ReleaseBookRequestObject) (ReleaseBookResponseObject, error)
+	// Вернуть книгу в библиотеку
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/return)
+	ReturnBook(ctx context.Context, request ReturnBookRequestObject) (ReturnBookResponseObject, error)
+	// Скорректировать количество экземпляров книги
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/stock-adjustments)
+	AdjustStock(ctx context.Context, request AdjustStockRequestObject) (AdjustStockResponseObject, error)
+	// Списать выданный экземпляр как утерянный или испорченный
+	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/write-off)
+	WriteOffBook(ctx context.Context, request WriteOffBookRequestObject) (WriteOffBookResponseObject, error)
+	// Выгрузить инвентаризационный отчет библиотеки
+	// (GET /api/v1/libraries/{libraryUid}/reports/inventory)
+	GetInventoryReport(ctx context.Context, request GetInventoryReportRequestObject) (GetInventoryReportResponseObject, error)
+	// Получить режим работы библиотеки
+	// (GET /api/v1/libraries/{libraryUid}/schedule)
+	GetLibrarySchedule(ctx context.Context, request GetLibraryScheduleRequestObject) (GetLibraryScheduleResponseObject, error)
+	// Проверить режим работы для выдачи книги
+	// (GET /api/v1/libraries/{libraryUid}/schedule/due-date)
+	CheckDueDate(ctx context.Context, request CheckDueDateRequestObject) (CheckDueDateResponseObject, error)
+	// Добавить выходной день
+	// (POST /api/v1/libraries/{libraryUid}/schedule/holidays)
+	AddHoliday(ctx context.Context, request AddHolidayRequestObject) (AddHolidayResponseObject, error)
+	// Удалить выходной день
+	// (DELETE /api/v1/libraries/{libraryUid}/schedule/holidays/{date})
+	DeleteHoliday(ctx context.Context, request DeleteHolidayRequestObject) (DeleteHolidayResponseObject, error)
+	// Задать часы работы библиотеки
+	// (PUT /api/v1/libraries/{libraryUid}/schedule/opening-hours)
+	SetOpeningHours(ctx context.Context, request SetOpeningHoursRequestObject) (SetOpeningHoursResponseObject, error)
+	// Получить статистику выдачи книг в библиотеке
+	// (GET /api/v1/libraries/{libraryUid}/statistics)
+	GetLibraryStatistics(ctx context.Context, request GetLibraryStatisticsRequestObject) (GetLibraryStatisticsResponseObject, error)
+	// Получить журнал движения экземпляров библиотеки
+	// (GET /api/v1/libraries/{libraryUid}/stock-movements)
+	ListStockMovements(ctx context.Context, request ListStockMovementsRequestObject) (ListStockMovementsResponseObject, error)
+	// Сверить журнал движения с текущим количеством экземпляров
+	// (GET /api/v1/libraries/{libraryUid}/stock-movements/consistency)
+	CheckStockConsistency(ctx context.Context, request CheckStockConsistencyRequestObject) (CheckStockConsistencyResponseObject, error)
+	// Получить список перемещений библиотеки
+	// (GET /api/v1/libraries/{libraryUid}/transfers)
+	ListLibraryTransfers(ctx context.Context, request ListLibraryTransfersRequestObject) (ListLibraryTransfersResponseObject, error)
+	// Создать серию
+	// (POST /api/v1/series)
+	CreateSeries(ctx context.Context, request CreateSeriesRequestObject) (CreateSeriesResponseObject, error)
+	// Получить тома серии и их доступность
+	// (GET /api/v1/series/{seriesUid})
+	GetSeries(ctx context.Context, request GetSeriesRequestObject) (GetSeriesResponseObject, error)
+	// Удалить том из серии
+	// (DELETE /api/v1/series/{seriesUid}/volumes/{bookUid})
+	RemoveSeriesVolume(ctx context.Context, request RemoveSeriesVolumeRequestObject) (RemoveSeriesVolumeResponseObject, error)
+	// Добавить книгу в серию как том
+	// (PUT /api/v1/series/{seriesUid}/volumes/{bookUid})
+	SetSeriesVolume(ctx context.Context, request SetSeriesVolumeRequestObject) (SetSeriesVolumeResponseObject, error)
+	// Получить самые популярные книги
+	// (GET /api/v1/statistics/popular-books)
+	ListPopularBooks(ctx context.Context, request ListPopularBooksRequestObject) (ListPopularBooksResponseObject, error)
+	// Подсказки для поиска по названиям, авторам и жанрам
+	// (GET /api/v1/suggestions)
+	ListSuggestions(ctx context.Context, request ListSuggestionsRequestObject) (ListSuggestionsResponseObject, error)
+	// Создать заявку на перемещение экземпляров между библиотеками
+	// (POST /api/v1/transfers)
+	CreateTransfer(ctx context.Context, request CreateTransferRequestObject) (CreateTransferResponseObject, error)
+	// Получить информацию о перемещении
+	// (GET /api/v1/transfers/{transferUid})
+	GetTransfer(ctx context.Context, request GetTransferRequestObject) (GetTransferResponseObject, error)
+	// Отменить перемещение до отправки
+	// (POST /api/v1/transfers/{transferUid}/cancel)
+	CancelTransfer(ctx context.Context, request CancelTransferRequestObject) (CancelTransferResponseObject, error)
+	// Отправить экземпляры из библиотеки-источника
+	// (POST /api/v1/transfers/{transferUid}/dispatch)
+	DispatchTransfer(ctx context.Context, request DispatchTransferRequestObject) (DispatchTransferResponseObject, error)
+	// Принять экземпляры в библиотеке-получателе
+	// (POST /api/v1/transfers/{transferUid}/receive)
+	ReceiveTransfer(ctx context.Context, request ReceiveTransferRequestObject) (ReceiveTransferResponseObject, error)
+	// Получить произведение и все его издания
+	// (GET /api/v1/works/{workUid})
+	GetWork(ctx context.Context, request GetWorkRequestObject) (GetWorkResponseObject, error)
+	// Выделить издание в отдельное произведение
+	// (DELETE /api/v1/works/{workUid}/editions/{bookUid})
+	RemoveWorkEdition(ctx context.Context, request RemoveWorkEditionRequestObject) (RemoveWorkEditionResponseObject, error)
+	// Добавить книгу в произведение как издание
+	// (PUT /api/v1/works/{workUid}/editions/{bookUid})
+	AddWorkEdition(ctx context.Context, request AddWorkEditionRequestObject) (AddWorkEditionResponseObject, error)
+	// Проверка живости сервиса
+	// (GET /manage/health)
+	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// SearchBooks operation middleware
+func (sh *strictHandler) SearchBooks(ctx echo.Context, params SearchBooksParams) error {
+	var request SearchBooksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchBooks(ctx.Request().Context(), request.(SearchBooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchBooks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SearchBooksResponseObject); ok {
+		return validResponse.VisitSearchBooksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBookByIsbn operation middleware
+func (sh *strictHandler) GetBookByIsbn(ctx echo.Context, isbn string) error {
+	var request GetBookByIsbnRequestObject
+
+	request.Isbn = isbn
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBookByIsbn(ctx.Request().Context(), request.(GetBookByIsbnRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBookByIsbn")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBookByIsbnResponseObject); ok {
+		return validResponse.VisitGetBookByIsbnResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBook operation middleware
+func (sh *strictHandler) GetBook(ctx echo.Context, bookUid openapi_types.UUID) error {
+	var request GetBookRequestObject
+
+	request.BookUid = bookUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBook(ctx.Request().Context(), request.(GetBookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBook")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBookResponseObject); ok {
+		return validResponse.VisitGetBookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteBookCover operation middleware
+func (sh *strictHandler) DeleteBookCover(ctx echo.Context, bookUid openapi_types.UUID) error {
+	var request DeleteBookCoverRequestObject
+
+	request.BookUid = bookUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBookCover(ctx.Request().Context(), request.(DeleteBookCoverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBookCover")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteBookCoverResponseObject); ok {
+		return validResponse.VisitDeleteBookCoverResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBookCover operation middleware
+func (sh *strictHandler) GetBookCover(ctx echo.Context, bookUid openapi_types.UUID, params GetBookCoverParams) error {
+	var request GetBookCoverRequestObject
+
+	request.BookUid = bookUid
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBookCover(ctx.Request().Context(), request.(GetBookCoverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBookCover")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBookCoverResponseObject); ok {
+		return validResponse.VisitGetBookCoverResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetBookCover operation middleware
+func (sh *strictHandler) SetBookCover(ctx echo.Context, bookUid openapi_types.UUID) error {
+	var request SetBookCoverRequestObject
+
+	request.BookUid = bookUid
+
+	request.Body = ctx.Request().Body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetBookCover(ctx.Request().Context(), request.(SetBookCoverRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetBookCover")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetBookCoverResponseObject); ok {
+		return validResponse.VisitSetBookCoverResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListCities operation middleware
+func (sh *strictHandler) ListCities(ctx echo.Context) error {
+	var request ListCitiesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCities(ctx.Request().Context(), request.(ListCitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListCitiesResponseObject); ok {
+		return validResponse.VisitListCitiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCopyConditionHistory operation middleware
+func (sh *strictHandler) GetCopyConditionHistory(ctx echo.Context, copyUid openapi_types.UUID) error {
+	var request GetCopyConditionHistoryRequestObject
+
+	request.CopyUid = copyUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCopyConditionHistory(ctx.Request().Context(), request.(GetCopyConditionHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCopyConditionHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCopyConditionHistoryResponseObject); ok {
+		return validResponse.VisitGetCopyConditionHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListHolds operation middleware
+func (sh *strictHandler) ListHolds(ctx echo.Context) error {
+	var request ListHoldsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListHolds(ctx.Request().Context(), request.(ListHoldsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListHolds")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListHoldsResponseObject); ok {
+		return validResponse.VisitListHoldsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// CancelHold operation middleware
+func (sh *strictHandler) CancelHold(ctx echo.Context, holdUid openapi_types.UUID) error {
+	var request CancelHoldRequestObject
+
+	request.HoldUid = holdUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelHold(ctx.Request().Context(), request.(CancelHoldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelHold")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CancelHoldResponseObject); ok {
+		return validResponse.VisitCancelHoldResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetHold operation middleware
+func (sh *strictHandler) GetHold(ctx echo.Context, holdUid openapi_types.UUID) error {
+	var request GetHoldRequestObject
+
+	request.HoldUid = holdUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHold(ctx.Request().Context(), request.(GetHoldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHold")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetHoldResponseObject); ok {
+		return validResponse.VisitGetHoldResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListLibraries operation middleware
+func (sh *strictHandler) ListLibraries(ctx echo.Context, params ListLibrariesParams) error {
+	var request ListLibrariesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListLibraries(ctx.Request().Context(), request.(ListLibrariesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListLibraries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListLibrariesResponseObject); ok {
+		return validResponse.VisitListLibrariesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListNearbyLibraries operation middleware
+func (sh *strictHandler) ListNearbyLibraries(ctx echo.Context, params ListNearbyLibrariesParams) error {
+	var request ListNearbyLibrariesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListNearbyLibraries(ctx.Request().Context(), request.(ListNearbyLibrariesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListNearbyLibraries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListNearbyLibrariesResponseObject); ok {
+		return validResponse.VisitListNearbyLibrariesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetLibrary operation middleware
+func (sh *strictHandler) GetLibrary(ctx echo.Context, libraryUid openapi_types.UUID) error {
+	var request GetLibraryRequestObject
+
+	request.LibraryUid = libraryUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLibrary(ctx.Request().Context(), request.(GetLibraryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLibrary")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetLibraryResponseObject); ok {
+		return validResponse.VisitGetLibraryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListBooks operation middleware
+func (sh *strictHandler) ListBooks(ctx echo.Context, libraryUid openapi_types.UUID, params ListBooksParams) error {
+	var request ListBooksRequestObject
+
+	request.LibraryUid = libraryUid
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListBooks(ctx.Request().Context(), request.(ListBooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListBooks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListBooksResponseObject); ok {
+		return validResponse.VisitListBooksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// TakeBook operation middleware
+func (sh *strictHandler) TakeBook(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params TakeBookParams) error {
+	var request TakeBookRequestObject
+
+	request.LibraryUid = libraryUid
+	request.BookUid = bookUid
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TakeBook(ctx.Request().Context(), request.(TakeBookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TakeBook")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(TakeBookResponseObject); ok {
+		return validResponse.VisitTakeBookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// BookCopyForPickup operation middleware
+func (sh *strictHandler) BookCopyForPickup(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params BookCopyForPickupParams) error {
+	var request BookCopyForPickupRequestObject
+
+	request.LibraryUid = libraryUid
+	request.BookUid = bookUid
+	request.Params = params
+
+	var body BookCopyForPickupJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BookCopyForPickup(ctx.Request().Context(), request.(BookCopyForPickupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BookCopyForPickup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(BookCopyForPickupResponseObject); ok {
+		return validResponse.VisitBookCopyForPickupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListBookCopies operation middleware
+func (sh *strictHandler) ListBookCopies(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error {
+	var request ListBookCopiesRequestObject
+
+	request.LibraryUid = libraryUid
+	request.BookUid = bookUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListBookCopies(ctx.Request().Context(), request.(ListBookCopiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListBookCopies")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListBookCopiesResponseObject); ok {
+		return validResponse.VisitListBookCopiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetHoldQueue operation middleware
+func (sh *strictHandler) GetHoldQueue(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error {
+	var request GetHoldQueueRequestObject
+
+	request.LibraryUid = libraryUid
+	request.BookUid = bookUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHoldQueue(ctx.Request().Context(), request.(GetHoldQueueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHoldQueue")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetHoldQueueResponseObject); ok {
+		return validResponse.VisitGetHoldQueueResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PlaceHold operation middleware
+func (sh *strictHandler) PlaceHold(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error {
+	var request PlaceHoldRequestObject
+
+	request.LibraryUid = libraryUid
+	request.BookUid = bookUid
+
+	var body PlaceHoldJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PlaceHold(ctx.Request().Context(), request.(PlaceHoldRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PlaceHold")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PlaceHoldResponseObject); ok {
+		return validResponse.VisitPlaceHoldResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReleaseBook operation middleware
+func (sh *strictHandler) ReleaseBook(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params ReleaseBookParams) error {
+	var request ReleaseBookRequestObject
+
+	request.LibraryUid = libraryUid
+	request.BookUid = bookUid
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReleaseBook(ctx.Request().Context(), request.(ReleaseBookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReleaseBook")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReleaseBookResponseObject); ok {
+		return validResponse.VisitReleaseBookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ReturnBook operation middleware
+func (sh *strictHandler) ReturnBook(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params ReturnBookParams) error {
+	var request ReturnBookRequestObject
+
+	request.LibraryUid = libraryUid
+	request.BookUid = bookUid
+	request.Params = params
+
+	var body ReturnBookJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ReturnBook(ctx.Request().Context(), request.(ReturnBookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReturnBook")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ReturnBookResponseObject); ok {
+		return validResponse.VisitReturnBookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AdjustStock operation middleware
+func (sh *strictHandler) AdjustStock(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error {
+	var request AdjustStockRequestObject
+
+	request.LibraryUid = libraryUid
+	request.BookUid = bookUid
+
+	var body AdjustStockJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdjustStock(ctx.Request().Context(), request.(AdjustStockRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdjustStock")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AdjustStockResponseObject); ok {
+		return validResponse.VisitAdjustStockResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WriteOffBook operation middleware
+func (sh *strictHandler) WriteOffBook(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID, params WriteOffBookParams) error {
+	var request WriteOffBookRequestObject
+
+	request.LibraryUid = libraryUid
+	request.BookUid = bookUid
+	request.Params = params
+
+	var body WriteOffBookJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WriteOffBook(ctx.Request().Context(), request.(WriteOffBookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WriteOffBook")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WriteOffBookResponseObject); ok {
+		return validResponse.VisitWriteOffBookResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetInventoryReport operation middleware
+func (sh *strictHandler) GetInventoryReport(ctx echo.Context, libraryUid openapi_types.UUID, params GetInventoryReportParams) error {
+	var request GetInventoryReportRequestObject
+
+	request.LibraryUid = libraryUid
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInventoryReport(ctx.Request().Context(), request.(GetInventoryReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInventoryReport")
+	}
+
+	response, err := handler(ctx, request)
 
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+		return err
+	} else if validResponse, ok := response.(GetInventoryReportResponseObject); ok {
+		return validResponse.VisitGetInventoryReportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	// ------------- Optional query parameter "size" -------------
+// GetLibrarySchedule operation middleware
+func (sh *strictHandler) GetLibrarySchedule(ctx echo.Context, libraryUid openapi_types.UUID) error {
+	var request GetLibraryScheduleRequestObject
 
-	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	request.LibraryUid = libraryUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLibrarySchedule(ctx.Request().Context(), request.(GetLibraryScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLibrarySchedule")
 	}
 
-	// ------------- Required query parameter "city" -------------
+	response, err := handler(ctx, request)
 
-	err = runtime.BindQueryParameter("form", true, true, "city", ctx.QueryParams(), &params.City)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter city: %s", err))
+		return err
+	} else if validResponse, ok := response.(GetLibraryScheduleResponseObject); ok {
+		return validResponse.VisitGetLibraryScheduleResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListLibraries(ctx, params)
-	return err
+	return nil
 }
 
-// GetLibrary converts echo context to params.
-func (w *ServerInterfaceWrapper) GetLibrary(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "libraryUid" -------------
-	var libraryUid openapi_types.UUID
+// CheckDueDate operation middleware
+func (sh *strictHandler) CheckDueDate(ctx echo.Context, libraryUid openapi_types.UUID, params CheckDueDateParams) error {
+	var request CheckDueDateRequestObject
 
-	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	request.LibraryUid = libraryUid
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckDueDate(ctx.Request().Context(), request.(CheckDueDateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckDueDate")
 	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetLibrary(ctx, libraryUid)
-	return err
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CheckDueDateResponseObject); ok {
+		return validResponse.VisitCheckDueDateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// ListBooks converts echo context to params.
-func (w *ServerInterfaceWrapper) ListBooks(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "libraryUid" -------------
-	var libraryUid openapi_types.UUID
+// AddHoliday operation middleware
+func (sh *strictHandler) AddHoliday(ctx echo.Context, libraryUid openapi_types.UUID) error {
+	var request AddHolidayRequestObject
 
-	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	request.LibraryUid = libraryUid
+
+	var body AddHolidayJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
+	request.Body = &body
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListBooksParams
-	// ------------- Optional query parameter "page" -------------
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AddHoliday(ctx.Request().Context(), request.(AddHolidayRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AddHoliday")
+	}
+
+	response, err := handler(ctx, request)
 
-	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+		return err
+	} else if validResponse, ok := response.(AddHolidayResponseObject); ok {
+		return validResponse.VisitAddHolidayResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
+	return nil
+}
 
-	// ------------- Optional query parameter "size" -------------
+// DeleteHoliday operation middleware
+func (sh *strictHandler) DeleteHoliday(ctx echo.Context, libraryUid openapi_types.UUID, date string) error {
+	var request DeleteHolidayRequestObject
 
-	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	request.LibraryUid = libraryUid
+	request.Date = date
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteHoliday(ctx.Request().Context(), request.(DeleteHolidayRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteHoliday")
 	}
 
-	// ------------- Optional query parameter "showAll" -------------
+	response, err := handler(ctx, request)
 
-	err = runtime.BindQueryParameter("form", true, false, "showAll", ctx.QueryParams(), &params.ShowAll)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter showAll: %s", err))
+		return err
+	} else if validResponse, ok := response.(DeleteHolidayResponseObject); ok {
+		return validResponse.VisitDeleteHolidayResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ListBooks(ctx, libraryUid, params)
-	return err
+	return nil
 }
 
-// TakeBook converts echo context to params.
-func (w *ServerInterfaceWrapper) TakeBook(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "libraryUid" -------------
-	var libraryUid openapi_types.UUID
+// SetOpeningHours operation middleware
+func (sh *strictHandler) SetOpeningHours(ctx echo.Context, libraryUid openapi_types.UUID) error {
+	var request SetOpeningHoursRequestObject
 
-	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	request.LibraryUid = libraryUid
+
+	var body SetOpeningHoursJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
 	}
+	request.Body = &body
 
-	// ------------- Path parameter "bookUid" -------------
-	var bookUid openapi_types.UUID
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetOpeningHours(ctx.Request().Context(), request.(SetOpeningHoursRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetOpeningHours")
+	}
+
+	response, err := handler(ctx, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+		return err
+	} else if validResponse, ok := response.(SetOpeningHoursResponseObject); ok {
+		return validResponse.VisitSetOpeningHoursResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.TakeBook(ctx, libraryUid, bookUid)
-	return err
+	return nil
 }
 
-// ReturnBook converts echo context to params.
-func (w *ServerInterfaceWrapper) ReturnBook(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "libraryUid" -------------
-	var libraryUid openapi_types.UUID
+// GetLibraryStatistics operation middleware
+func (sh *strictHandler) GetLibraryStatistics(ctx echo.Context, libraryUid openapi_types.UUID, params GetLibraryStatisticsParams) error {
+	var request GetLibraryStatisticsRequestObject
 
-	err = runtime.BindStyledParameterWithOptions("simple", "libraryUid", ctx.Param("libraryUid"), &libraryUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter libraryUid: %s", err))
+	request.LibraryUid = libraryUid
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLibraryStatistics(ctx.Request().Context(), request.(GetLibraryStatisticsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLibraryStatistics")
 	}
 
-	// ------------- Path parameter "bookUid" -------------
-	var bookUid openapi_types.UUID
+	response, err := handler(ctx, request)
 
-	err = runtime.BindStyledParameterWithOptions("simple", "bookUid", ctx.Param("bookUid"), &bookUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bookUid: %s", err))
+		return err
+	} else if validResponse, ok := response.(GetLibraryStatisticsResponseObject); ok {
+		return validResponse.VisitGetLibraryStatisticsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ReturnBook(ctx, libraryUid, bookUid)
-	return err
+	return nil
 }
 
-// Health converts echo context to params.
-func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
-	var err error
+// ListStockMovements operation middleware
+func (sh *strictHandler) ListStockMovements(ctx echo.Context, libraryUid openapi_types.UUID, params ListStockMovementsParams) error {
+	var request ListStockMovementsRequestObject
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.Health(ctx)
-	return err
-}
+	request.LibraryUid = libraryUid
+	request.Params = params
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListStockMovements(ctx.Request().Context(), request.(ListStockMovementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListStockMovements")
+	}
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListStockMovementsResponseObject); ok {
+		return validResponse.VisitListStockMovementsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// CheckStockConsistency operation middleware
+func (sh *strictHandler) CheckStockConsistency(ctx echo.Context, libraryUid openapi_types.UUID) error {
+	var request CheckStockConsistencyRequestObject
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	request.LibraryUid = libraryUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CheckStockConsistency(ctx.Request().Context(), request.(CheckStockConsistencyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CheckStockConsistency")
 	}
 
-	router.GET(baseURL+"/api/v1/books/:bookUid", wrapper.GetBook)
-	router.GET(baseURL+"/api/v1/libraries", wrapper.ListLibraries)
-	router.GET(baseURL+"/api/v1/libraries/:libraryUid", wrapper.GetLibrary)
-	router.GET(baseURL+"/api/v1/libraries/:libraryUid/books", wrapper.ListBooks)
-	router.POST(baseURL+"/api/v1/libraries/:libraryUid/books/:bookUid", wrapper.TakeBook)
-	router.POST(baseURL+"/api/v1/libraries/:libraryUid/books/:bookUid/return", wrapper.ReturnBook)
-	router.GET(baseURL+"/manage/health", wrapper.Health)
+	response, err := handler(ctx, request)
 
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CheckStockConsistencyResponseObject); ok {
+		return validResponse.VisitCheckStockConsistencyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetBookRequestObject struct {
-	BookUid openapi_types.UUID `json:"bookUid"`
-}
+// ListLibraryTransfers operation middleware
+func (sh *strictHandler) ListLibraryTransfers(ctx echo.Context, libraryUid openapi_types.UUID, params ListLibraryTransfersParams) error {
+	var request ListLibraryTransfersRequestObject
 
-type GetBookResponseObject interface {
-	VisitGetBookResponse(w http.ResponseWriter) error
-}
+	request.LibraryUid = libraryUid
+	request.Params = params
 
-type GetBook200JSONResponse BookInfo
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListLibraryTransfers(ctx.Request().Context(), request.(ListLibraryTransfersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListLibraryTransfers")
+	}
 
-func (response GetBook200JSONResponse) VisitGetBookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListLibraryTransfersResponseObject); ok {
+		return validResponse.VisitListLibraryTransfersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type ListLibrariesRequestObject struct {
-	Params ListLibrariesParams
-}
+// CreateSeries operation middleware
+func (sh *strictHandler) CreateSeries(ctx echo.Context) error {
+	var request CreateSeriesRequestObject
 
-type ListLibrariesResponseObject interface {
-	VisitListLibrariesResponse(w http.ResponseWriter) error
-}
+	var body CreateSeriesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type ListLibraries200JSONResponse LibraryPaginationResponse
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSeries(ctx.Request().Context(), request.(CreateSeriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSeries")
+	}
 
-func (response ListLibraries200JSONResponse) VisitListLibrariesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateSeriesResponseObject); ok {
+		return validResponse.VisitCreateSeriesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type GetLibraryRequestObject struct {
-	LibraryUid openapi_types.UUID `json:"libraryUid"`
-}
+// GetSeries operation middleware
+func (sh *strictHandler) GetSeries(ctx echo.Context, seriesUid openapi_types.UUID, params GetSeriesParams) error {
+	var request GetSeriesRequestObject
 
-type GetLibraryResponseObject interface {
-	VisitGetLibraryResponse(w http.ResponseWriter) error
-}
+	request.SeriesUid = seriesUid
+	request.Params = params
 
-type GetLibrary200JSONResponse LibraryResponse
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSeries(ctx.Request().Context(), request.(GetSeriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSeries")
+	}
 
-func (response GetLibrary200JSONResponse) VisitGetLibraryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSeriesResponseObject); ok {
+		return validResponse.VisitGetSeriesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type ListBooksRequestObject struct {
-	LibraryUid openapi_types.UUID `json:"libraryUid"`
-	Params     ListBooksParams
-}
+// RemoveSeriesVolume operation middleware
+func (sh *strictHandler) RemoveSeriesVolume(ctx echo.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID) error {
+	var request RemoveSeriesVolumeRequestObject
 
-type ListBooksResponseObject interface {
-	VisitListBooksResponse(w http.ResponseWriter) error
-}
+	request.SeriesUid = seriesUid
+	request.BookUid = bookUid
 
-type ListBooks200JSONResponse LibraryBookPaginationResponse
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveSeriesVolume(ctx.Request().Context(), request.(RemoveSeriesVolumeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveSeriesVolume")
+	}
 
-func (response ListBooks200JSONResponse) VisitListBooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RemoveSeriesVolumeResponseObject); ok {
+		return validResponse.VisitRemoveSeriesVolumeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type TakeBookRequestObject struct {
-	LibraryUid openapi_types.UUID `json:"libraryUid"`
-	BookUid    openapi_types.UUID `json:"bookUid"`
-}
+// SetSeriesVolume operation middleware
+func (sh *strictHandler) SetSeriesVolume(ctx echo.Context, seriesUid openapi_types.UUID, bookUid openapi_types.UUID) error {
+	var request SetSeriesVolumeRequestObject
 
-type TakeBookResponseObject interface {
-	VisitTakeBookResponse(w http.ResponseWriter) error
-}
+	request.SeriesUid = seriesUid
+	request.BookUid = bookUid
 
-type TakeBook204Response struct {
-}
+	var body SetSeriesVolumeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-func (response TakeBook204Response) VisitTakeBookResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetSeriesVolume(ctx.Request().Context(), request.(SetSeriesVolumeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetSeriesVolume")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetSeriesVolumeResponseObject); ok {
+		return validResponse.VisitSetSeriesVolumeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-type TakeBook400JSONResponse ValidationErrorResponse
+// ListPopularBooks operation middleware
+func (sh *strictHandler) ListPopularBooks(ctx echo.Context, params ListPopularBooksParams) error {
+	var request ListPopularBooksRequestObject
 
-func (response TakeBook400JSONResponse) VisitTakeBookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPopularBooks(ctx.Request().Context(), request.(ListPopularBooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPopularBooks")
+	}
 
-type ReturnBookRequestObject struct {
-	LibraryUid openapi_types.UUID `json:"libraryUid"`
-	BookUid    openapi_types.UUID `json:"bookUid"`
-	Body       *ReturnBookJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type ReturnBookResponseObject interface {
-	VisitReturnBookResponse(w http.ResponseWriter) error
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListPopularBooksResponseObject); ok {
+		return validResponse.VisitListPopularBooksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type ReturnBook200JSONResponse ViolationStatus
-
-func (response ReturnBook200JSONResponse) VisitReturnBookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListSuggestions operation middleware
+func (sh *strictHandler) ListSuggestions(ctx echo.Context, params ListSuggestionsParams) error {
+	var request ListSuggestionsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type ReturnBook400JSONResponse ValidationErrorResponse
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSuggestions(ctx.Request().Context(), request.(ListSuggestionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSuggestions")
+	}
 
-func (response ReturnBook400JSONResponse) VisitReturnBookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListSuggestionsResponseObject); ok {
+		return validResponse.VisitListSuggestionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type HealthRequestObject struct {
-}
+// CreateTransfer operation middleware
+func (sh *strictHandler) CreateTransfer(ctx echo.Context) error {
+	var request CreateTransferRequestObject
 
-type HealthResponseObject interface {
-	VisitHealthResponse(w http.ResponseWriter) error
-}
+	var body CreateTransferJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
-type Health200Response struct {
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateTransfer(ctx.Request().Context(), request.(CreateTransferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateTransfer")
+	}
 
-func (response Health200Response) VisitHealthResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(CreateTransferResponseObject); ok {
+		return validResponse.VisitCreateTransferResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
 	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Получить информацию о книге
-	// (GET /api/v1/books/{bookUid})
-	GetBook(ctx context.Context, request GetBookRequestObject) (GetBookResponseObject, error)
-	// Получить список библиотек в городе
-	// (GET /api/v1/libraries)
-	ListLibraries(ctx context.Context, request ListLibrariesRequestObject) (ListLibrariesResponseObject, error)
-	// Получить информацию о библиотеке
-	// (GET /api/v1/libraries/{libraryUid})
-	GetLibrary(ctx context.Context, request GetLibraryRequestObject) (GetLibraryResponseObject, error)
-	// Получить список книг в выбранной библиотеке
-	// (GET /api/v1/libraries/{libraryUid}/books)
-	ListBooks(ctx context.Context, request ListBooksRequestObject) (ListBooksResponseObject, error)
-	// Взять книгу в библиотеке
-	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid})
-	TakeBook(ctx context.Context, request TakeBookRequestObject) (TakeBookResponseObject, error)
-	// Вернуть книгу в библиотеку
-	// (POST /api/v1/libraries/{libraryUid}/books/{bookUid}/return)
-	ReturnBook(ctx context.Context, request ReturnBookRequestObject) (ReturnBookResponseObject, error)
-	// Проверка живости сервиса
-	// (GET /manage/health)
-	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
-}
+// GetTransfer operation middleware
+func (sh *strictHandler) GetTransfer(ctx echo.Context, transferUid openapi_types.UUID) error {
+	var request GetTransferRequestObject
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	request.TransferUid = transferUid
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTransfer(ctx.Request().Context(), request.(GetTransferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTransfer")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTransferResponseObject); ok {
+		return validResponse.VisitGetTransferResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// GetBook operation middleware
-func (sh *strictHandler) GetBook(ctx echo.Context, bookUid openapi_types.UUID) error {
-	var request GetBookRequestObject
+// CancelTransfer operation middleware
+func (sh *strictHandler) CancelTransfer(ctx echo.Context, transferUid openapi_types.UUID) error {
+	var request CancelTransferRequestObject
 
-	request.BookUid = bookUid
+	request.TransferUid = transferUid
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetBook(ctx.Request().Context(), request.(GetBookRequestObject))
+		return sh.ssi.CancelTransfer(ctx.Request().Context(), request.(CancelTransferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetBook")
+		handler = middleware(handler, "CancelTransfer")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetBookResponseObject); ok {
-		return validResponse.VisitGetBookResponse(ctx.Response())
+	} else if validResponse, ok := response.(CancelTransferResponseObject); ok {
+		return validResponse.VisitCancelTransferResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ListLibraries operation middleware
-func (sh *strictHandler) ListLibraries(ctx echo.Context, params ListLibrariesParams) error {
-	var request ListLibrariesRequestObject
+// DispatchTransfer operation middleware
+func (sh *strictHandler) DispatchTransfer(ctx echo.Context, transferUid openapi_types.UUID) error {
+	var request DispatchTransferRequestObject
 
-	request.Params = params
+	request.TransferUid = transferUid
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListLibraries(ctx.Request().Context(), request.(ListLibrariesRequestObject))
+		return sh.ssi.DispatchTransfer(ctx.Request().Context(), request.(DispatchTransferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListLibraries")
+		handler = middleware(handler, "DispatchTransfer")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ListLibrariesResponseObject); ok {
-		return validResponse.VisitListLibrariesResponse(ctx.Response())
+	} else if validResponse, ok := response.(DispatchTransferResponseObject); ok {
+		return validResponse.VisitDispatchTransferResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetLibrary operation middleware
-func (sh *strictHandler) GetLibrary(ctx echo.Context, libraryUid openapi_types.UUID) error {
-	var request GetLibraryRequestObject
+// ReceiveTransfer operation middleware
+func (sh *strictHandler) ReceiveTransfer(ctx echo.Context, transferUid openapi_types.UUID) error {
+	var request ReceiveTransferRequestObject
 
-	request.LibraryUid = libraryUid
+	request.TransferUid = transferUid
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLibrary(ctx.Request().Context(), request.(GetLibraryRequestObject))
+		return sh.ssi.ReceiveTransfer(ctx.Request().Context(), request.(ReceiveTransferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLibrary")
+		handler = middleware(handler, "ReceiveTransfer")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetLibraryResponseObject); ok {
-		return validResponse.VisitGetLibraryResponse(ctx.Response())
+	} else if validResponse, ok := response.(ReceiveTransferResponseObject); ok {
+		return validResponse.VisitReceiveTransferResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ListBooks operation middleware
-func (sh *strictHandler) ListBooks(ctx echo.Context, libraryUid openapi_types.UUID, params ListBooksParams) error {
-	var request ListBooksRequestObject
+// GetWork operation middleware
+func (sh *strictHandler) GetWork(ctx echo.Context, workUid openapi_types.UUID) error {
+	var request GetWorkRequestObject
 
-	request.LibraryUid = libraryUid
-	request.Params = params
+	request.WorkUid = workUid
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListBooks(ctx.Request().Context(), request.(ListBooksRequestObject))
+		return sh.ssi.GetWork(ctx.Request().Context(), request.(GetWorkRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListBooks")
+		handler = middleware(handler, "GetWork")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ListBooksResponseObject); ok {
-		return validResponse.VisitListBooksResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetWorkResponseObject); ok {
+		return validResponse.VisitGetWorkResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// TakeBook operation middleware
-func (sh *strictHandler) TakeBook(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error {
-	var request TakeBookRequestObject
+// RemoveWorkEdition operation middleware
+func (sh *strictHandler) RemoveWorkEdition(ctx echo.Context, workUid openapi_types.UUID, bookUid openapi_types.UUID) error {
+	var request RemoveWorkEditionRequestObject
 
-	request.LibraryUid = libraryUid
+	request.WorkUid = workUid
 	request.BookUid = bookUid
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.TakeBook(ctx.Request().Context(), request.(TakeBookRequestObject))
+		return sh.ssi.RemoveWorkEdition(ctx.Request().Context(), request.(RemoveWorkEditionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "TakeBook")
+		handler = middleware(handler, "RemoveWorkEdition")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(TakeBookResponseObject); ok {
-		return validResponse.VisitTakeBookResponse(ctx.Response())
+	} else if validResponse, ok := response.(RemoveWorkEditionResponseObject); ok {
+		return validResponse.VisitRemoveWorkEditionResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// ReturnBook operation middleware
-func (sh *strictHandler) ReturnBook(ctx echo.Context, libraryUid openapi_types.UUID, bookUid openapi_types.UUID) error {
-	var request ReturnBookRequestObject
+// AddWorkEdition operation middleware
+func (sh *strictHandler) AddWorkEdition(ctx echo.Context, workUid openapi_types.UUID, bookUid openapi_types.UUID) error {
+	var request AddWorkEditionRequestObject
 
-	request.LibraryUid = libraryUid
+	request.WorkUid = workUid
 	request.BookUid = bookUid
 
-	var body ReturnBookJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
-
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ReturnBook(ctx.Request().Context(), request.(ReturnBookRequestObject))
+		return sh.ssi.AddWorkEdition(ctx.Request().Context(), request.(AddWorkEditionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ReturnBook")
+		handler = middleware(handler, "AddWorkEdition")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(ReturnBookResponseObject); ok {
-		return validResponse.VisitReturnBookResponse(ctx.Response())
+	} else if validResponse, ok := response.(AddWorkEditionResponseObject); ok {
+		return validResponse.VisitAddWorkEditionResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -0,0 +1,336 @@
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/muhomorfus/ds-lab-02/services/auth/contextutils"
+	"github.com/muhomorfus/ds-lab-02/services/library/internal/generated"
+	"github.com/samber/lo"
+	"log/slog"
+	"time"
+)
+
+// setAsideBookedCopy sets an available copy aside for the booking and takes
+// it out of the available stock. It returns nil if there is no available copy.
+func setAsideBookedCopy(ctx context.Context, tx *sqlx.Tx, b *copyBooking, actor string) (*bookCopyInfo, error) {
+	query := `select c.*, b.book_uid, l.library_uid from
+		book_copies c
+		join books b on b.id = c.book_id
+		join library l on l.id = c.library_id
+		where c.library_id = $1 and c.book_id = $2 and c.status = 'AVAILABLE'
+		order by ` + copyConditionOrder + `, c.id
+		limit 1
+		for update of c skip locked`
+
+	var copies []bookCopyInfo
+	if err := tx.SelectContext(ctx, &copies, query, b.LibraryID, b.BookID); err != nil {
+		return nil, fmt.Errorf("select available copy: %w", err)
+	}
+
+	if len(copies) == 0 {
+		return nil, nil
+	}
+
+	booked := copies[0]
+	booked.Status = copyBooked
+	booked.ReservationUID = &b.ReservationUID
+
+	query = `update book_copies set status = $2, reservation_uid = $3 where id = $1`
+	if _, err := tx.ExecContext(ctx, query, booked.ID, booked.Status, booked.ReservationUID); err != nil {
+		return nil, fmt.Errorf("update book copy: %w", err)
+	}
+
+	query = `update copy_bookings set copy_id = $2 where id = $1`
+	if _, err := tx.ExecContext(ctx, query, b.ID, booked.ID); err != nil {
+		return nil, fmt.Errorf("update booking: %w", err)
+	}
+
+	if _, err := recordMovement(ctx, tx, stockMovement{
+		LibraryID:      b.LibraryID,
+		BookID:         b.BookID,
+		CopyID:         &booked.ID,
+		Actor:          actor,
+		Reason:         movementBooking,
+		Delta:          -1,
+		CorrelationUID: &b.ReservationUID,
+	}); err != nil {
+		return nil, fmt.Errorf("record stock movement: %w", err)
+	}
+
+	b.CopyID = &booked.ID
+
+	return &booked, nil
+}
+
+// takeBookedCopy rents the copy booked for the reservation. The copy has
+// already left the available stock when it was set aside, so no stock
+// movement is recorded. If the background job has not set the copy aside yet,
+// it is done here.
+func (s *Server) takeBookedCopy(ctx context.Context, tx *sqlx.Tx, b copyBooking) (generated.TakeBookResponseObject, error) {
+	logger := slog.With("handler", "TakeBook", "reservation_uid", b.ReservationUID)
+
+	if time.Now().Before(b.PickupDate) {
+		return generated.TakeBook409JSONResponse{
+			Message: "booked copy can not be picked up before " + b.PickupDate.Format(time.DateOnly),
+		}, nil
+	}
+
+	if b.CopyID == nil {
+		booked, err := setAsideBookedCopy(ctx, tx, &b, contextutils.GetUser(ctx))
+		if err != nil {
+			logger.Error("set aside booked copy", "error", err)
+			return nil, fmt.Errorf("set aside booked copy: %w", err)
+		}
+
+		if booked == nil {
+			return generated.TakeBook409JSONResponse{
+				Message: "there is 0 available books in library for the booking",
+			}, nil
+		}
+	}
+
+	taken, err := rentSetAsideCopy(ctx, tx, *b.CopyID, copyBooked, &b.ReservationUID)
+	if err != nil {
+		logger.Error("rent booked copy", "error", err)
+		return nil, fmt.Errorf("rent booked copy: %w", err)
+	}
+
+	query := `update copy_bookings set status = $2, closed_at = now() where id = $1`
+	if _, err := tx.ExecContext(ctx, query, b.ID, bookingPickedUp); err != nil {
+		logger.Error("update booking in db", "error", err)
+		return nil, fmt.Errorf("update booking in db: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.TakeBook200JSONResponse(toBookCopyResponse(taken)), nil
+}
+
+// lockBooking selects the booking of the reservation in the status for update.
+func lockBooking(ctx context.Context, tx *sqlx.Tx, reservationUID uuid.UUID, status string) ([]copyBooking, error) {
+	query := `select * from copy_bookings where reservation_uid = $1 and status = $2 for update`
+
+	var bookings []copyBooking
+	if err := tx.SelectContext(ctx, &bookings, query, reservationUID, status); err != nil {
+		return nil, err
+	}
+
+	return bookings, nil
+}
+
+// closeBooking moves the booking to the final status. A copy set aside for it
+// is made available and goes to the next hold in the queue if there is one.
+func (s *Server) closeBooking(ctx context.Context, tx *sqlx.Tx, b copyBooking, status, actor string) error {
+	query := `update copy_bookings set status = $2, closed_at = now() where id = $1`
+	if _, err := tx.ExecContext(ctx, query, b.ID, status); err != nil {
+		return fmt.Errorf("update booking: %w", err)
+	}
+
+	if b.CopyID == nil {
+		return nil
+	}
+
+	query = `update book_copies set status = $2, reservation_uid = null where id = $1 and status = $3`
+	if _, err := tx.ExecContext(ctx, query, *b.CopyID, copyAvailable, copyBooked); err != nil {
+		return fmt.Errorf("update book copy: %w", err)
+	}
+
+	if _, err := recordMovement(ctx, tx, stockMovement{
+		LibraryID:      b.LibraryID,
+		BookID:         b.BookID,
+		CopyID:         b.CopyID,
+		Actor:          actor,
+		Reason:         movementBooking,
+		Delta:          1,
+		CorrelationUID: &b.ReservationUID,
+	}); err != nil {
+		return fmt.Errorf("record stock movement: %w", err)
+	}
+
+	return s.promoteHolds(ctx, tx, b.LibraryID, b.BookID)
+}
+
+// releaseBookedCopy cancels the booking of the cancelled reservation.
+func (s *Server) releaseBookedCopy(ctx context.Context, tx *sqlx.Tx, b copyBooking) (generated.ReleaseBookResponseObject, error) {
+	logger := slog.With("handler", "ReleaseBook", "reservation_uid", b.ReservationUID)
+
+	if err := s.closeBooking(ctx, tx, b, bookingCancelled, contextutils.GetUser(ctx)); err != nil {
+		logger.Error("close booking", "error", err)
+		return nil, fmt.Errorf("close booking: %w", err)
+	}
+
+	if b.CopyID == nil {
+		if err := tx.Commit(); err != nil {
+			logger.Error("commit transaction", "error", err)
+			return nil, fmt.Errorf("commit transaction: %w", err)
+		}
+
+		return generated.ReleaseBook204Response{}, nil
+	}
+
+	query := `select c.*, b.book_uid, l.library_uid from
+		book_copies c
+		join books b on b.id = c.book_id
+		join library l on l.id = c.library_id
+		where c.id = $1`
+
+	var released bookCopyInfo
+	if err := tx.GetContext(ctx, &released, query, *b.CopyID); err != nil {
+		logger.Error("select book copy from db", "error", err)
+		return nil, fmt.Errorf("select book copy from db: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.ReleaseBook200JSONResponse(toBookCopyResponse(released)), nil
+}
+
+// rebookCopy undoes the pickup of the booking, which was not completed by
+// reservation service: the rented copy is set aside for the reservation again
+// and the booking waits for the next pickup.
+func (s *Server) rebookCopy(ctx context.Context, tx *sqlx.Tx, b copyBooking) (generated.ReleaseBookResponseObject, error) {
+	logger := slog.With("handler", "ReleaseBook", "reservation_uid", b.ReservationUID)
+
+	query := `select c.*, b.book_uid, l.library_uid from
+		book_copies c
+		join books b on b.id = c.book_id
+		join library l on l.id = c.library_id
+		where c.id = $1 and c.status = 'RENTED' and c.reservation_uid = $2
+		for update of c`
+
+	var copies []bookCopyInfo
+	if err := tx.SelectContext(ctx, &copies, query, lo.FromPtr(b.CopyID), b.ReservationUID); err != nil {
+		logger.Error("select book copy from db", "error", err)
+		return nil, fmt.Errorf("select book copy from db: %w", err)
+	}
+
+	if len(copies) == 0 {
+		return generated.ReleaseBook404JSONResponse{
+			Message: "copy rented by reservation not found",
+		}, nil
+	}
+
+	rebooked := copies[0]
+	rebooked.Status = copyBooked
+	rebooked.RentedAt = nil
+
+	query = `update book_copies set status = $2, rented_at = null where id = $1`
+	if _, err := tx.ExecContext(ctx, query, rebooked.ID, rebooked.Status); err != nil {
+		logger.Error("update book copy in db", "error", err)
+		return nil, fmt.Errorf("update book copy in db: %w", err)
+	}
+
+	query = `update copy_bookings set status = $2, closed_at = null where id = $1`
+	if _, err := tx.ExecContext(ctx, query, b.ID, bookingBooked); err != nil {
+		logger.Error("update booking in db", "error", err)
+		return nil, fmt.Errorf("update booking in db: %w", err)
+	}
+
+	if err := countCirculation(ctx, tx, circulation{
+		LibraryID: rebooked.LibraryID,
+		BookID:    rebooked.BookID,
+		Checkouts: -1,
+	}); err != nil {
+		logger.Error("count circulation", "error", err)
+		return nil, fmt.Errorf("count circulation: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.ReleaseBook200JSONResponse(toBookCopyResponse(rebooked)), nil
+}
+
+// setAsideBookings sets copies aside for bookings, whose pickup date has come
+// in the time zone of the library. Bookings, for which there is no available
+// copy yet, are retried on the next run.
+func (s *Server) setAsideBookings(ctx context.Context) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select cb.* from copy_bookings cb
+		join library l on l.id = cb.library_id
+		where cb.status = 'BOOKED' and cb.copy_id is null and cb.pickup_date <= (now() at time zone l.timezone)::date
+		order by cb.pickup_date, cb.id
+		for update of cb skip locked`
+
+	var due []copyBooking
+	if err := tx.SelectContext(ctx, &due, query); err != nil {
+		return fmt.Errorf("select due bookings: %w", err)
+	}
+
+	var setAside int
+	for i := range due {
+		booked, err := setAsideBookedCopy(ctx, tx, &due[i], systemActor)
+		if err != nil {
+			return fmt.Errorf("set aside copy for booking %s: %w", due[i].ReservationUID, err)
+		}
+
+		if booked != nil {
+			setAside++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	if setAside > 0 {
+		slog.Info("copies set aside for bookings", "count", setAside, "waiting", len(due)-setAside)
+	}
+
+	return nil
+}
+
+func (s *Server) expireBookings(ctx context.Context) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err := tx.GetContext(ctx, &locked, `select pg_try_advisory_xact_lock(hashtext('library_booking_expiry'))`); err != nil {
+		return fmt.Errorf("take advisory lock: %w", err)
+	}
+
+	if !locked {
+		return nil
+	}
+
+	query := `select * from copy_bookings where status = 'BOOKED' and expires_at < now() order by expires_at for update skip locked`
+
+	var expired []copyBooking
+	if err := tx.SelectContext(ctx, &expired, query); err != nil {
+		return fmt.Errorf("select expired bookings: %w", err)
+	}
+
+	for _, b := range expired {
+		if err := s.closeBooking(ctx, tx, b, bookingExpired, systemActor); err != nil {
+			return fmt.Errorf("close booking %s: %w", b.ReservationUID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	if len(expired) > 0 {
+		slog.Info("bookings expired", "count", len(expired))
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package openapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+)
+
+const (
+	coverCacheControl = "private, max-age=86400"
+	thumbnailWidth    = 240
+	maxCoverPixels    = 40_000_000
+	thumbnailFormat   = "image/jpeg"
+)
+
+var (
+	errUnsupportedCover = errors.New("cover must be a jpeg or png image")
+	errCoverTooLarge    = errors.New("cover image is too large")
+)
+
+var coverContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+}
+
+type cover struct {
+	hash        string
+	contentType string
+	original    []byte
+	thumbnail   []byte
+}
+
+func newCover(data []byte) (cover, error) {
+	contentType := http.DetectContentType(data)
+	if !coverContentTypes[contentType] {
+		return cover{}, errUnsupportedCover
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return cover{}, errUnsupportedCover
+	}
+
+	if cfg.Width*cfg.Height > maxCoverPixels {
+		return cover{}, errCoverTooLarge
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return cover{}, errUnsupportedCover
+	}
+
+	var thumbnail bytes.Buffer
+	if err := jpeg.Encode(&thumbnail, scaleDown(img, thumbnailWidth), &jpeg.Options{Quality: 80}); err != nil {
+		return cover{}, fmt.Errorf("encode thumbnail: %w", err)
+	}
+
+	sum := sha256.Sum256(data)
+
+	return cover{
+		hash:        hex.EncodeToString(sum[:]),
+		contentType: contentType,
+		original:    data,
+		thumbnail:   thumbnail.Bytes(),
+	}, nil
+}
+
+// scaleDown resizes image to the given width keeping aspect ratio. Every
+// destination pixel is an average of the source pixels it covers.
+func scaleDown(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	if bounds.Dx() <= width {
+		width = bounds.Dx()
+	}
+
+	height := max(bounds.Dy()*width/bounds.Dx(), 1)
+
+	src := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(src, src.Bounds(), img, bounds.Min, draw.Src)
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0, y1 := y*src.Rect.Dy()/height, max((y+1)*src.Rect.Dy()/height, y*src.Rect.Dy()/height+1)
+
+		for x := 0; x < width; x++ {
+			x0, x1 := x*src.Rect.Dx()/width, max((x+1)*src.Rect.Dx()/width, x*src.Rect.Dx()/width+1)
+
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					i := src.PixOffset(sx, sy)
+					r += int(src.Pix[i])
+					g += int(src.Pix[i+1])
+					b += int(src.Pix[i+2])
+					a += int(src.Pix[i+3])
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+
+	return dst
+}
+
+func coverKey(bookUID uuid.UUID, hash string, thumbnail bool) string {
+	if thumbnail {
+		return fmt.Sprintf("covers/%s/%s-thumbnail", bookUID, hash)
+	}
+
+	return fmt.Sprintf("covers/%s/%s", bookUID, hash)
+}
+
+func coverETag(hash string, thumbnail bool) string {
+	if thumbnail {
+		return `"` + hash + `-thumbnail"`
+	}
+
+	return `"` + hash + `"`
+}
+
+func coverURLs(bookUID uuid.UUID, hash *string) (*string, *string) {
+	if hash == nil {
+		return nil, nil
+	}
+
+	version := (*hash)[:12]
+	original := fmt.Sprintf("/api/v1/books/%s/cover?v=%s", bookUID, version)
+	thumbnail := fmt.Sprintf("/api/v1/books/%s/cover?size=thumbnail&v=%s", bookUID, version)
+
+	return &original, &thumbnail
+}
@@ -0,0 +1,35 @@
+package openapi
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RunExpiry periodically expires holds and bookings, whose copies were not
+// picked up in time, and sets copies aside for bookings on their pickup date.
+// It is safe to run on every replica.
+func (s *Server) RunExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.expireHolds(ctx); err != nil {
+			slog.Error("expire holds", "error", err)
+		}
+
+		if err := s.setAsideBookings(ctx); err != nil {
+			slog.Error("set aside booked copies", "error", err)
+		}
+
+		if err := s.expireBookings(ctx); err != nil {
+			slog.Error("expire bookings", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
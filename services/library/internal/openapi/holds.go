@@ -0,0 +1,176 @@
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/muhomorfus/ds-lab-02/services/library/internal/generated"
+	"log/slog"
+	"time"
+)
+
+// HoldPolicy configures the hold queue. A copy set aside for a hold waits
+// for PickupWindow. Every star of user rating moves the hold ahead in the
+// queue as if it was placed RatingWeight earlier, zero weight keeps the queue
+// strictly FIFO.
+type HoldPolicy struct {
+	PickupWindow time.Duration
+	RatingWeight time.Duration
+}
+
+// promoteHolds sets aside available copies of the book for holds waiting
+// in the queue, until either of them runs out.
+func (s *Server) promoteHolds(ctx context.Context, tx *sqlx.Tx, libraryID, bookID int) error {
+	for {
+		query := `select * from holds
+			where library_id = $1 and book_id = $2 and status = 'WAITING'
+			order by priority, id
+			limit 1
+			for update skip locked`
+
+		var holds []hold
+		if err := tx.SelectContext(ctx, &holds, query, libraryID, bookID); err != nil {
+			return fmt.Errorf("select waiting hold: %w", err)
+		}
+
+		if len(holds) == 0 {
+			return nil
+		}
+
+		query = `select * from book_copies c
+			where c.library_id = $1 and c.book_id = $2 and c.status = 'AVAILABLE'
+			order by ` + copyConditionOrder + `, c.id
+			limit 1
+			for update skip locked`
+
+		var copies []bookCopy
+		if err := tx.SelectContext(ctx, &copies, query, libraryID, bookID); err != nil {
+			return fmt.Errorf("select available copy: %w", err)
+		}
+
+		if len(copies) == 0 {
+			return nil
+		}
+
+		h, c := holds[0], copies[0]
+
+		query = `update book_copies set status = $2 where id = $1`
+		if _, err := tx.ExecContext(ctx, query, c.ID, copyOnHold); err != nil {
+			return fmt.Errorf("update book copy: %w", err)
+		}
+
+		query = `update holds set status = $2, copy_id = $3, ready_at = now(), expires_at = now() + make_interval(secs => $4) where id = $1`
+		if _, err := tx.ExecContext(ctx, query, h.ID, holdReady, c.ID, s.holds.PickupWindow.Seconds()); err != nil {
+			return fmt.Errorf("update hold: %w", err)
+		}
+
+		if _, err := recordMovement(ctx, tx, stockMovement{
+			LibraryID:      libraryID,
+			BookID:         bookID,
+			CopyID:         &c.ID,
+			Actor:          systemActor,
+			Reason:         movementHold,
+			Delta:          -1,
+			CorrelationUID: &h.HoldUID,
+		}); err != nil {
+			return fmt.Errorf("record stock movement: %w", err)
+		}
+	}
+}
+
+// takeHeldCopy rents the copy set aside for the hold. The copy has already
+// left the available stock on promotion, so no stock movement is recorded.
+func (s *Server) takeHeldCopy(ctx context.Context, tx *sqlx.Tx, h holdInfo, request generated.TakeBookRequestObject) (generated.TakeBookResponseObject, error) {
+	logger := slog.With("handler", "TakeBook", "hold_uid", h.HoldUID)
+
+	taken, err := rentSetAsideCopy(ctx, tx, *h.CopyID, copyOnHold, request.Params.ReservationUid)
+	if err != nil {
+		logger.Error("rent held copy", "error", err)
+		return nil, fmt.Errorf("rent held copy: %w", err)
+	}
+
+	query := `update holds set status = $2, expires_at = null, closed_at = now() where id = $1`
+	if _, err := tx.ExecContext(ctx, query, h.ID, holdFulfilled); err != nil {
+		logger.Error("update hold in db", "error", err)
+		return nil, fmt.Errorf("update hold in db: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.TakeBook200JSONResponse(toBookCopyResponse(taken)), nil
+}
+
+// closeHold moves the hold to the final status. A copy set aside for it is
+// made available and goes to the next hold in the queue.
+func (s *Server) closeHold(ctx context.Context, tx *sqlx.Tx, h hold, status, actor string) error {
+	query := `update holds set status = $2, copy_id = null, expires_at = null, closed_at = now() where id = $1`
+	if _, err := tx.ExecContext(ctx, query, h.ID, status); err != nil {
+		return fmt.Errorf("update hold: %w", err)
+	}
+
+	if h.Status != holdReady || h.CopyID == nil {
+		return nil
+	}
+
+	query = `update book_copies set status = $2 where id = $1 and status = $3`
+	if _, err := tx.ExecContext(ctx, query, *h.CopyID, copyAvailable, copyOnHold); err != nil {
+		return fmt.Errorf("update book copy: %w", err)
+	}
+
+	if _, err := recordMovement(ctx, tx, stockMovement{
+		LibraryID:      h.LibraryID,
+		BookID:         h.BookID,
+		CopyID:         h.CopyID,
+		Actor:          actor,
+		Reason:         movementHold,
+		Delta:          1,
+		CorrelationUID: &h.HoldUID,
+	}); err != nil {
+		return fmt.Errorf("record stock movement: %w", err)
+	}
+
+	return s.promoteHolds(ctx, tx, h.LibraryID, h.BookID)
+}
+
+func (s *Server) expireHolds(ctx context.Context) error {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var locked bool
+	if err := tx.GetContext(ctx, &locked, `select pg_try_advisory_xact_lock(hashtext('library_hold_expiry'))`); err != nil {
+		return fmt.Errorf("take advisory lock: %w", err)
+	}
+
+	if !locked {
+		return nil
+	}
+
+	query := `select * from holds where status = 'READY' and expires_at < now() order by expires_at for update skip locked`
+
+	var expired []hold
+	if err := tx.SelectContext(ctx, &expired, query); err != nil {
+		return fmt.Errorf("select expired holds: %w", err)
+	}
+
+	for _, h := range expired {
+		if err := s.closeHold(ctx, tx, h, holdExpired, systemActor); err != nil {
+			return fmt.Errorf("close hold %s: %w", h.HoldUID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	if len(expired) > 0 {
+		slog.Info("holds expired", "count", len(expired))
+	}
+
+	return nil
+}
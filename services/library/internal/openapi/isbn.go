@@ -0,0 +1,104 @@
+package openapi
+
+import (
+	"errors"
+	"strings"
+)
+
+var errInvalidISBN = errors.New("invalid isbn")
+
+func normalizeISBN(isbn string) (string, error) {
+	isbn = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(isbn))
+
+	switch len(isbn) {
+	case 10:
+		if !isbn10Valid(isbn) {
+			return "", errInvalidISBN
+		}
+
+		return isbn10To13(isbn), nil
+	case 13:
+		if !isbn13Valid(isbn) {
+			return "", errInvalidISBN
+		}
+
+		return isbn, nil
+	default:
+		return "", errInvalidISBN
+	}
+}
+
+func isbn10Valid(isbn string) bool {
+	sum := 0
+	for i, r := range isbn {
+		digit := int(r - '0')
+		switch {
+		case r == 'X' && i == 9:
+			digit = 10
+		case r < '0' || r > '9':
+			return false
+		}
+
+		sum += digit * (10 - i)
+	}
+
+	return sum%11 == 0
+}
+
+func isbn13Valid(isbn string) bool {
+	if !strings.HasPrefix(isbn, "978") && !strings.HasPrefix(isbn, "979") {
+		return false
+	}
+
+	sum := 0
+	for i, r := range isbn {
+		if r < '0' || r > '9' {
+			return false
+		}
+
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+
+		sum += int(r-'0') * weight
+	}
+
+	return sum%10 == 0
+}
+
+func isbn10To13(isbn string) string {
+	isbn = "978" + isbn[:9]
+
+	sum := 0
+	for i, r := range isbn {
+		weight := 1
+		if i%2 == 1 {
+			weight = 3
+		}
+
+		sum += int(r-'0') * weight
+	}
+
+	return isbn + string(rune('0'+(10-sum%10)%10))
+}
+
+func isbn13To10(isbn string) (string, bool) {
+	if !strings.HasPrefix(isbn, "978") {
+		return "", false
+	}
+
+	isbn = isbn[3:12]
+
+	sum := 0
+	for i, r := range isbn {
+		sum += int(r-'0') * (10 - i)
+	}
+
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return isbn + "X", true
+	}
+
+	return isbn + string(rune('0'+check)), true
+}
@@ -0,0 +1,44 @@
+package openapi
+
+import (
+	"github.com/muhomorfus/ds-lab-02/services/library/internal/generated"
+	"github.com/muhomorfus/ds-lab-02/services/listing"
+	"strings"
+)
+
+func newListPage[T any](sorts map[string]listing.SortField[T], page, size *int, sort, order string, cursor *string) (listing.Page, listing.SortField[T], *generated.ValidationErrorResponse) {
+	p, field, ferr := listing.New(sorts, page, size, sort, order, cursor)
+	if ferr != nil {
+		return p, field, validationError(ferr.Field, ferr.Message)
+	}
+
+	return p, field, nil
+}
+
+func plainPage(page, size *int, order string) (listing.Page, *generated.ValidationErrorResponse) {
+	p, ferr := listing.Plain(page, size, order)
+	if ferr != nil {
+		return p, validationError(ferr.Field, ferr.Message)
+	}
+
+	return p, nil
+}
+
+func validationError(field, message string) *generated.ValidationErrorResponse {
+	return &generated.ValidationErrorResponse{
+		Message: "invalid request parameters",
+		Errors: []generated.ErrorDescription{
+			{Field: field, Error: message},
+		},
+	}
+}
+
+func likePrefix(value string) string {
+	return likeEscaper.Replace(value) + "%"
+}
+
+func likeContains(value string) string {
+	return "%" + likeEscaper.Replace(value) + "%"
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
@@ -1,6 +1,10 @@
 package openapi
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"github.com/muhomorfus/ds-lab-02/services/listing"
+	"time"
+)
 
 type library struct {
 	ID         int       `db:"id"`
@@ -8,15 +12,106 @@ type library struct {
 	Name       string    `db:"name"`
 	City       string    `db:"city"`
 	Address    string    `db:"address"`
+	Latitude   *float64  `db:"latitude"`
+	Longitude  *float64  `db:"longitude"`
+	Timezone   string    `db:"timezone"`
 }
 
+type openingHours struct {
+	LibraryID int    `db:"library_id"`
+	Weekday   int    `db:"weekday"`
+	OpensAt   string `db:"opens_at"`
+	ClosesAt  string `db:"closes_at"`
+}
+
+type holiday struct {
+	ID        int       `db:"id"`
+	LibraryID int       `db:"library_id"`
+	Date      time.Time `db:"date"`
+	Reason    *string   `db:"reason"`
+}
+
+type nearbyLibrary struct {
+	library
+	Distance float64 `db:"distance"`
+}
+
+type city struct {
+	City           string `db:"city"`
+	LibrariesCount int    `db:"libraries_count"`
+}
+
+const (
+	defaultSearchRadius = 10.0
+	maxSearchRadius     = 500.0
+)
+
 type book struct {
+	ID      int       `db:"id"`
+	BookUID uuid.UUID `db:"book_uid"`
+	Name    string    `db:"name"`
+	Author  string    `db:"author"`
+	Genre   string    `db:"genre"`
+	bookMetadata
+}
+
+type bookMetadata struct {
+	ISBN10          *string `db:"isbn10"`
+	ISBN13          *string `db:"isbn13"`
+	PublicationYear *int    `db:"publication_year"`
+	Publisher       *string `db:"publisher"`
+	Language        *string `db:"language"`
+	PageCount       *int    `db:"page_count"`
+	Description     *string `db:"description"`
+	bookCover
+	bookGrouping
+}
+
+type bookGrouping struct {
+	WorkID       int        `db:"work_id"`
+	SeriesID     *int       `db:"series_id"`
+	VolumeNumber *int       `db:"volume_number"`
+	WorkUID      *uuid.UUID `db:"work_uid"`
+	SeriesUID    *uuid.UUID `db:"series_uid"`
+}
+
+const (
+	bookColumns = `b.*, w.work_uid, s.series_uid`
+	bookJoins   = `join works w on w.id = b.work_id left join series s on s.id = b.series_id`
+	bookQuery   = `select ` + bookColumns + ` from books b ` + bookJoins
+)
+
+type work struct {
+	ID      int       `db:"id"`
+	WorkUID uuid.UUID `db:"work_uid"`
+	Title   string    `db:"title"`
+	Author  *string   `db:"author"`
+}
+
+type workSearchResult struct {
+	work
+	BookID        int `db:"book_id"`
+	EditionsCount int `db:"editions_count"`
+}
+
+type series struct {
 	ID        int       `db:"id"`
-	BookUID   uuid.UUID `db:"book_uid"`
+	SeriesUID uuid.UUID `db:"series_uid"`
 	Name      string    `db:"name"`
-	Author    string    `db:"author"`
-	Genre     string    `db:"genre"`
-	Condition string    `db:"condition"`
+	Author    *string   `db:"author"`
+}
+
+type seriesVolume struct {
+	book
+	AvailableCount int `db:"available_count"`
+}
+
+const editionOrder = `b.publication_year desc nulls last, b.id desc`
+
+type bookCover struct {
+	CoverHash        *string    `db:"cover_hash"`
+	CoverContentType *string    `db:"cover_content_type"`
+	CoverUpdatedAt   *time.Time `db:"cover_updated_at"`
 }
 
 type libraryBook struct {
@@ -27,10 +122,266 @@ type libraryBook struct {
 	Author         string    `db:"author"`
 	Genre          string    `db:"genre"`
 	Condition      string    `db:"condition"`
+	bookMetadata
 }
 
-type libraryBookRaw struct {
-	LibraryID      int `db:"library_id"`
-	BookID         int `db:"book_id"`
-	AvailableCount int `db:"available_count"`
+type bookCopy struct {
+	ID             int        `db:"id"`
+	CopyUID        uuid.UUID  `db:"copy_uid"`
+	Barcode        string     `db:"barcode"`
+	BookID         int        `db:"book_id"`
+	LibraryID      int        `db:"library_id"`
+	Condition      string     `db:"condition"`
+	Status         string     `db:"status"`
+	ReservationUID *uuid.UUID `db:"reservation_uid"`
+	RentedAt       *time.Time `db:"rented_at"`
+}
+
+type bookCopyInfo struct {
+	bookCopy
+	BookUID    uuid.UUID `db:"book_uid"`
+	LibraryUID uuid.UUID `db:"library_uid"`
+}
+
+const (
+	copyAvailable = "AVAILABLE"
+	copyRented    = "RENTED"
+	copyWithdrawn = "WITHDRAWN"
+	copyInTransit = "IN_TRANSIT"
+	copyBooked    = "BOOKED"
+	copyOnHold    = "ON_HOLD"
+)
+
+type stockMovement struct {
+	ID             int        `db:"id"`
+	LibraryID      int        `db:"library_id"`
+	BookID         int        `db:"book_id"`
+	CopyID         *int       `db:"copy_id"`
+	Actor          string     `db:"actor"`
+	Reason         string     `db:"reason"`
+	Delta          int        `db:"delta"`
+	ResultingCount int        `db:"resulting_count"`
+	CorrelationUID *uuid.UUID `db:"correlation_uid"`
+	Comment        *string    `db:"comment"`
+	CreatedAt      time.Time  `db:"created_at"`
+}
+
+type stockMovementInfo struct {
+	stockMovement
+	BookUID    uuid.UUID  `db:"book_uid"`
+	LibraryUID uuid.UUID  `db:"library_uid"`
+	CopyUID    *uuid.UUID `db:"copy_uid"`
+}
+
+type stockDiscrepancy struct {
+	BookUID         uuid.UUID `db:"book_uid"`
+	LedgerCount     int       `db:"ledger_count"`
+	RecordedCount   int       `db:"recorded_count"`
+	ActualCount     int       `db:"actual_count"`
+	BrokenMovements int       `db:"broken_movements"`
+}
+
+const maxStockAdjustment = 1000
+
+type circulation struct {
+	LibraryID      int
+	BookID         int
+	Checkouts      int
+	Returns        int
+	LoanSeconds    int64
+	CompletedLoans int
 }
+
+type popularBook struct {
+	book
+	Checkouts int `db:"checkouts"`
+	Returns   int `db:"returns"`
+}
+
+type circulationTotals struct {
+	Checkouts      int   `db:"checkouts"`
+	Returns        int   `db:"returns"`
+	LoanSeconds    int64 `db:"loan_seconds"`
+	CompletedLoans int   `db:"completed_loans"`
+	TotalCopies    int   `db:"total_copies"`
+	CopiesOut      int   `db:"copies_out"`
+}
+
+const (
+	defaultStatisticsDays = 30
+	defaultPopularLimit   = 10
+	maxPopularLimit       = 100
+)
+
+type suggestion struct {
+	Kind       string     `db:"kind"`
+	Text       string     `db:"text"`
+	BookUID    *uuid.UUID `db:"book_uid"`
+	Prefix     bool       `db:"prefix"`
+	Popularity int        `db:"popularity"`
+	Score      float64    `db:"score"`
+}
+
+const (
+	defaultSuggestionLimit   = 10
+	maxSuggestionLimit       = 20
+	suggestionPopularityDays = 90
+)
+
+type transfer struct {
+	ID                   int        `db:"id"`
+	TransferUID          uuid.UUID  `db:"transfer_uid"`
+	BookID               int        `db:"book_id"`
+	SourceLibraryID      int        `db:"source_library_id"`
+	DestinationLibraryID int        `db:"destination_library_id"`
+	Quantity             int        `db:"quantity"`
+	Status               string     `db:"status"`
+	RequestedBy          string     `db:"requested_by"`
+	RequestedAt          time.Time  `db:"requested_at"`
+	DispatchedAt         *time.Time `db:"dispatched_at"`
+	ReceivedAt           *time.Time `db:"received_at"`
+	CancelledAt          *time.Time `db:"cancelled_at"`
+}
+
+type transferInfo struct {
+	transfer
+	BookUID               uuid.UUID `db:"book_uid"`
+	SourceLibraryUID      uuid.UUID `db:"source_library_uid"`
+	DestinationLibraryUID uuid.UUID `db:"destination_library_uid"`
+}
+
+const (
+	transferRequested = "REQUESTED"
+	transferInTransit = "IN_TRANSIT"
+	transferReceived  = "RECEIVED"
+	transferCancelled = "CANCELLED"
+)
+
+const transferInfoQuery = `select t.*, b.book_uid, s.library_uid as source_library_uid, d.library_uid as destination_library_uid from
+	transfers t
+	join books b on b.id = t.book_id
+	join library s on s.id = t.source_library_id
+	join library d on d.id = t.destination_library_id`
+
+const (
+	movementCheckout = "CHECKOUT"
+	movementReturn   = "RETURN"
+	movementAdjust   = "ADJUST"
+	movementTransfer = "TRANSFER"
+	movementHold     = "HOLD"
+	movementCancel   = "CANCEL"
+	movementBooking  = "BOOKING"
+)
+
+type conditionChange struct {
+	ID             int        `db:"id"`
+	CopyID         int        `db:"copy_id"`
+	OldCondition   string     `db:"old_condition"`
+	NewCondition   string     `db:"new_condition"`
+	ReservationUID *uuid.UUID `db:"reservation_uid"`
+	ChangedBy      string     `db:"changed_by"`
+	ChangedAt      time.Time  `db:"changed_at"`
+}
+
+var conditionRank = map[string]int{
+	"BAD":       0,
+	"GOOD":      1,
+	"EXCELLENT": 2,
+}
+
+func degraded(from, to string) bool {
+	return conditionRank[to] < conditionRank[from]
+}
+
+const copyConditionOrder = `case c.condition when 'EXCELLENT' then 0 when 'GOOD' then 1 else 2 end`
+
+var librarySorts = map[string]listing.SortField[library]{
+	"name": {Column: "t.name", Value: func(item library) any { return item.Name }},
+	"city": {Column: "t.city", Value: func(item library) any { return item.City }},
+}
+
+var libraryBookSorts = map[string]listing.SortField[libraryBook]{
+	"name":           {Column: "t.name", Value: func(item libraryBook) any { return item.Name }},
+	"author":         {Column: "coalesce(t.author, '')", Value: func(item libraryBook) any { return item.Author }},
+	"genre":          {Column: "coalesce(t.genre, '')", Value: func(item libraryBook) any { return item.Genre }},
+	"availableCount": {Column: "t.available_count", Value: func(item libraryBook) any { return item.AvailableCount }},
+}
+
+type hold struct {
+	ID          int        `db:"id"`
+	HoldUID     uuid.UUID  `db:"hold_uid"`
+	LibraryID   int        `db:"library_id"`
+	BookID      int        `db:"book_id"`
+	Username    string     `db:"username"`
+	Rating      int        `db:"rating"`
+	Priority    time.Time  `db:"priority"`
+	Status      string     `db:"status"`
+	CopyID      *int       `db:"copy_id"`
+	RequestedAt time.Time  `db:"requested_at"`
+	ReadyAt     *time.Time `db:"ready_at"`
+	ExpiresAt   *time.Time `db:"expires_at"`
+	ClosedAt    *time.Time `db:"closed_at"`
+}
+
+type holdInfo struct {
+	hold
+	BookUID    uuid.UUID `db:"book_uid"`
+	LibraryUID uuid.UUID `db:"library_uid"`
+	Position   int       `db:"position"`
+}
+
+const (
+	holdWaiting   = "WAITING"
+	holdReady     = "READY"
+	holdFulfilled = "FULFILLED"
+	holdCancelled = "CANCELLED"
+	holdExpired   = "EXPIRED"
+)
+
+type holdStock struct {
+	LibraryID int `db:"library_id"`
+	BookID    int `db:"book_id"`
+	Total     int `db:"total"`
+	Available int `db:"available"`
+}
+
+const holdInfoQuery = `select h.*, b.book_uid, l.library_uid,
+		case when h.status = 'WAITING' then (
+			select count(*) from holds q
+			where q.library_id = h.library_id and q.book_id = h.book_id and q.status = 'WAITING'
+				and (q.priority, q.id) <= (h.priority, h.id)
+		) else 0 end as position
+	from holds h
+		join books b on b.id = h.book_id
+		join library l on l.id = h.library_id`
+
+const systemActor = "system"
+
+type copyBooking struct {
+	ID             int        `db:"id"`
+	ReservationUID uuid.UUID  `db:"reservation_uid"`
+	LibraryID      int        `db:"library_id"`
+	BookID         int        `db:"book_id"`
+	CopyID         *int       `db:"copy_id"`
+	Username       string     `db:"username"`
+	PickupDate     time.Time  `db:"pickup_date"`
+	Status         string     `db:"status"`
+	ExpiresAt      time.Time  `db:"expires_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	ClosedAt       *time.Time `db:"closed_at"`
+}
+
+type bookingStock struct {
+	LibraryID int       `db:"library_id"`
+	BookID    int       `db:"book_id"`
+	Today     time.Time `db:"today"`
+	Total     int       `db:"total"`
+	Booked    int       `db:"booked"`
+}
+
+const (
+	bookingBooked    = "BOOKED"
+	bookingPickedUp  = "PICKED_UP"
+	bookingCancelled = "CANCELLED"
+	bookingExpired   = "EXPIRED"
+)
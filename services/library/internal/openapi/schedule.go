@@ -0,0 +1,104 @@
+package openapi
+
+import (
+	"errors"
+	"time"
+)
+
+const scheduleHorizonDays = 366
+
+var errNoOpenDays = errors.New("library has no open days within a year")
+
+type schedule struct {
+	location *time.Location
+	hours    map[time.Weekday]openingHours
+	holidays map[string]bool
+}
+
+func newSchedule(timezone string, hours []openingHours, holidays []holiday) (schedule, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return schedule{}, err
+	}
+
+	s := schedule{
+		location: location,
+		hours:    make(map[time.Weekday]openingHours, len(hours)),
+		holidays: make(map[string]bool, len(holidays)),
+	}
+
+	for _, h := range hours {
+		s.hours[time.Weekday(h.Weekday%7)] = h
+	}
+
+	for _, h := range holidays {
+		s.holidays[h.Date.Format(time.DateOnly)] = true
+	}
+
+	return s, nil
+}
+
+// openOn reports whether library works on the given day. Library without
+// configured opening hours is considered to work every day except holidays.
+func (s schedule) openOn(day time.Time) bool {
+	if s.holidays[day.Format(time.DateOnly)] {
+		return false
+	}
+
+	if len(s.hours) == 0 {
+		return true
+	}
+
+	_, ok := s.hours[day.Weekday()]
+	return ok
+}
+
+func (s schedule) openAt(t time.Time) bool {
+	t = t.In(s.location)
+	if !s.openOn(t) {
+		return false
+	}
+
+	if len(s.hours) == 0 {
+		return true
+	}
+
+	h := s.hours[t.Weekday()]
+	clock := t.Format("15:04")
+
+	return h.OpensAt <= clock && clock < h.ClosesAt
+}
+
+func (s schedule) nextOpenDay(day time.Time) (time.Time, error) {
+	for i := 0; i < scheduleHorizonDays; i++ {
+		if s.openOn(day) {
+			return day, nil
+		}
+
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}, errNoOpenDays
+}
+
+func (s schedule) nextOpening(now time.Time) (time.Time, error) {
+	now = now.In(s.location)
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, s.location)
+
+	for i := 0; i < scheduleHorizonDays; i++ {
+		if s.openOn(day) {
+			if len(s.hours) == 0 {
+				return day, nil
+			}
+
+			opens, _ := time.ParseInLocation(time.DateOnly+" 15:04", day.Format(time.DateOnly)+" "+s.hours[day.Weekday()].OpensAt, s.location)
+			if opens.After(now) {
+				return opens, nil
+			}
+		}
+
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}, errNoOpenDays
+}
@@ -1,20 +1,34 @@
 package openapi
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/muhomorfus/ds-lab-02/services/auth/contextutils"
+	"github.com/muhomorfus/ds-lab-02/services/library/internal/blob"
 	"github.com/muhomorfus/ds-lab-02/services/library/internal/generated"
+	"github.com/muhomorfus/ds-lab-02/services/library/internal/report"
+	"github.com/muhomorfus/ds-lab-02/services/listing"
 	"github.com/samber/lo"
+	"io"
 	"log/slog"
+	"strings"
+	"time"
 )
 
 type Server struct {
-	db *sqlx.DB
+	db           *sqlx.DB
+	covers       blob.Store
+	maxCoverSize int64
+	holds        HoldPolicy
 }
 
-func New(db *sqlx.DB) *Server {
-	return &Server{db: db}
+func New(db *sqlx.DB, covers blob.Store, maxCoverSize int64, holds HoldPolicy) *Server {
+	return &Server{db: db, covers: covers, maxCoverSize: maxCoverSize, holds: holds}
 }
 
 func (s *Server) Health(ctx context.Context, request generated.HealthRequestObject) (generated.HealthResponseObject, error) {
@@ -23,7 +37,7 @@ func (s *Server) Health(ctx context.Context, request generated.HealthRequestObje
 
 func (s *Server) GetBook(ctx context.Context, request generated.GetBookRequestObject) (generated.GetBookResponseObject, error) {
 	logger := slog.With("handler", "GetBook")
-	query := `select * from books where book_uid = $1`
+	query := bookQuery + ` where b.book_uid = $1`
 
 	var books []book
 	if err := s.db.SelectContext(ctx, &books, query, request.BookUid); err != nil {
@@ -32,43 +46,97 @@ func (s *Server) GetBook(ctx context.Context, request generated.GetBookRequestOb
 	}
 
 	if len(books) == 0 {
-		logger.Error("book not found")
-		return nil, fmt.Errorf("book not found")
+		logger.Warn("book not found", "book", request.BookUid)
+		return generated.GetBook404JSONResponse{
+			Message: "book not found",
+		}, nil
 	}
 
-	return generated.GetBook200JSONResponse{
-		Author:  books[0].Author,
-		BookUid: books[0].BookUID,
-		Genre:   books[0].Genre,
-		Name:    books[0].Name,
-	}, nil
+	return generated.GetBook200JSONResponse(toBookInfo(books[0])), nil
 }
 
-func (s *Server) ListLibraries(ctx context.Context, request generated.ListLibrariesRequestObject) (generated.ListLibrariesResponseObject, error) {
-	logger := slog.With("handler", "ListLibraries")
-	query := pagination(`select * from library where city = $1`, request.Params.Page, request.Params.Size)
+func (s *Server) SearchBooks(ctx context.Context, request generated.SearchBooksRequestObject) (generated.SearchBooksResponseObject, error) {
+	logger := slog.With("handler", "SearchBooks")
 
-	var libraries []library
-	if err := s.db.SelectContext(ctx, &libraries, query, request.Params.City); err != nil {
-		logger.Error("select libraries from db", "error", err)
-		return nil, fmt.Errorf("select libraries from db: %w", err)
+	page, verr := plainPage(request.Params.Page, request.Params.Size, "asc")
+	if verr != nil {
+		return generated.SearchBooks400JSONResponse(*verr), nil
+	}
+
+	var q listing.Query
+	filtered := `select w.*, count(b.id) as editions_count, (array_agg(b.id order by ` + editionOrder + `))[1] as book_id from
+		works w
+		join books b on b.work_id = w.id`
+
+	if request.Params.Query != nil {
+		pattern := q.Bind(likeContains(*request.Params.Query))
+		filtered += ` where w.title ilike ` + pattern + ` or w.author ilike ` + pattern + `
+			or w.id in (select work_id from books where name ilike ` + pattern + ` or author ilike ` + pattern + `)`
+	}
+
+	filtered += ` group by w.id`
+
+	filterArgs := len(q.Args)
+	query := page.Keyset(&q, filtered, "t.title")
+
+	var works []workSearchResult
+	if err := s.db.SelectContext(ctx, &works, query, q.Args...); err != nil {
+		logger.Error("select works from db", "error", err)
+		return nil, fmt.Errorf("select works from db: %w", err)
 	}
 
-	query = `select count(*) from library where city = $1`
+	query = `select count(*) from (` + filtered + `) t`
 	var count int
-	if err := s.db.QueryRow(query, request.Params.City).Scan(&count); err != nil {
+	if err := s.db.QueryRowContext(ctx, query, q.Args[:filterArgs]...).Scan(&count); err != nil {
 		logger.Error("select count from db", "error", err)
 		return nil, fmt.Errorf("select count from db: %w", err)
 	}
 
-	return generated.ListLibraries200JSONResponse{
-		Items: lo.Map(libraries, func(item library, _ int) generated.LibraryResponse {
-			return generated.LibraryResponse{
-				Address:    item.Address,
-				City:       item.City,
-				LibraryUid: item.LibraryUID,
-				Name:       item.Name,
+	works = listing.Cut(page, works)
+
+	expand := lo.FromPtr(request.Params.ExpandEditions)
+
+	query, ids := bookQuery+` where b.id = any($1)`, lo.Map(works, func(item workSearchResult, _ int) int64 {
+		return int64(item.BookID)
+	})
+
+	if expand {
+		query, ids = bookQuery+` where b.work_id = any($1) order by `+editionOrder, lo.Map(works, func(item workSearchResult, _ int) int64 {
+			return int64(item.ID)
+		})
+	}
+
+	var books []book
+	if err := s.db.SelectContext(ctx, &books, query, pq.Array(ids)); err != nil {
+		logger.Error("select books from db", "error", err)
+		return nil, fmt.Errorf("select books from db: %w", err)
+	}
+
+	byID := lo.KeyBy(books, func(item book) int {
+		return item.ID
+	})
+
+	editions := lo.GroupBy(books, func(item book) int {
+		return item.WorkID
+	})
+
+	return generated.SearchBooks200JSONResponse{
+		Items: lo.Map(works, func(item workSearchResult, _ int) generated.WorkSearchResult {
+			result := generated.WorkSearchResult{
+				WorkUid:       item.WorkUID,
+				Title:         item.Title,
+				Author:        item.Author,
+				Book:          toBookInfo(byID[item.BookID]),
+				EditionsCount: item.EditionsCount,
 			}
+
+			if expand {
+				result.OtherEditions = lo.ToPtr(lo.FilterMap(editions[item.ID], func(b book, _ int) (generated.BookInfo, bool) {
+					return toBookInfo(b), b.ID != item.BookID
+				}))
+			}
+
+			return result
 		}),
 		Page:          request.Params.Page,
 		PageSize:      request.Params.Size,
@@ -76,171 +144,3096 @@ func (s *Server) ListLibraries(ctx context.Context, request generated.ListLibrar
 	}, nil
 }
 
-func (s *Server) GetLibrary(ctx context.Context, request generated.GetLibraryRequestObject) (generated.GetLibraryResponseObject, error) {
-	logger := slog.With("handler", "GetLibrary")
-	query := `select * from library where library_uid = $1`
+func (s *Server) GetWork(ctx context.Context, request generated.GetWorkRequestObject) (generated.GetWorkResponseObject, error) {
+	logger := slog.With("handler", "GetWork")
 
-	var libraries []library
-	if err := s.db.SelectContext(ctx, &libraries, query, request.LibraryUid); err != nil {
-		logger.Error("select library from db", "error", err)
-		return nil, fmt.Errorf("select library from db: %w", err)
+	resp, found, err := s.workResponse(ctx, request.WorkUid)
+	if err != nil {
+		logger.Error("get work", "error", err)
+		return nil, fmt.Errorf("get work: %w", err)
 	}
 
-	if len(libraries) == 0 {
-		logger.Error("library not found")
-		return nil, fmt.Errorf("library not found")
+	if !found {
+		return generated.GetWork404JSONResponse{
+			Message: "work not found",
+		}, nil
 	}
 
-	return generated.GetLibrary200JSONResponse{
-		Address:    libraries[0].Address,
-		City:       libraries[0].City,
-		LibraryUid: libraries[0].LibraryUID,
-		Name:       libraries[0].Name,
-	}, nil
+	return generated.GetWork200JSONResponse(resp), nil
 }
 
-func (s *Server) ListBooks(ctx context.Context, request generated.ListBooksRequestObject) (generated.ListBooksResponseObject, error) {
-	logger := slog.With("handler", "ListBooks")
-	dontShowNotAvailableFilter := " and lb.available_count > 0"
-	if lo.FromPtr(request.Params.ShowAll) {
-		dontShowNotAvailableFilter = ""
-	}
-
-	query := pagination(`
-	select b.*, lb.available_count from 
-		books b 
-			join library_books lb on b.id = lb.book_id 
-			join library l on l.id = lb.library_id 
-		where l.library_uid = $1`+dontShowNotAvailableFilter,
-		request.Params.Page,
-		request.Params.Size,
-	)
+func (s *Server) AddWorkEdition(ctx context.Context, request generated.AddWorkEditionRequestObject) (generated.AddWorkEditionResponseObject, error) {
+	logger := slog.With("handler", "AddWorkEdition")
 
-	var books []libraryBook
-	if err := s.db.SelectContext(ctx, &books, query, request.LibraryUid); err != nil {
-		logger.Error("select books from db", "error", err)
-		return nil, fmt.Errorf("select books from db: %w", err)
+	if !contextutils.IsStaff(ctx) {
+		return generated.AddWorkEdition403JSONResponse{
+			Message: "only library staff can group editions",
+		}, nil
 	}
 
-	query = `select count(*) from
-		books b
-		join library_books lb on b.id = lb.book_id
-		join library l on l.id = lb.library_id
-		where l.library_uid = $1` + dontShowNotAvailableFilter
-	var count int
-	if err := s.db.QueryRow(query, request.LibraryUid).Scan(&count); err != nil {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `update books b set work_id = w.id
+		from works w, books old
+		where w.work_uid = $1 and b.book_uid = $2 and old.id = b.id
+		returning old.work_id`
+
+	var previous []int
+	if err := tx.SelectContext(ctx, &previous, query, request.WorkUid, request.BookUid); err != nil {
+		logger.Error("update book work", "error", err)
+		return nil, fmt.Errorf("update book work: %w", err)
+	}
+
+	if len(previous) == 0 {
+		return generated.AddWorkEdition404JSONResponse{
+			Message: "work or book not found",
+		}, nil
+	}
+
+	query = `delete from works w where w.id = $1 and not exists (select 1 from books b where b.work_id = w.id)`
+	if _, err := tx.ExecContext(ctx, query, previous[0]); err != nil {
+		logger.Error("delete empty work", "error", err)
+		return nil, fmt.Errorf("delete empty work: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	resp, _, err := s.workResponse(ctx, request.WorkUid)
+	if err != nil {
+		logger.Error("get work", "error", err)
+		return nil, fmt.Errorf("get work: %w", err)
+	}
+
+	return generated.AddWorkEdition200JSONResponse(resp), nil
+}
+
+func (s *Server) RemoveWorkEdition(ctx context.Context, request generated.RemoveWorkEditionRequestObject) (generated.RemoveWorkEditionResponseObject, error) {
+	logger := slog.With("handler", "RemoveWorkEdition")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.RemoveWorkEdition403JSONResponse{
+			Message: "only library staff can group editions",
+		}, nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select b.* from books b join works w on w.id = b.work_id where w.work_uid = $1 for update of w`
+
+	var editions []book
+	if err := tx.SelectContext(ctx, &editions, query, request.WorkUid); err != nil {
+		logger.Error("select editions from db", "error", err)
+		return nil, fmt.Errorf("select editions from db: %w", err)
+	}
+
+	edition, found := lo.Find(editions, func(item book) bool {
+		return item.BookUID == request.BookUid
+	})
+
+	if !found {
+		return generated.RemoveWorkEdition404JSONResponse{
+			Message: "edition not found in work",
+		}, nil
+	}
+
+	if len(editions) == 1 {
+		return generated.RemoveWorkEdition409JSONResponse{
+			Message: "book is the only edition of the work",
+		}, nil
+	}
+
+	query = `insert into works (work_uid, title, author) values ($1, $2, $3) returning id`
+
+	var workID int
+	if err := tx.QueryRowContext(ctx, query, uuid.New(), edition.Name, edition.Author).Scan(&workID); err != nil {
+		logger.Error("insert work", "error", err)
+		return nil, fmt.Errorf("insert work: %w", err)
+	}
+
+	query = `update books set work_id = $2 where id = $1`
+	if _, err := tx.ExecContext(ctx, query, edition.ID, workID); err != nil {
+		logger.Error("update book work", "error", err)
+		return nil, fmt.Errorf("update book work: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.RemoveWorkEdition204Response{}, nil
+}
+
+func (s *Server) CreateSeries(ctx context.Context, request generated.CreateSeriesRequestObject) (generated.CreateSeriesResponseObject, error) {
+	logger := slog.With("handler", "CreateSeries")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.CreateSeries403JSONResponse{
+			Message: "only library staff can create series",
+		}, nil
+	}
+
+	switch {
+	case strings.TrimSpace(request.Body.Name) == "":
+		return generated.CreateSeries400JSONResponse(*validationError("name", "name must not be empty")), nil
+	case len(request.Body.Name) > 255:
+		return generated.CreateSeries400JSONResponse(*validationError("name", "name must be at most 255 characters")), nil
+	case len(lo.FromPtr(request.Body.Author)) > 255:
+		return generated.CreateSeries400JSONResponse(*validationError("author", "author must be at most 255 characters")), nil
+	}
+
+	sr := series{
+		SeriesUID: uuid.New(),
+		Name:      request.Body.Name,
+		Author:    request.Body.Author,
+	}
+
+	query := `insert into series (series_uid, name, author) values ($1, $2, $3) returning id`
+	if err := s.db.QueryRowContext(ctx, query, sr.SeriesUID, sr.Name, sr.Author).Scan(&sr.ID); err != nil {
+		logger.Error("insert series", "error", err)
+		return nil, fmt.Errorf("insert series: %w", err)
+	}
+
+	return generated.CreateSeries201JSONResponse(toSeriesResponse(sr, nil)), nil
+}
+
+func (s *Server) GetSeries(ctx context.Context, request generated.GetSeriesRequestObject) (generated.GetSeriesResponseObject, error) {
+	logger := slog.With("handler", "GetSeries")
+
+	resp, found, err := s.seriesResponse(ctx, request.SeriesUid, request.Params.LibraryUid)
+	if err != nil {
+		logger.Error("get series", "error", err)
+		return nil, fmt.Errorf("get series: %w", err)
+	}
+
+	if !found {
+		return generated.GetSeries404JSONResponse{
+			Message: "series not found",
+		}, nil
+	}
+
+	return generated.GetSeries200JSONResponse(resp), nil
+}
+
+func (s *Server) SetSeriesVolume(ctx context.Context, request generated.SetSeriesVolumeRequestObject) (generated.SetSeriesVolumeResponseObject, error) {
+	logger := slog.With("handler", "SetSeriesVolume")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.SetSeriesVolume403JSONResponse{
+			Message: "only library staff can change series",
+		}, nil
+	}
+
+	if request.Body.VolumeNumber < 1 {
+		return generated.SetSeriesVolume400JSONResponse(*validationError("volumeNumber", "volume number must be positive")), nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select * from series where series_uid = $1 for update`
+
+	var found []series
+	if err := tx.SelectContext(ctx, &found, query, request.SeriesUid); err != nil {
+		logger.Error("select series from db", "error", err)
+		return nil, fmt.Errorf("select series from db: %w", err)
+	}
+
+	if len(found) == 0 {
+		return generated.SetSeriesVolume404JSONResponse{
+			Message: "series not found",
+		}, nil
+	}
+
+	query = `select count(*) from books where series_id = $1 and volume_number = $2 and book_uid <> $3`
+
+	var taken int
+	if err := tx.QueryRowContext(ctx, query, found[0].ID, request.Body.VolumeNumber, request.BookUid).Scan(&taken); err != nil {
 		logger.Error("select count from db", "error", err)
 		return nil, fmt.Errorf("select count from db: %w", err)
 	}
 
-	return generated.ListBooks200JSONResponse{
-		Items: lo.Map(books, func(item libraryBook, _ int) generated.LibraryBookResponse {
-			return generated.LibraryBookResponse{
-				Author:         item.Author,
-				AvailableCount: item.AvailableCount,
-				BookUid:        item.BookUID,
-				Condition:      generated.LibraryBookResponseCondition(item.Condition),
-				Genre:          item.Genre,
-				Name:           item.Name,
-			}
-		}),
-		Page:          request.Params.Page,
-		PageSize:      request.Params.Size,
-		TotalElements: count,
-	}, nil
+	if taken > 0 {
+		return generated.SetSeriesVolume409JSONResponse{
+			Message: fmt.Sprintf("volume %d already exists in series", request.Body.VolumeNumber),
+		}, nil
+	}
+
+	query = `update books set series_id = $2, volume_number = $3 where book_uid = $1`
+	res, err := tx.ExecContext(ctx, query, request.BookUid, found[0].ID, request.Body.VolumeNumber)
+	if err != nil {
+		logger.Error("update book series", "error", err)
+		return nil, fmt.Errorf("update book series: %w", err)
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return generated.SetSeriesVolume404JSONResponse{
+			Message: "book not found",
+		}, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	resp, _, err := s.seriesResponse(ctx, request.SeriesUid, nil)
+	if err != nil {
+		logger.Error("get series", "error", err)
+		return nil, fmt.Errorf("get series: %w", err)
+	}
+
+	return generated.SetSeriesVolume200JSONResponse(resp), nil
 }
 
-func (s *Server) TakeBook(ctx context.Context, request generated.TakeBookRequestObject) (generated.TakeBookResponseObject, error) {
-	logger := slog.With("handler", "TakeBook")
-	query := `select l.id as library_id, b.id as book_id, lb.available_count from
-		books b
-		join library_books lb on b.id = lb.book_id
-		join library l on l.id = lb.library_id
-		where l.library_uid = $1 and b.book_uid = $2`
+func (s *Server) RemoveSeriesVolume(ctx context.Context, request generated.RemoveSeriesVolumeRequestObject) (generated.RemoveSeriesVolumeResponseObject, error) {
+	logger := slog.With("handler", "RemoveSeriesVolume")
 
-	var libraryBooks []libraryBookRaw
-	if err := s.db.SelectContext(ctx, &libraryBooks, query, request.LibraryUid, request.BookUid); err != nil {
-		logger.Error("select library books from db", "error", err)
-		return nil, fmt.Errorf("select library books from db: %w", err)
+	if !contextutils.IsStaff(ctx) {
+		return generated.RemoveSeriesVolume403JSONResponse{
+			Message: "only library staff can change series",
+		}, nil
 	}
 
-	if len(libraryBooks) == 0 {
-		logger.Warn("no book presented in library")
-		return generated.TakeBook400JSONResponse{
-			Message: "book not presented in this library",
+	query := `update books b set series_id = null, volume_number = null
+		from series s
+		where s.id = b.series_id and s.series_uid = $1 and b.book_uid = $2`
+	res, err := s.db.ExecContext(ctx, query, request.SeriesUid, request.BookUid)
+	if err != nil {
+		logger.Error("update book series", "error", err)
+		return nil, fmt.Errorf("update book series: %w", err)
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return generated.RemoveSeriesVolume404JSONResponse{
+			Message: "volume not found in series",
 		}, nil
 	}
 
-	if libraryBooks[0].AvailableCount == 0 {
-		logger.Warn("0 available books in library")
-		return generated.TakeBook400JSONResponse{
-			Message: "there is 0 available books in library",
+	return generated.RemoveSeriesVolume204Response{}, nil
+}
+
+func (s *Server) ListSuggestions(ctx context.Context, request generated.ListSuggestionsRequestObject) (generated.ListSuggestionsResponseObject, error) {
+	logger := slog.With("handler", "ListSuggestions")
+
+	text := strings.TrimSpace(request.Params.Query)
+	switch {
+	case text == "":
+		return generated.ListSuggestions400JSONResponse(*validationError("query", "query must not be empty")), nil
+	case len(text) > 100:
+		return generated.ListSuggestions400JSONResponse(*validationError("query", "query must be at most 100 characters")), nil
+	}
+
+	limit := lo.FromPtrOr(request.Params.Limit, defaultSuggestionLimit)
+	if limit < 1 || limit > maxSuggestionLimit {
+		return generated.ListSuggestions400JSONResponse(*validationError("limit", fmt.Sprintf("limit must be between 1 and %d", maxSuggestionLimit))), nil
+	}
+
+	query := `with popularity as (
+		select book_id, sum(checkouts)::int as checkouts
+		from circulation_daily
+		where day > current_date - $4::int
+		group by book_id
+	), candidates as (
+		select 'BOOK' as kind, b.name as text, b.book_uid,
+			b.name ilike $2 as prefix,
+			coalesce(p.checkouts, 0) as popularity,
+			word_similarity($1, b.name) as score
+		from books b
+			left join popularity p on p.book_id = b.id
+		where b.name ilike $2 or $1 <% b.name
+		union all
+		select 'AUTHOR', b.author, null,
+			b.author ilike $2,
+			coalesce(sum(p.checkouts), 0)::int,
+			word_similarity($1, b.author)
+		from books b
+			left join popularity p on p.book_id = b.id
+		where b.author ilike $2 or $1 <% b.author
+		group by b.author
+		union all
+		select 'GENRE', b.genre, null,
+			b.genre ilike $2,
+			coalesce(sum(p.checkouts), 0)::int,
+			word_similarity($1, b.genre)
+		from books b
+			left join popularity p on p.book_id = b.id
+		where b.genre ilike $2 or $1 <% b.genre
+		group by b.genre
+	)
+	select * from candidates
+	order by prefix desc, popularity desc, score desc, text
+	limit $3`
+
+	var suggestions []suggestion
+	if err := s.db.SelectContext(ctx, &suggestions, query, text, likePrefix(text), limit, suggestionPopularityDays); err != nil {
+		logger.Error("select suggestions from db", "error", err)
+		return nil, fmt.Errorf("select suggestions from db: %w", err)
+	}
+
+	return generated.ListSuggestions200JSONResponse(lo.Map(suggestions, func(item suggestion, _ int) generated.SuggestionResponse {
+		return generated.SuggestionResponse{
+			Text:    item.Text,
+			Kind:    generated.SuggestionResponseKind(item.Kind),
+			BookUid: item.BookUID,
+		}
+	})), nil
+}
+
+func (s *Server) GetBookByIsbn(ctx context.Context, request generated.GetBookByIsbnRequestObject) (generated.GetBookByIsbnResponseObject, error) {
+	logger := slog.With("handler", "GetBookByIsbn")
+
+	isbn13, err := normalizeISBN(request.Isbn)
+	if err != nil {
+		logger.Warn("invalid isbn", "isbn", request.Isbn)
+		return generated.GetBookByIsbn400JSONResponse{
+			Message: "invalid isbn",
+			Errors: []generated.ErrorDescription{
+				{Field: "isbn", Error: err.Error()},
+			},
 		}, nil
 	}
 
-	query = `update library_books set available_count = available_count - 1 where library_id = $1 and book_id = $2`
-	if _, err := s.db.ExecContext(ctx, query, libraryBooks[0].LibraryID, libraryBooks[0].BookID); err != nil {
-		logger.Error("update library books table in db", "error", err)
-		return nil, fmt.Errorf("update library books table in db: %w", err)
+	isbn10, _ := isbn13To10(isbn13)
+
+	query := bookQuery + ` where b.isbn13 = $1 or b.isbn10 = $2`
+
+	var books []book
+	if err := s.db.SelectContext(ctx, &books, query, isbn13, isbn10); err != nil {
+		logger.Error("select books from db", "error", err)
+		return nil, fmt.Errorf("select book from db: %w", err)
+	}
+
+	if len(books) == 0 {
+		return generated.GetBookByIsbn404JSONResponse{
+			Message: "book not found",
+		}, nil
 	}
 
-	return generated.TakeBook204Response{}, nil
+	return generated.GetBookByIsbn200JSONResponse(toBookInfo(books[0])), nil
 }
 
-func (s *Server) ReturnBook(ctx context.Context, request generated.ReturnBookRequestObject) (generated.ReturnBookResponseObject, error) {
-	logger := slog.With("handler", "ReturnBook")
-	query := `select l.id as library_id, b.id as book_id, lb.available_count from
-		books b
-		join library_books lb on b.id = lb.book_id
-		join library l on l.id = lb.library_id
-		where l.library_uid = $1 and b.book_uid = $2`
+func (s *Server) GetBookCover(ctx context.Context, request generated.GetBookCoverRequestObject) (generated.GetBookCoverResponseObject, error) {
+	logger := slog.With("handler", "GetBookCover")
+	query := bookQuery + ` where b.book_uid = $1`
 
-	var libraryBooks []libraryBookRaw
-	if err := s.db.SelectContext(ctx, &libraryBooks, query, request.LibraryUid, request.BookUid); err != nil {
-		logger.Error("select library books from db", "error", err)
-		return nil, fmt.Errorf("select library books from db: %w", err)
+	var books []book
+	if err := s.db.SelectContext(ctx, &books, query, request.BookUid); err != nil {
+		logger.Error("select book from db", "error", err)
+		return nil, fmt.Errorf("select book from db: %w", err)
 	}
 
-	if len(libraryBooks) == 0 {
-		logger.Warn("no book presented in library")
-		return generated.ReturnBook400JSONResponse{
-			Message: "book not presented in this library",
+	if len(books) == 0 || books[0].CoverHash == nil {
+		return generated.GetBookCover404JSONResponse{
+			Message: "cover not found",
+		}, nil
+	}
+
+	b := books[0]
+	thumbnail := lo.FromPtr(request.Params.Size) == generated.Thumbnail
+	etag := coverETag(*b.CoverHash, thumbnail)
+
+	if lo.FromPtr(request.Params.IfNoneMatch) == etag {
+		return generated.GetBookCover304Response{
+			Headers: generated.GetBookCover304ResponseHeaders{
+				CacheControl: coverCacheControl,
+				ETag:         etag,
+			},
+		}, nil
+	}
+
+	body, err := s.covers.Get(ctx, coverKey(b.BookUID, *b.CoverHash, thumbnail))
+	if errors.Is(err, blob.ErrNotFound) {
+		logger.Warn("cover blob is missing", "book", b.BookUID)
+		return generated.GetBookCover404JSONResponse{
+			Message: "cover not found",
+		}, nil
+	}
+
+	if err != nil {
+		logger.Error("get cover from store", "error", err)
+		return nil, fmt.Errorf("get cover from store: %w", err)
+	}
+
+	contentType := *b.CoverContentType
+	if thumbnail {
+		contentType = thumbnailFormat
+	}
+
+	return generated.GetBookCover200ImageResponse{
+		Body:        body,
+		ContentType: contentType,
+		Headers: generated.GetBookCover200ResponseHeaders{
+			CacheControl: coverCacheControl,
+			ETag:         etag,
+		},
+	}, nil
+}
+
+func (s *Server) SetBookCover(ctx context.Context, request generated.SetBookCoverRequestObject) (generated.SetBookCoverResponseObject, error) {
+	logger := slog.With("handler", "SetBookCover")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.SetBookCover403JSONResponse{
+			Message: "only library staff can change covers",
 		}, nil
 	}
 
-	query = `update library_books set available_count = available_count + 1 where library_id = $1 and book_id = $2`
-	if _, err := s.db.ExecContext(ctx, query, libraryBooks[0].LibraryID, libraryBooks[0].BookID); err != nil {
-		logger.Error("update library books table in db", "error", err)
-		return nil, fmt.Errorf("update library books table in db: %w", err)
+	data, err := io.ReadAll(io.LimitReader(request.Body, s.maxCoverSize+1))
+	if err != nil {
+		logger.Error("read cover", "error", err)
+		return nil, fmt.Errorf("read cover: %w", err)
+	}
+
+	if int64(len(data)) > s.maxCoverSize {
+		return generated.SetBookCover413JSONResponse{
+			Message: fmt.Sprintf("cover must be at most %d bytes", s.maxCoverSize),
+		}, nil
 	}
 
-	query = `select * from books where book_uid = $1`
+	query := bookQuery + ` where b.book_uid = $1`
 
 	var books []book
 	if err := s.db.SelectContext(ctx, &books, query, request.BookUid); err != nil {
-		logger.Error("select books from db", "error", err)
+		logger.Error("select book from db", "error", err)
 		return nil, fmt.Errorf("select book from db: %w", err)
 	}
 
 	if len(books) == 0 {
-		logger.Error("book not found")
-		return nil, fmt.Errorf("book not found")
+		return generated.SetBookCover404JSONResponse{
+			Message: "book not found",
+		}, nil
 	}
 
-	return generated.ReturnBook200JSONResponse{
-		Violation: books[0].Condition != string(request.Body.Condition),
-	}, nil
+	c, err := newCover(data)
+	if errors.Is(err, errCoverTooLarge) {
+		return generated.SetBookCover413JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if errors.Is(err, errUnsupportedCover) {
+		return generated.SetBookCover415JSONResponse{
+			Message: err.Error(),
+		}, nil
+	}
+
+	if err != nil {
+		logger.Error("process cover", "error", err)
+		return nil, fmt.Errorf("process cover: %w", err)
+	}
+
+	b := books[0]
+	if err := s.covers.Put(ctx, coverKey(b.BookUID, c.hash, false), bytes.NewReader(c.original)); err != nil {
+		logger.Error("put cover to store", "error", err)
+		return nil, fmt.Errorf("put cover to store: %w", err)
+	}
+
+	if err := s.covers.Put(ctx, coverKey(b.BookUID, c.hash, true), bytes.NewReader(c.thumbnail)); err != nil {
+		logger.Error("put thumbnail to store", "error", err)
+		return nil, fmt.Errorf("put thumbnail to store: %w", err)
+	}
+
+	query = `update books set cover_hash = $2, cover_content_type = $3, cover_updated_at = now() where id = $1`
+	if _, err := s.db.ExecContext(ctx, query, b.ID, c.hash, c.contentType); err != nil {
+		logger.Error("update book cover", "error", err)
+		return nil, fmt.Errorf("update book cover: %w", err)
+	}
+
+	if b.CoverHash != nil && *b.CoverHash != c.hash {
+		s.deleteCover(ctx, b.BookUID, *b.CoverHash)
+	}
+
+	b.CoverHash = &c.hash
+	b.CoverContentType = &c.contentType
+
+	return generated.SetBookCover200JSONResponse(toBookInfo(b)), nil
+}
+
+func (s *Server) DeleteBookCover(ctx context.Context, request generated.DeleteBookCoverRequestObject) (generated.DeleteBookCoverResponseObject, error) {
+	logger := slog.With("handler", "DeleteBookCover")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.DeleteBookCover403JSONResponse{
+			Message: "only library staff can change covers",
+		}, nil
+	}
+
+	query := `update books b set cover_hash = null, cover_content_type = null, cover_updated_at = now()
+		from books old
+		where old.id = b.id and b.book_uid = $1
+		returning old.cover_hash`
+
+	var hashes []*string
+	if err := s.db.SelectContext(ctx, &hashes, query, request.BookUid); err != nil {
+		logger.Error("update book cover", "error", err)
+		return nil, fmt.Errorf("update book cover: %w", err)
+	}
+
+	if len(hashes) == 0 {
+		return generated.DeleteBookCover404JSONResponse{
+			Message: "book not found",
+		}, nil
+	}
+
+	if hashes[0] != nil {
+		s.deleteCover(ctx, request.BookUid, *hashes[0])
+	}
+
+	return generated.DeleteBookCover204Response{}, nil
 }
 
-func pagination(query string, page, pageSize *int) string {
-	if pageSize == nil {
-		return query
+func (s *Server) ListLibraries(ctx context.Context, request generated.ListLibrariesRequestObject) (generated.ListLibrariesResponseObject, error) {
+	logger := slog.With("handler", "ListLibraries")
+
+	page, sort, verr := newListPage(librarySorts, request.Params.Page, request.Params.Size,
+		string(lo.FromPtrOr(request.Params.Sort, generated.ListLibrariesParamsSortName)),
+		string(lo.FromPtrOr(request.Params.Order, generated.ListLibrariesParamsOrderAsc)),
+		request.Params.Cursor,
+	)
+	if verr != nil {
+		logger.Warn("invalid list parameters", "errors", verr.Errors)
+		return generated.ListLibraries400JSONResponse(*verr), nil
+	}
+
+	var q listing.Query
+	var conditions []string
+	if request.Params.City != nil {
+		conditions = append(conditions, `city = `+q.Bind(*request.Params.City))
+	}
+
+	if request.Params.NamePrefix != nil {
+		conditions = append(conditions, `name ilike `+q.Bind(likePrefix(*request.Params.NamePrefix)))
+	}
+
+	filtered := `select * from library`
+	if len(conditions) > 0 {
+		filtered += ` where ` + strings.Join(conditions, " and ")
 	}
 
-	if page == nil {
-		page = new(int)
-		*page = 1
+	filterArgs := len(q.Args)
+	query := page.Keyset(&q, filtered, sort.Column)
+
+	var libraries []library
+	if err := s.db.SelectContext(ctx, &libraries, query, q.Args...); err != nil {
+		logger.Error("select libraries from db", "error", err)
+		return nil, fmt.Errorf("select libraries from db: %w", err)
 	}
 
-	return fmt.Sprintf("%s limit %d offset %d", query, (*pageSize), (*pageSize)*(*page-1))
+	query = `select count(*) from (` + filtered + `) t`
+	var count int
+	if err := s.db.QueryRowContext(ctx, query, q.Args[:filterArgs]...).Scan(&count); err != nil {
+		logger.Error("select count from db", "error", err)
+		return nil, fmt.Errorf("select count from db: %w", err)
+	}
+
+	libraries, next := listing.Trim(page, sort, libraries, func(item library) int {
+		return item.ID
+	})
+
+	return generated.ListLibraries200JSONResponse{
+		Items: lo.Map(libraries, func(item library, _ int) generated.LibraryResponse {
+			return toLibraryResponse(item)
+		}),
+		Page:          request.Params.Page,
+		PageSize:      request.Params.Size,
+		TotalElements: count,
+		NextCursor:    next,
+	}, nil
+}
+
+func (s *Server) ListNearbyLibraries(ctx context.Context, request generated.ListNearbyLibrariesRequestObject) (generated.ListNearbyLibrariesResponseObject, error) {
+	logger := slog.With("handler", "ListNearbyLibraries")
+
+	latitude, longitude := request.Params.Latitude, request.Params.Longitude
+	radius := lo.FromPtrOr(request.Params.Radius, defaultSearchRadius)
+
+	switch {
+	case latitude < -90 || latitude > 90:
+		return generated.ListNearbyLibraries400JSONResponse(*validationError("latitude", "latitude must be between -90 and 90")), nil
+	case longitude < -180 || longitude > 180:
+		return generated.ListNearbyLibraries400JSONResponse(*validationError("longitude", "longitude must be between -180 and 180")), nil
+	case radius < 0 || radius > maxSearchRadius:
+		return generated.ListNearbyLibraries400JSONResponse(*validationError("radius", fmt.Sprintf("radius must be between 0 and %v km", maxSearchRadius))), nil
+	}
+
+	page, verr := plainPage(request.Params.Page, request.Params.Size, "asc")
+	if verr != nil {
+		return generated.ListNearbyLibraries400JSONResponse(*verr), nil
+	}
+
+	var q listing.Query
+	lat := q.Bind(latitude) + `::double precision`
+	lon := q.Bind(longitude) + `::double precision`
+	r := q.Bind(radius) + `::double precision`
+
+	filtered := `select * from (
+		select l.*, 6371 * 2 * asin(least(1, sqrt(
+			power(sin(radians(l.latitude - ` + lat + `) / 2), 2) +
+			cos(radians(` + lat + `)) * cos(radians(l.latitude)) * power(sin(radians(l.longitude - ` + lon + `) / 2), 2)
+		))) as distance
+		from library l
+		where l.latitude between ` + lat + ` - ` + r + ` / 111.045 and ` + lat + ` + ` + r + ` / 111.045
+	) d where distance <= ` + r
+
+	filterArgs := len(q.Args)
+	query := page.Keyset(&q, filtered, "t.distance")
+
+	var libraries []nearbyLibrary
+	if err := s.db.SelectContext(ctx, &libraries, query, q.Args...); err != nil {
+		logger.Error("select libraries from db", "error", err)
+		return nil, fmt.Errorf("select libraries from db: %w", err)
+	}
+
+	query = `select count(*) from (` + filtered + `) t`
+	var count int
+	if err := s.db.QueryRowContext(ctx, query, q.Args[:filterArgs]...).Scan(&count); err != nil {
+		logger.Error("select count from db", "error", err)
+		return nil, fmt.Errorf("select count from db: %w", err)
+	}
+
+	libraries = listing.Cut(page, libraries)
+
+	return generated.ListNearbyLibraries200JSONResponse{
+		Items: lo.Map(libraries, func(item nearbyLibrary, _ int) generated.LibraryResponse {
+			resp := toLibraryResponse(item.library)
+			resp.Distance = &item.Distance
+			return resp
+		}),
+		Page:          request.Params.Page,
+		PageSize:      request.Params.Size,
+		TotalElements: count,
+	}, nil
+}
+
+func (s *Server) ListCities(ctx context.Context, request generated.ListCitiesRequestObject) (generated.ListCitiesResponseObject, error) {
+	logger := slog.With("handler", "ListCities")
+	query := `select city, count(*) as libraries_count from library group by city order by city`
+
+	var cities []city
+	if err := s.db.SelectContext(ctx, &cities, query); err != nil {
+		logger.Error("select cities from db", "error", err)
+		return nil, fmt.Errorf("select cities from db: %w", err)
+	}
+
+	return generated.ListCities200JSONResponse(lo.Map(cities, func(item city, _ int) generated.CityResponse {
+		return generated.CityResponse{
+			City:           item.City,
+			LibrariesCount: item.LibrariesCount,
+		}
+	})), nil
+}
+
+func (s *Server) GetLibrary(ctx context.Context, request generated.GetLibraryRequestObject) (generated.GetLibraryResponseObject, error) {
+	logger := slog.With("handler", "GetLibrary")
+
+	libraries, err := s.selectLibrary(ctx, request.LibraryUid)
+	if err != nil {
+		logger.Error("select library from db", "error", err)
+		return nil, fmt.Errorf("select library from db: %w", err)
+	}
+
+	if len(libraries) == 0 {
+		logger.Warn("library not found", "library", request.LibraryUid)
+		return generated.GetLibrary404JSONResponse{
+			Message: "library not found",
+		}, nil
+	}
+
+	return generated.GetLibrary200JSONResponse(toLibraryResponse(libraries[0])), nil
+}
+
+func (s *Server) GetLibrarySchedule(ctx context.Context, request generated.GetLibraryScheduleRequestObject) (generated.GetLibraryScheduleResponseObject, error) {
+	logger := slog.With("handler", "GetLibrarySchedule")
+
+	libraries, err := s.selectLibrary(ctx, request.LibraryUid)
+	if err != nil {
+		logger.Error("select library from db", "error", err)
+		return nil, fmt.Errorf("select library from db: %w", err)
+	}
+
+	if len(libraries) == 0 {
+		return generated.GetLibrarySchedule404JSONResponse{
+			Message: "library not found",
+		}, nil
+	}
+
+	resp, err := s.libraryScheduleResponse(ctx, libraries[0])
+	if err != nil {
+		logger.Error("get library schedule", "error", err)
+		return nil, fmt.Errorf("get library schedule: %w", err)
+	}
+
+	return generated.GetLibrarySchedule200JSONResponse(resp), nil
+}
+
+func (s *Server) SetOpeningHours(ctx context.Context, request generated.SetOpeningHoursRequestObject) (generated.SetOpeningHoursResponseObject, error) {
+	logger := slog.With("handler", "SetOpeningHours")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.SetOpeningHours403JSONResponse{
+			Message: "only library staff can change opening hours",
+		}, nil
+	}
+
+	if request.Body.Timezone != nil {
+		if _, err := time.LoadLocation(*request.Body.Timezone); err != nil {
+			return generated.SetOpeningHours400JSONResponse(*validationError("timezone", "unknown timezone")), nil
+		}
+	}
+
+	weekdays := make(map[int]bool, len(request.Body.OpeningHours))
+	for _, h := range request.Body.OpeningHours {
+		if h.Weekday < 1 || h.Weekday > 7 {
+			return generated.SetOpeningHours400JSONResponse(*validationError("weekday", "weekday must be between 1 and 7")), nil
+		}
+
+		if weekdays[h.Weekday] {
+			return generated.SetOpeningHours400JSONResponse(*validationError("weekday", fmt.Sprintf("weekday %d is duplicated", h.Weekday))), nil
+		}
+		weekdays[h.Weekday] = true
+
+		opens, err := time.Parse("15:04", h.OpensAt)
+		if err != nil {
+			return generated.SetOpeningHours400JSONResponse(*validationError("opensAt", "time must be in HH:MM format")), nil
+		}
+
+		closes, err := time.Parse("15:04", h.ClosesAt)
+		if err != nil {
+			return generated.SetOpeningHours400JSONResponse(*validationError("closesAt", "time must be in HH:MM format")), nil
+		}
+
+		if !opens.Before(closes) {
+			return generated.SetOpeningHours400JSONResponse(*validationError("closesAt", "library must close after it opens")), nil
+		}
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select * from library where library_uid = $1 for update`
+
+	var libraries []library
+	if err := tx.SelectContext(ctx, &libraries, query, request.LibraryUid); err != nil {
+		logger.Error("select library from db", "error", err)
+		return nil, fmt.Errorf("select library from db: %w", err)
+	}
+
+	if len(libraries) == 0 {
+		return generated.SetOpeningHours404JSONResponse{
+			Message: "library not found",
+		}, nil
+	}
+
+	lib := libraries[0]
+	if request.Body.Timezone != nil {
+		lib.Timezone = *request.Body.Timezone
+
+		query = `update library set timezone = $2 where id = $1`
+		if _, err := tx.ExecContext(ctx, query, lib.ID, lib.Timezone); err != nil {
+			logger.Error("update library timezone", "error", err)
+			return nil, fmt.Errorf("update library timezone: %w", err)
+		}
+	}
+
+	query = `delete from library_opening_hours where library_id = $1`
+	if _, err := tx.ExecContext(ctx, query, lib.ID); err != nil {
+		logger.Error("delete opening hours", "error", err)
+		return nil, fmt.Errorf("delete opening hours: %w", err)
+	}
+
+	for _, h := range request.Body.OpeningHours {
+		query = `insert into library_opening_hours (library_id, weekday, opens_at, closes_at) values ($1, $2, $3, $4)`
+		if _, err := tx.ExecContext(ctx, query, lib.ID, h.Weekday, h.OpensAt, h.ClosesAt); err != nil {
+			logger.Error("insert opening hours", "error", err)
+			return nil, fmt.Errorf("insert opening hours: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	resp, err := s.libraryScheduleResponse(ctx, lib)
+	if err != nil {
+		logger.Error("get library schedule", "error", err)
+		return nil, fmt.Errorf("get library schedule: %w", err)
+	}
+
+	return generated.SetOpeningHours200JSONResponse(resp), nil
+}
+
+func (s *Server) AddHoliday(ctx context.Context, request generated.AddHolidayRequestObject) (generated.AddHolidayResponseObject, error) {
+	logger := slog.With("handler", "AddHoliday")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.AddHoliday403JSONResponse{
+			Message: "only library staff can change holidays",
+		}, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, request.Body.Date)
+	if err != nil {
+		return generated.AddHoliday400JSONResponse(*validationError("date", "date must be in YYYY-MM-DD format")), nil
+	}
+
+	libraries, err := s.selectLibrary(ctx, request.LibraryUid)
+	if err != nil {
+		logger.Error("select library from db", "error", err)
+		return nil, fmt.Errorf("select library from db: %w", err)
+	}
+
+	if len(libraries) == 0 {
+		return generated.AddHoliday404JSONResponse{
+			Message: "library not found",
+		}, nil
+	}
+
+	query := `insert into library_holidays (library_id, date, reason) values ($1, $2, $3) on conflict do nothing`
+	res, err := s.db.ExecContext(ctx, query, libraries[0].ID, date, request.Body.Reason)
+	if err != nil {
+		logger.Error("insert holiday", "error", err)
+		return nil, fmt.Errorf("insert holiday: %w", err)
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return generated.AddHoliday409JSONResponse{
+			Message: "holiday already exists",
+		}, nil
+	}
+
+	return generated.AddHoliday201JSONResponse(*request.Body), nil
+}
+
+func (s *Server) DeleteHoliday(ctx context.Context, request generated.DeleteHolidayRequestObject) (generated.DeleteHolidayResponseObject, error) {
+	logger := slog.With("handler", "DeleteHoliday")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.DeleteHoliday403JSONResponse{
+			Message: "only library staff can change holidays",
+		}, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, request.Date)
+	if err != nil {
+		return generated.DeleteHoliday400JSONResponse(*validationError("date", "date must be in YYYY-MM-DD format")), nil
+	}
+
+	query := `delete from library_holidays h using library l
+		where l.id = h.library_id and l.library_uid = $1 and h.date = $2`
+	res, err := s.db.ExecContext(ctx, query, request.LibraryUid, date)
+	if err != nil {
+		logger.Error("delete holiday", "error", err)
+		return nil, fmt.Errorf("delete holiday: %w", err)
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return generated.DeleteHoliday404JSONResponse{
+			Message: "holiday not found",
+		}, nil
+	}
+
+	return generated.DeleteHoliday204Response{}, nil
+}
+
+func (s *Server) CheckDueDate(ctx context.Context, request generated.CheckDueDateRequestObject) (generated.CheckDueDateResponseObject, error) {
+	logger := slog.With("handler", "CheckDueDate")
+
+	requested, err := time.Parse(time.DateOnly, request.Params.Date)
+	if err != nil {
+		return generated.CheckDueDate400JSONResponse(*validationError("date", "date must be in YYYY-MM-DD format")), nil
+	}
+
+	libraries, err := s.selectLibrary(ctx, request.LibraryUid)
+	if err != nil {
+		logger.Error("select library from db", "error", err)
+		return nil, fmt.Errorf("select library from db: %w", err)
+	}
+
+	if len(libraries) == 0 {
+		return generated.CheckDueDate404JSONResponse{
+			Message: "library not found",
+		}, nil
+	}
+
+	now := time.Now()
+
+	sched, _, _, err := s.librarySchedule(ctx, libraries[0], lo.Ternary(requested.Before(now), requested, now))
+	if err != nil {
+		logger.Error("get library schedule", "error", err)
+		return nil, fmt.Errorf("get library schedule: %w", err)
+	}
+
+	dueDate, err := sched.nextOpenDay(requested)
+	if err != nil {
+		logger.Warn("library has no open days", "library", request.LibraryUid)
+		return generated.CheckDueDate400JSONResponse(*validationError("date", err.Error())), nil
+	}
+
+	resp := generated.CheckDueDate200JSONResponse{
+		RequestedDate: request.Params.Date,
+		DueDate:       dueDate.Format(time.DateOnly),
+		OpenNow:       sched.openAt(now),
+	}
+
+	if !resp.OpenNow {
+		if opening, err := sched.nextOpening(now); err == nil {
+			resp.NextOpening = &opening
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *Server) ListBooks(ctx context.Context, request generated.ListBooksRequestObject) (generated.ListBooksResponseObject, error) {
+	logger := slog.With("handler", "ListBooks")
+
+	page, sort, verr := newListPage(libraryBookSorts, request.Params.Page, request.Params.Size,
+		string(lo.FromPtrOr(request.Params.Sort, generated.ListBooksParamsSortName)),
+		string(lo.FromPtrOr(request.Params.Order, generated.ListBooksParamsOrderAsc)),
+		request.Params.Cursor,
+	)
+	if verr != nil {
+		logger.Warn("invalid list parameters", "errors", verr.Errors)
+		return generated.ListBooks400JSONResponse(*verr), nil
+	}
+
+	libraries, err := s.selectLibrary(ctx, request.LibraryUid)
+	if err != nil {
+		logger.Error("select library from db", "error", err)
+		return nil, fmt.Errorf("select library from db: %w", err)
+	}
+
+	if len(libraries) == 0 {
+		logger.Warn("library not found", "library", request.LibraryUid)
+		return generated.ListBooks404JSONResponse{
+			Message: "library not found",
+		}, nil
+	}
+
+	var q listing.Query
+	filtered := `
+	select ` + bookColumns + `,
+		count(c.id) filter (where c.status = 'AVAILABLE') as available_count,
+		(array_agg(c.condition order by c.status = 'AVAILABLE' desc, ` + copyConditionOrder + `))[1] as condition
+	from books b
+		` + bookJoins + `
+		join book_copies c on b.id = c.book_id
+		join library l on l.id = c.library_id
+	where l.library_uid = ` + q.Bind(request.LibraryUid)
+
+	if request.Params.Genre != nil {
+		filtered += ` and lower(b.genre) = lower(` + q.Bind(*request.Params.Genre) + `)`
+	}
+
+	if request.Params.Author != nil {
+		filtered += ` and b.author ilike ` + q.Bind(likeContains(*request.Params.Author))
+	}
+
+	if request.Params.NamePrefix != nil {
+		filtered += ` and b.name ilike ` + q.Bind(likePrefix(*request.Params.NamePrefix))
+	}
+
+	filtered += ` group by b.id, w.id, s.id`
+
+	var having []string
+	if !lo.FromPtr(request.Params.ShowAll) {
+		having = append(having, `count(c.id) filter (where c.status = 'AVAILABLE') > 0`)
+	}
+
+	if request.Params.Condition != nil {
+		having = append(having, `count(c.id) filter (where c.condition = `+q.Bind(string(*request.Params.Condition))+`) > 0`)
+	}
+
+	if len(having) > 0 {
+		filtered += ` having ` + strings.Join(having, " and ")
+	}
+
+	filterArgs := len(q.Args)
+	query := page.Keyset(&q, filtered, sort.Column)
+
+	var books []libraryBook
+	if err := s.db.SelectContext(ctx, &books, query, q.Args...); err != nil {
+		logger.Error("select books from db", "error", err)
+		return nil, fmt.Errorf("select books from db: %w", err)
+	}
+
+	query = `select count(*) from (` + filtered + `) t`
+	var count int
+	if err := s.db.QueryRowContext(ctx, query, q.Args[:filterArgs]...).Scan(&count); err != nil {
+		logger.Error("select count from db", "error", err)
+		return nil, fmt.Errorf("select count from db: %w", err)
+	}
+
+	books, next := listing.Trim(page, sort, books, func(item libraryBook) int {
+		return item.ID
+	})
+
+	return generated.ListBooks200JSONResponse{
+		Items: lo.Map(books, func(item libraryBook, _ int) generated.LibraryBookResponse {
+			coverURL, coverThumbnailURL := coverURLs(item.BookUID, item.CoverHash)
+
+			return generated.LibraryBookResponse{
+				Author:            item.Author,
+				AvailableCount:    item.AvailableCount,
+				BookUid:           item.BookUID,
+				Condition:         generated.LibraryBookResponseCondition(item.Condition),
+				Genre:             item.Genre,
+				Name:              item.Name,
+				Isbn10:            item.ISBN10,
+				Isbn13:            item.ISBN13,
+				PublicationYear:   item.PublicationYear,
+				Publisher:         item.Publisher,
+				Language:          item.Language,
+				PageCount:         item.PageCount,
+				Description:       item.Description,
+				CoverUrl:          coverURL,
+				CoverThumbnailUrl: coverThumbnailURL,
+			}
+		}),
+		Page:          request.Params.Page,
+		PageSize:      request.Params.Size,
+		TotalElements: count,
+		NextCursor:    next,
+	}, nil
+}
+
+func (s *Server) ListBookCopies(ctx context.Context, request generated.ListBookCopiesRequestObject) (generated.ListBookCopiesResponseObject, error) {
+	logger := slog.With("handler", "ListBookCopies")
+	query := `select c.*, b.book_uid, l.library_uid from
+		book_copies c
+		join books b on b.id = c.book_id
+		join library l on l.id = c.library_id
+		where l.library_uid = $1 and b.book_uid = $2
+		order by c.id`
+
+	var copies []bookCopyInfo
+	if err := s.db.SelectContext(ctx, &copies, query, request.LibraryUid, request.BookUid); err != nil {
+		logger.Error("select book copies from db", "error", err)
+		return nil, fmt.Errorf("select book copies from db: %w", err)
+	}
+
+	if len(copies) == 0 {
+		query = `select count(*) from library l, books b where l.library_uid = $1 and b.book_uid = $2`
+
+		var count int
+		if err := s.db.QueryRowContext(ctx, query, request.LibraryUid, request.BookUid).Scan(&count); err != nil {
+			logger.Error("select count from db", "error", err)
+			return nil, fmt.Errorf("select count from db: %w", err)
+		}
+
+		if count == 0 {
+			return generated.ListBookCopies404JSONResponse{
+				Message: "library or book not found",
+			}, nil
+		}
+	}
+
+	return generated.ListBookCopies200JSONResponse(lo.Map(copies, func(item bookCopyInfo, _ int) generated.BookCopyResponse {
+		return toBookCopyResponse(item)
+	})), nil
+}
+
+func (s *Server) TakeBook(ctx context.Context, request generated.TakeBookRequestObject) (generated.TakeBookResponseObject, error) {
+	logger := slog.With("handler", "TakeBook")
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if request.Params.ReservationUid != nil {
+		bookings, err := lockBooking(ctx, tx, *request.Params.ReservationUid, bookingBooked)
+		if err != nil {
+			logger.Error("select booking from db", "error", err)
+			return nil, fmt.Errorf("select booking from db: %w", err)
+		}
+
+		if len(bookings) > 0 {
+			return s.takeBookedCopy(ctx, tx, bookings[0])
+		}
+	}
+
+	query := holdInfoQuery + ` where l.library_uid = $1 and b.book_uid = $2 and h.username = $3 and h.status = 'READY' for update of h`
+
+	var ready []holdInfo
+	if err := tx.SelectContext(ctx, &ready, query, request.LibraryUid, request.BookUid, contextutils.GetUser(ctx)); err != nil {
+		logger.Error("select ready holds from db", "error", err)
+		return nil, fmt.Errorf("select ready holds from db: %w", err)
+	}
+
+	if len(ready) > 0 {
+		return s.takeHeldCopy(ctx, tx, ready[0], request)
+	}
+
+	query = `select c.*, b.book_uid, l.library_uid from
+		book_copies c
+		join books b on b.id = c.book_id
+		join library l on l.id = c.library_id
+		where l.library_uid = $1 and b.book_uid = $2 and c.status = 'AVAILABLE'
+		order by ` + copyConditionOrder + `, c.id
+		limit 1
+		for update of c skip locked`
+
+	var copies []bookCopyInfo
+	if err := tx.SelectContext(ctx, &copies, query, request.LibraryUid, request.BookUid); err != nil {
+		logger.Error("select book copies from db", "error", err)
+		return nil, fmt.Errorf("select book copies from db: %w", err)
+	}
+
+	if len(copies) == 0 {
+		query = `select count(*) from
+			book_copies c
+			join books b on b.id = c.book_id
+			join library l on l.id = c.library_id
+			where l.library_uid = $1 and b.book_uid = $2`
+
+		var count int
+		if err := tx.QueryRowContext(ctx, query, request.LibraryUid, request.BookUid).Scan(&count); err != nil {
+			logger.Error("select count from db", "error", err)
+			return nil, fmt.Errorf("select count from db: %w", err)
+		}
+
+		if count == 0 {
+			logger.Warn("no book presented in library")
+			return generated.TakeBook404JSONResponse{
+				Message: "book not presented in this library",
+			}, nil
+		}
+
+		logger.Warn("0 available books in library")
+		return generated.TakeBook409JSONResponse{
+			Message: "there is 0 available books in library",
+		}, nil
+	}
+
+	taken := copies[0]
+	taken.Status = copyRented
+	taken.ReservationUID = request.Params.ReservationUid
+
+	query = `update book_copies set status = $2, reservation_uid = $3, rented_at = now() where id = $1`
+	if _, err := tx.ExecContext(ctx, query, taken.ID, taken.Status, taken.ReservationUID); err != nil {
+		logger.Error("update book copies table in db", "error", err)
+		return nil, fmt.Errorf("update book copies table in db: %w", err)
+	}
+
+	if err := countCirculation(ctx, tx, circulation{
+		LibraryID: taken.LibraryID,
+		BookID:    taken.BookID,
+		Checkouts: 1,
+	}); err != nil {
+		logger.Error("count circulation", "error", err)
+		return nil, fmt.Errorf("count circulation: %w", err)
+	}
+
+	if _, err := recordMovement(ctx, tx, stockMovement{
+		LibraryID:      taken.LibraryID,
+		BookID:         taken.BookID,
+		CopyID:         &taken.ID,
+		Actor:          contextutils.GetUser(ctx),
+		Reason:         movementCheckout,
+		Delta:          -1,
+		CorrelationUID: taken.ReservationUID,
+	}); err != nil {
+		logger.Error("record stock movement", "error", err)
+		return nil, fmt.Errorf("record stock movement: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.TakeBook200JSONResponse(toBookCopyResponse(taken)), nil
+}
+
+func (s *Server) ReturnBook(ctx context.Context, request generated.ReturnBookRequestObject) (generated.ReturnBookResponseObject, error) {
+	logger := slog.With("handler", "ReturnBook")
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select c.*, b.book_uid, l.library_uid from
+		book_copies c
+		join books b on b.id = c.book_id
+		join library l on l.id = c.library_id
+		where l.library_uid = $1 and b.book_uid = $2 and c.status = 'RENTED'`
+	args := []any{request.LibraryUid, request.BookUid}
+
+	switch {
+	case request.Body.CopyUid != nil:
+		query += ` and c.copy_uid = $3 order by c.id`
+		args = append(args, *request.Body.CopyUid)
+	case request.Params.ReservationUid != nil:
+		query += ` and c.reservation_uid = $3 order by c.id`
+		args = append(args, *request.Params.ReservationUid)
+	default:
+		query += ` order by c.reservation_uid is null desc, c.id`
+	}
+
+	query += ` limit 1 for update of c`
+
+	var copies []bookCopyInfo
+	if err := tx.SelectContext(ctx, &copies, query, args...); err != nil {
+		logger.Error("select book copies from db", "error", err)
+		return nil, fmt.Errorf("select book copies from db: %w", err)
+	}
+
+	if len(copies) == 0 && request.Body.CopyUid == nil && request.Params.ReservationUid != nil {
+		copies, err = untrackedCopy(ctx, tx, request.LibraryUid, request.BookUid, *request.Params.ReservationUid)
+		if err != nil {
+			logger.Error("select untracked copy from db", "error", err)
+			return nil, fmt.Errorf("select untracked copy from db: %w", err)
+		}
+	}
+
+	if len(copies) == 0 {
+		var presented bool
+		query = `select exists(select 1 from library l, books b where l.library_uid = $1 and b.book_uid = $2)`
+		if err := tx.GetContext(ctx, &presented, query, request.LibraryUid, request.BookUid); err != nil {
+			logger.Error("select library and book from db", "error", err)
+			return nil, fmt.Errorf("select library and book from db: %w", err)
+		}
+
+		if !presented {
+			return generated.ReturnBook404JSONResponse{
+				Message: "book not presented in this library",
+			}, nil
+		}
+
+		if request.Params.ReservationUid != nil {
+			// The copy may be already returned by the previous attempt, which
+			// failed to close the reservation.
+			var returnedBefore bool
+			query = `select exists(select 1 from stock_movements where correlation_uid = $1 and reason = $2)`
+			if err := tx.GetContext(ctx, &returnedBefore, query, *request.Params.ReservationUid, movementReturn); err != nil {
+				logger.Error("select stock movements from db", "error", err)
+				return nil, fmt.Errorf("select stock movements from db: %w", err)
+			}
+
+			if returnedBefore {
+				query = `select * from copy_condition_history where reservation_uid = $1 order by changed_at desc, id desc limit 1`
+
+				var changes []conditionChange
+				if err := tx.SelectContext(ctx, &changes, query, *request.Params.ReservationUid); err != nil {
+					logger.Error("select condition changes from db", "error", err)
+					return nil, fmt.Errorf("select condition changes from db: %w", err)
+				}
+
+				return generated.ReturnBook200JSONResponse{
+					Violation: len(changes) > 0 && degraded(changes[0].OldCondition, changes[0].NewCondition),
+				}, nil
+			}
+		}
+
+		return generated.ReturnBook409JSONResponse{
+			Message: "no rented copy of the book in this library",
+		}, nil
+	}
+
+	returned := copies[0]
+	condition := string(request.Body.Condition)
+
+	reservationUID := returned.ReservationUID
+	if reservationUID == nil {
+		reservationUID = request.Params.ReservationUid
+	}
+
+	query = `update book_copies set status = $2, condition = $3, reservation_uid = null, rented_at = null where id = $1`
+	if _, err := tx.ExecContext(ctx, query, returned.ID, copyAvailable, condition); err != nil {
+		logger.Error("update book copies table in db", "error", err)
+		return nil, fmt.Errorf("update book copies table in db: %w", err)
+	}
+
+	returnedCirculation := circulation{
+		LibraryID: returned.LibraryID,
+		BookID:    returned.BookID,
+		Returns:   1,
+	}
+
+	if returned.RentedAt != nil {
+		returnedCirculation.LoanSeconds = int64(time.Since(*returned.RentedAt).Seconds())
+		returnedCirculation.CompletedLoans = 1
+	}
+
+	if err := countCirculation(ctx, tx, returnedCirculation); err != nil {
+		logger.Error("count circulation", "error", err)
+		return nil, fmt.Errorf("count circulation: %w", err)
+	}
+
+	if _, err := recordMovement(ctx, tx, stockMovement{
+		LibraryID:      returned.LibraryID,
+		BookID:         returned.BookID,
+		CopyID:         &returned.ID,
+		Actor:          contextutils.GetUser(ctx),
+		Reason:         movementReturn,
+		Delta:          1,
+		CorrelationUID: reservationUID,
+	}); err != nil {
+		logger.Error("record stock movement", "error", err)
+		return nil, fmt.Errorf("record stock movement: %w", err)
+	}
+
+	if returned.Condition != condition {
+		change := conditionChange{
+			CopyID:         returned.ID,
+			OldCondition:   returned.Condition,
+			NewCondition:   condition,
+			ReservationUID: reservationUID,
+			ChangedBy:      contextutils.GetUser(ctx),
+		}
+
+		query = `insert into copy_condition_history (copy_id, old_condition, new_condition, reservation_uid, changed_by)
+			values (:copy_id, :old_condition, :new_condition, :reservation_uid, :changed_by)`
+		if _, err := tx.NamedExecContext(ctx, query, change); err != nil {
+			logger.Error("insert condition change", "error", err)
+			return nil, fmt.Errorf("insert condition change: %w", err)
+		}
+	}
+
+	if err := s.promoteHolds(ctx, tx, returned.LibraryID, returned.BookID); err != nil {
+		logger.Error("promote holds", "error", err)
+		return nil, fmt.Errorf("promote holds: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.ReturnBook200JSONResponse{
+		Violation: degraded(returned.Condition, condition),
+	}, nil
+}
+
+func (s *Server) ReleaseBook(ctx context.Context, request generated.ReleaseBookRequestObject) (generated.ReleaseBookResponseObject, error) {
+	logger := slog.With("handler", "ReleaseBook")
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	bookings, err := lockBooking(ctx, tx, request.Params.ReservationUid, bookingBooked)
+	if err != nil {
+		logger.Error("select booking from db", "error", err)
+		return nil, fmt.Errorf("select booking from db: %w", err)
+	}
+
+	if len(bookings) > 0 {
+		return s.releaseBookedCopy(ctx, tx, bookings[0])
+	}
+
+	if lo.FromPtr(request.Params.Rebook) {
+		bookings, err := lockBooking(ctx, tx, request.Params.ReservationUid, bookingPickedUp)
+		if err != nil {
+			logger.Error("select booking from db", "error", err)
+			return nil, fmt.Errorf("select booking from db: %w", err)
+		}
+
+		if len(bookings) > 0 {
+			return s.rebookCopy(ctx, tx, bookings[0])
+		}
+	}
+
+	query := `select c.*, b.book_uid, l.library_uid from
+		book_copies c
+		join books b on b.id = c.book_id
+		join library l on l.id = c.library_id
+		where l.library_uid = $1 and b.book_uid = $2 and c.status = 'RENTED' and c.reservation_uid = $3
+		for update of c`
+
+	var copies []bookCopyInfo
+	if err := tx.SelectContext(ctx, &copies, query, request.LibraryUid, request.BookUid, request.Params.ReservationUid); err != nil {
+		logger.Error("select book copies from db", "error", err)
+		return nil, fmt.Errorf("select book copies from db: %w", err)
+	}
+
+	if len(copies) == 0 {
+		return generated.ReleaseBook404JSONResponse{
+			Message: "copy rented by reservation not found",
+		}, nil
+	}
+
+	released := copies[0]
+	released.Status = copyAvailable
+	released.ReservationUID = nil
+	released.RentedAt = nil
+
+	query = `update book_copies set status = $2, reservation_uid = null, rented_at = null where id = $1`
+	if _, err := tx.ExecContext(ctx, query, released.ID, released.Status); err != nil {
+		logger.Error("update book copies table in db", "error", err)
+		return nil, fmt.Errorf("update book copies table in db: %w", err)
+	}
+
+	if err := countCirculation(ctx, tx, circulation{
+		LibraryID: released.LibraryID,
+		BookID:    released.BookID,
+		Checkouts: -1,
+	}); err != nil {
+		logger.Error("count circulation", "error", err)
+		return nil, fmt.Errorf("count circulation: %w", err)
+	}
+
+	if _, err := recordMovement(ctx, tx, stockMovement{
+		LibraryID:      released.LibraryID,
+		BookID:         released.BookID,
+		CopyID:         &released.ID,
+		Actor:          contextutils.GetUser(ctx),
+		Reason:         movementCancel,
+		Delta:          1,
+		CorrelationUID: &request.Params.ReservationUid,
+	}); err != nil {
+		logger.Error("record stock movement", "error", err)
+		return nil, fmt.Errorf("record stock movement: %w", err)
+	}
+
+	if err := s.promoteHolds(ctx, tx, released.LibraryID, released.BookID); err != nil {
+		logger.Error("promote holds", "error", err)
+		return nil, fmt.Errorf("promote holds: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.ReleaseBook200JSONResponse(toBookCopyResponse(released)), nil
+}
+
+func (s *Server) BookCopyForPickup(ctx context.Context, request generated.BookCopyForPickupRequestObject) (generated.BookCopyForPickupResponseObject, error) {
+	logger := slog.With("handler", "BookCopyForPickup")
+
+	pickupDate, err := time.Parse(time.DateOnly, request.Body.PickupDate)
+	if err != nil {
+		return generated.BookCopyForPickup400JSONResponse(*validationError("pickupDate", "invalid pickup date format")), nil
+	}
+
+	if !request.Body.ExpiresAt.After(pickupDate) {
+		return generated.BookCopyForPickup400JSONResponse(*validationError("expiresAt", "booking must expire after pickup date")), nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select pg_advisory_xact_lock(l.id, b.id) from library l, books b where l.library_uid = $1 and b.book_uid = $2`
+	if _, err := tx.ExecContext(ctx, query, request.LibraryUid, request.BookUid); err != nil {
+		logger.Error("lock stock", "error", err)
+		return nil, fmt.Errorf("lock stock: %w", err)
+	}
+
+	// Bookings, which overlap with the new one, need a copy each at the same
+	// time, so there can not be more of them than copies of the book.
+	query = `select l.id as library_id, b.id as book_id,
+			(now() at time zone l.timezone)::date as today,
+			count(c.id) filter (where c.status <> 'WITHDRAWN') as total,
+			(select count(*) from copy_bookings cb
+				where cb.library_id = l.id and cb.book_id = b.id and cb.status = 'BOOKED'
+				  and cb.pickup_date <= $4 and cb.expires_at >= $3) as booked
+		from library l
+			join books b on b.book_uid = $2
+			left join book_copies c on c.library_id = l.id and c.book_id = b.id
+		where l.library_uid = $1
+		group by l.id, b.id`
+
+	var stock []bookingStock
+	if err := tx.SelectContext(ctx, &stock, query, request.LibraryUid, request.BookUid, pickupDate, request.Body.ExpiresAt); err != nil {
+		logger.Error("select book copies from db", "error", err)
+		return nil, fmt.Errorf("select book copies from db: %w", err)
+	}
+
+	if len(stock) == 0 || stock[0].Total == 0 {
+		return generated.BookCopyForPickup404JSONResponse{
+			Message: "book not presented in this library",
+		}, nil
+	}
+
+	if stock[0].Booked >= stock[0].Total {
+		return generated.BookCopyForPickup409JSONResponse{
+			Message: "all copies of the book are booked for these dates",
+		}, nil
+	}
+
+	query = `insert into copy_bookings (reservation_uid, library_id, book_id, username, pickup_date, status, expires_at)
+		values ($1, $2, $3, $4, $5, $6, $7)
+		returning *`
+
+	var booking copyBooking
+	if err := tx.GetContext(ctx, &booking, query, request.Params.ReservationUid, stock[0].LibraryID, stock[0].BookID, contextutils.GetUser(ctx), pickupDate, bookingBooked, request.Body.ExpiresAt); err != nil {
+		logger.Error("insert booking", "error", err)
+		return nil, fmt.Errorf("insert booking: %w", err)
+	}
+
+	// The copy is set aside from the pickup date, later bookings get it from
+	// the background job on that date.
+	var booked *bookCopyInfo
+	if !pickupDate.After(stock[0].Today) {
+		booked, err = setAsideBookedCopy(ctx, tx, &booking, contextutils.GetUser(ctx))
+		if err != nil {
+			logger.Error("set aside booked copy", "error", err)
+			return nil, fmt.Errorf("set aside booked copy: %w", err)
+		}
+
+		if booked == nil {
+			return generated.BookCopyForPickup409JSONResponse{
+				Message: "there is 0 available books in library",
+			}, nil
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.BookCopyForPickup201JSONResponse(toBookingResponse(booking, request.LibraryUid, request.BookUid, booked)), nil
+}
+
+func (s *Server) PlaceHold(ctx context.Context, request generated.PlaceHoldRequestObject) (generated.PlaceHoldResponseObject, error) {
+	logger := slog.With("handler", "PlaceHold")
+
+	rating := lo.FromPtr(request.Body.Rating)
+	if rating < 0 || rating > 100 {
+		return generated.PlaceHold400JSONResponse(*validationError("rating", "rating must be between 0 and 100")), nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Copies become available only together with a stock movement, which
+	// takes the same lock. So a copy returned concurrently is either seen by
+	// the check below or promoted to the new hold after it is committed.
+	query := `select pg_advisory_xact_lock(l.id, b.id) from library l, books b where l.library_uid = $1 and b.book_uid = $2`
+	if _, err := tx.ExecContext(ctx, query, request.LibraryUid, request.BookUid); err != nil {
+		logger.Error("lock stock", "error", err)
+		return nil, fmt.Errorf("lock stock: %w", err)
+	}
+
+	query = `select l.id as library_id, b.id as book_id,
+			count(c.id) as total,
+			count(c.id) filter (where c.status = 'AVAILABLE') as available
+		from library l
+			join books b on b.book_uid = $2
+			left join book_copies c on c.library_id = l.id and c.book_id = b.id
+		where l.library_uid = $1
+		group by l.id, b.id`
+
+	var stock []holdStock
+	if err := tx.SelectContext(ctx, &stock, query, request.LibraryUid, request.BookUid); err != nil {
+		logger.Error("select book copies from db", "error", err)
+		return nil, fmt.Errorf("select book copies from db: %w", err)
+	}
+
+	if len(stock) == 0 || stock[0].Total == 0 {
+		return generated.PlaceHold404JSONResponse{
+			Message: "book not presented in this library",
+		}, nil
+	}
+
+	if stock[0].Available > 0 {
+		return generated.PlaceHold409JSONResponse{
+			Message: "book is available, take it instead of waiting",
+		}, nil
+	}
+
+	query = `insert into holds (library_id, book_id, username, rating, priority)
+		values ($1, $2, $3, $4, now() - make_interval(secs => $5))
+		on conflict (library_id, book_id, username) where status in ('WAITING', 'READY') do nothing
+		returning hold_uid`
+
+	var holdUIDs []uuid.UUID
+	if err := tx.SelectContext(ctx, &holdUIDs, query, stock[0].LibraryID, stock[0].BookID, contextutils.GetUser(ctx), rating, float64(rating)*s.holds.RatingWeight.Seconds()); err != nil {
+		logger.Error("insert hold", "error", err)
+		return nil, fmt.Errorf("insert hold: %w", err)
+	}
+
+	if len(holdUIDs) == 0 {
+		return generated.PlaceHold409JSONResponse{
+			Message: "user already waits for this book",
+		}, nil
+	}
+
+	var placed holdInfo
+	if err := tx.GetContext(ctx, &placed, holdInfoQuery+` where h.hold_uid = $1`, holdUIDs[0]); err != nil {
+		logger.Error("select hold from db", "error", err)
+		return nil, fmt.Errorf("select hold from db: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.PlaceHold201JSONResponse(toHoldResponse(placed)), nil
+}
+
+func (s *Server) GetHoldQueue(ctx context.Context, request generated.GetHoldQueueRequestObject) (generated.GetHoldQueueResponseObject, error) {
+	logger := slog.With("handler", "GetHoldQueue")
+
+	query := `select count(*) from holds h
+		join books b on b.id = h.book_id
+		join library l on l.id = h.library_id
+		where l.library_uid = $1 and b.book_uid = $2 and h.status = 'WAITING'`
+
+	var waiting int
+	if err := s.db.GetContext(ctx, &waiting, query, request.LibraryUid, request.BookUid); err != nil {
+		logger.Error("count waiting holds", "error", err)
+		return nil, fmt.Errorf("count waiting holds: %w", err)
+	}
+
+	return generated.GetHoldQueue200JSONResponse{
+		Waiting: waiting,
+	}, nil
+}
+
+func (s *Server) ListHolds(ctx context.Context, request generated.ListHoldsRequestObject) (generated.ListHoldsResponseObject, error) {
+	logger := slog.With("handler", "ListHolds")
+
+	query := holdInfoQuery + ` where h.username = $1 and h.status in ('WAITING', 'READY') order by h.requested_at, h.id`
+
+	var holds []holdInfo
+	if err := s.db.SelectContext(ctx, &holds, query, contextutils.GetUser(ctx)); err != nil {
+		logger.Error("select holds from db", "error", err)
+		return nil, fmt.Errorf("select holds from db: %w", err)
+	}
+
+	return generated.ListHolds200JSONResponse(lo.Map(holds, func(item holdInfo, _ int) generated.HoldResponse {
+		return toHoldResponse(item)
+	})), nil
+}
+
+func (s *Server) GetHold(ctx context.Context, request generated.GetHoldRequestObject) (generated.GetHoldResponseObject, error) {
+	logger := slog.With("handler", "GetHold")
+
+	query := holdInfoQuery + ` where h.hold_uid = $1 and h.username = $2`
+
+	var holds []holdInfo
+	if err := s.db.SelectContext(ctx, &holds, query, request.HoldUid, contextutils.GetUser(ctx)); err != nil {
+		logger.Error("select hold from db", "error", err)
+		return nil, fmt.Errorf("select hold from db: %w", err)
+	}
+
+	if len(holds) == 0 {
+		return generated.GetHold404JSONResponse{
+			Message: "hold not found",
+		}, nil
+	}
+
+	return generated.GetHold200JSONResponse(toHoldResponse(holds[0])), nil
+}
+
+func (s *Server) CancelHold(ctx context.Context, request generated.CancelHoldRequestObject) (generated.CancelHoldResponseObject, error) {
+	logger := slog.With("handler", "CancelHold")
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select * from holds where hold_uid = $1 and username = $2 for update`
+
+	var holds []hold
+	if err := tx.SelectContext(ctx, &holds, query, request.HoldUid, contextutils.GetUser(ctx)); err != nil {
+		logger.Error("select hold from db", "error", err)
+		return nil, fmt.Errorf("select hold from db: %w", err)
+	}
+
+	if len(holds) == 0 {
+		return generated.CancelHold404JSONResponse{
+			Message: "hold not found",
+		}, nil
+	}
+
+	h := holds[0]
+	if h.Status != holdWaiting && h.Status != holdReady {
+		return generated.CancelHold409JSONResponse{
+			Message: fmt.Sprintf("hold in status %s can not be cancelled", h.Status),
+		}, nil
+	}
+
+	if err := s.closeHold(ctx, tx, h, holdCancelled, contextutils.GetUser(ctx)); err != nil {
+		logger.Error("close hold", "error", err)
+		return nil, fmt.Errorf("close hold: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.CancelHold204Response{}, nil
+}
+
+func (s *Server) WriteOffBook(ctx context.Context, request generated.WriteOffBookRequestObject) (generated.WriteOffBookResponseObject, error) {
+	logger := slog.With("handler", "WriteOffBook")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.WriteOffBook403JSONResponse{
+			Message: "only library staff can write off copies",
+		}, nil
+	}
+
+	if len(lo.FromPtr(request.Body.Comment)) > 255 {
+		return generated.WriteOffBook400JSONResponse(*validationError("comment", "comment must be at most 255 characters")), nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select c.*, b.book_uid, l.library_uid from
+		book_copies c
+		join books b on b.id = c.book_id
+		join library l on l.id = c.library_id
+		where l.library_uid = $1 and b.book_uid = $2 and c.status = 'RENTED' and c.reservation_uid = $3
+		limit 1 for update of c`
+
+	var copies []bookCopyInfo
+	if err := tx.SelectContext(ctx, &copies, query, request.LibraryUid, request.BookUid, request.Params.ReservationUid); err != nil {
+		logger.Error("select book copies from db", "error", err)
+		return nil, fmt.Errorf("select book copies from db: %w", err)
+	}
+
+	if len(copies) == 0 {
+		copies, err = untrackedCopy(ctx, tx, request.LibraryUid, request.BookUid, request.Params.ReservationUid)
+		if err != nil {
+			logger.Error("select untracked copy from db", "error", err)
+			return nil, fmt.Errorf("select untracked copy from db: %w", err)
+		}
+	}
+
+	if len(copies) == 0 {
+		// The copy may be already written off by the previous attempt, which
+		// failed to close the reservation.
+		query = `select c.*, b.book_uid, l.library_uid from
+			copy_write_offs w
+			join book_copies c on c.id = w.copy_id
+			join books b on b.id = c.book_id
+			join library l on l.id = c.library_id
+			where l.library_uid = $1 and b.book_uid = $2 and w.reservation_uid = $3`
+
+		if err := tx.SelectContext(ctx, &copies, query, request.LibraryUid, request.BookUid, request.Params.ReservationUid); err != nil {
+			logger.Error("select written off copies from db", "error", err)
+			return nil, fmt.Errorf("select written off copies from db: %w", err)
+		}
+
+		if len(copies) > 0 {
+			return generated.WriteOffBook200JSONResponse(toBookCopyResponse(copies[0])), nil
+		}
+
+		return generated.WriteOffBook404JSONResponse{
+			Message: "rented copy not found",
+		}, nil
+	}
+
+	lost := copies[0]
+	condition := lost.Condition
+	if request.Body.Reason == generated.DAMAGED {
+		condition = string(generated.BookCopyResponseConditionBAD)
+	}
+
+	query = `update book_copies set status = $2, condition = $3, reservation_uid = null, rented_at = null where id = $1`
+	if _, err := tx.ExecContext(ctx, query, lost.ID, copyWithdrawn, condition); err != nil {
+		logger.Error("update book copies table in db", "error", err)
+		return nil, fmt.Errorf("update book copies table in db: %w", err)
+	}
+
+	query = `insert into copy_write_offs (copy_id, reservation_uid, reason, actor, comment) values ($1, $2, $3, $4, $5)`
+	if _, err := tx.ExecContext(ctx, query, lost.ID, request.Params.ReservationUid, request.Body.Reason, contextutils.GetUser(ctx), request.Body.Comment); err != nil {
+		logger.Error("insert write off", "error", err)
+		return nil, fmt.Errorf("insert write off: %w", err)
+	}
+
+	if lost.Condition != condition {
+		change := conditionChange{
+			CopyID:         lost.ID,
+			OldCondition:   lost.Condition,
+			NewCondition:   condition,
+			ReservationUID: &request.Params.ReservationUid,
+			ChangedBy:      contextutils.GetUser(ctx),
+		}
+
+		query = `insert into copy_condition_history (copy_id, old_condition, new_condition, reservation_uid, changed_by)
+			values (:copy_id, :old_condition, :new_condition, :reservation_uid, :changed_by)`
+		if _, err := tx.NamedExecContext(ctx, query, change); err != nil {
+			logger.Error("insert condition change", "error", err)
+			return nil, fmt.Errorf("insert condition change: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	lost.Status = copyWithdrawn
+	lost.Condition = condition
+	lost.ReservationUID = nil
+	lost.RentedAt = nil
+
+	return generated.WriteOffBook200JSONResponse(toBookCopyResponse(lost)), nil
+}
+
+func (s *Server) AdjustStock(ctx context.Context, request generated.AdjustStockRequestObject) (generated.AdjustStockResponseObject, error) {
+	logger := slog.With("handler", "AdjustStock")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.AdjustStock403JSONResponse{
+			Message: "only library staff can adjust stock",
+		}, nil
+	}
+
+	delta := request.Body.Delta
+	switch {
+	case delta == 0:
+		return generated.AdjustStock400JSONResponse(*validationError("delta", "delta must not be zero")), nil
+	case delta < -maxStockAdjustment || delta > maxStockAdjustment:
+		return generated.AdjustStock400JSONResponse(*validationError("delta", fmt.Sprintf("delta must be between -%d and %d", maxStockAdjustment, maxStockAdjustment))), nil
+	case len(lo.FromPtr(request.Body.Comment)) > 255:
+		return generated.AdjustStock400JSONResponse(*validationError("comment", "comment must be at most 255 characters")), nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select l.id as library_id, b.id as book_id from library l, books b where l.library_uid = $1 and b.book_uid = $2`
+
+	var copies []bookCopy
+	if err := tx.SelectContext(ctx, &copies, query, request.LibraryUid, request.BookUid); err != nil {
+		logger.Error("select library and book from db", "error", err)
+		return nil, fmt.Errorf("select library and book from db: %w", err)
+	}
+
+	if len(copies) == 0 {
+		return generated.AdjustStock404JSONResponse{
+			Message: "library or book not found",
+		}, nil
+	}
+
+	target := copies[0]
+
+	if delta > 0 {
+		for range delta {
+			c := target
+			c.CopyUID = uuid.New()
+			c.Barcode = barcode(c.CopyUID)
+			c.Condition = string(lo.FromPtrOr(request.Body.Condition, generated.StockAdjustmentRequestConditionEXCELLENT))
+			c.Status = copyAvailable
+
+			if err := insertCopy(ctx, tx, &c); err != nil {
+				logger.Error("insert book copy", "error", err)
+				return nil, fmt.Errorf("insert book copy: %w", err)
+			}
+		}
+	} else {
+		query = `select * from book_copies c
+			where c.library_id = $1 and c.book_id = $2 and c.status = 'AVAILABLE'
+			order by ` + copyConditionOrder + ` desc, c.id
+			limit $3
+			for update`
+
+		var withdrawn []bookCopy
+		if err := tx.SelectContext(ctx, &withdrawn, query, target.LibraryID, target.BookID, -delta); err != nil {
+			logger.Error("select book copies from db", "error", err)
+			return nil, fmt.Errorf("select book copies from db: %w", err)
+		}
+
+		if len(withdrawn) < -delta {
+			return generated.AdjustStock409JSONResponse{
+				Message: fmt.Sprintf("only %d available copies can be withdrawn", len(withdrawn)),
+			}, nil
+		}
+
+		ids := lo.Map(withdrawn, func(item bookCopy, _ int) int64 {
+			return int64(item.ID)
+		})
+
+		query = `update book_copies set status = $2 where id = any($1)`
+		if _, err := tx.ExecContext(ctx, query, pq.Array(ids), copyWithdrawn); err != nil {
+			logger.Error("update book copies table in db", "error", err)
+			return nil, fmt.Errorf("update book copies table in db: %w", err)
+		}
+	}
+
+	movement, err := recordMovement(ctx, tx, stockMovement{
+		LibraryID: target.LibraryID,
+		BookID:    target.BookID,
+		Actor:     contextutils.GetUser(ctx),
+		Reason:    movementAdjust,
+		Delta:     delta,
+		Comment:   request.Body.Comment,
+	})
+	if err != nil {
+		logger.Error("record stock movement", "error", err)
+		return nil, fmt.Errorf("record stock movement: %w", err)
+	}
+
+	if delta > 0 {
+		if err := s.promoteHolds(ctx, tx, target.LibraryID, target.BookID); err != nil {
+			logger.Error("promote holds", "error", err)
+			return nil, fmt.Errorf("promote holds: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.AdjustStock200JSONResponse(toStockMovementResponse(stockMovementInfo{
+		stockMovement: movement,
+		BookUID:       request.BookUid,
+		LibraryUID:    request.LibraryUid,
+	})), nil
+}
+
+func (s *Server) ListStockMovements(ctx context.Context, request generated.ListStockMovementsRequestObject) (generated.ListStockMovementsResponseObject, error) {
+	logger := slog.With("handler", "ListStockMovements")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.ListStockMovements403JSONResponse{
+			Message: "only library staff can view stock movements",
+		}, nil
+	}
+
+	page, verr := plainPage(request.Params.Page, request.Params.Size, "desc")
+	if verr != nil {
+		return generated.ListStockMovements400JSONResponse(*verr), nil
+	}
+
+	var q listing.Query
+	filtered := `select m.*, b.book_uid, l.library_uid, c.copy_uid from
+		stock_movements m
+		join books b on b.id = m.book_id
+		join library l on l.id = m.library_id
+		left join book_copies c on c.id = m.copy_id
+	where l.library_uid = ` + q.Bind(request.LibraryUid)
+
+	if request.Params.BookUid != nil {
+		filtered += ` and b.book_uid = ` + q.Bind(*request.Params.BookUid)
+	}
+
+	if request.Params.Reason != nil {
+		filtered += ` and m.reason = ` + q.Bind(string(*request.Params.Reason))
+	}
+
+	if request.Params.CorrelationUid != nil {
+		filtered += ` and m.correlation_uid = ` + q.Bind(*request.Params.CorrelationUid)
+	}
+
+	filterArgs := len(q.Args)
+	query := page.Keyset(&q, filtered, "t.created_at")
+
+	var movements []stockMovementInfo
+	if err := s.db.SelectContext(ctx, &movements, query, q.Args...); err != nil {
+		logger.Error("select stock movements from db", "error", err)
+		return nil, fmt.Errorf("select stock movements from db: %w", err)
+	}
+
+	query = `select count(*) from (` + filtered + `) t`
+	var count int
+	if err := s.db.QueryRowContext(ctx, query, q.Args[:filterArgs]...).Scan(&count); err != nil {
+		logger.Error("select count from db", "error", err)
+		return nil, fmt.Errorf("select count from db: %w", err)
+	}
+
+	movements = listing.Cut(page, movements)
+
+	return generated.ListStockMovements200JSONResponse{
+		Items: lo.Map(movements, func(item stockMovementInfo, _ int) generated.StockMovementResponse {
+			return toStockMovementResponse(item)
+		}),
+		Page:          request.Params.Page,
+		PageSize:      request.Params.Size,
+		TotalElements: count,
+	}, nil
+}
+
+func (s *Server) CheckStockConsistency(ctx context.Context, request generated.CheckStockConsistencyRequestObject) (generated.CheckStockConsistencyResponseObject, error) {
+	logger := slog.With("handler", "CheckStockConsistency")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.CheckStockConsistency403JSONResponse{
+			Message: "only library staff can check stock consistency",
+		}, nil
+	}
+
+	libraries, err := s.selectLibrary(ctx, request.LibraryUid)
+	if err != nil {
+		logger.Error("select library from db", "error", err)
+		return nil, fmt.Errorf("select library from db: %w", err)
+	}
+
+	if len(libraries) == 0 {
+		return generated.CheckStockConsistency404JSONResponse{
+			Message: "library not found",
+		}, nil
+	}
+
+	query := `with replayed as (
+		select book_id, id, resulting_count,
+			sum(delta) over (partition by book_id order by id) as running_count
+		from stock_movements
+		where library_id = $1
+	), ledger as (
+		select book_id,
+			(array_agg(running_count order by id desc))[1] as ledger_count,
+			(array_agg(resulting_count order by id desc))[1] as recorded_count,
+			count(*) filter (where resulting_count <> running_count) as broken_movements
+		from replayed
+		group by book_id
+	), actual as (
+		select book_id, count(*) filter (where status = 'AVAILABLE') as actual_count
+		from book_copies
+		where library_id = $1
+		group by book_id
+	)
+	select b.book_uid,
+		coalesce(l.ledger_count, 0)::int as ledger_count,
+		coalesce(l.recorded_count, 0)::int as recorded_count,
+		coalesce(a.actual_count, 0)::int as actual_count,
+		coalesce(l.broken_movements, 0)::int as broken_movements
+	from ledger l
+		full join actual a on a.book_id = l.book_id
+		join books b on b.id = coalesce(l.book_id, a.book_id)
+	order by b.id`
+
+	var books []stockDiscrepancy
+	if err := s.db.SelectContext(ctx, &books, query, libraries[0].ID); err != nil {
+		logger.Error("replay stock movements", "error", err)
+		return nil, fmt.Errorf("replay stock movements: %w", err)
+	}
+
+	discrepancies := lo.Filter(books, func(item stockDiscrepancy, _ int) bool {
+		return item.LedgerCount != item.ActualCount || item.RecordedCount != item.ActualCount || item.BrokenMovements > 0
+	})
+
+	if len(discrepancies) > 0 {
+		logger.Warn("stock ledger is inconsistent", "library", request.LibraryUid, "books", len(discrepancies))
+	}
+
+	return generated.CheckStockConsistency200JSONResponse{
+		LibraryUid:   request.LibraryUid,
+		Consistent:   len(discrepancies) == 0,
+		CheckedBooks: len(books),
+		Discrepancies: lo.Map(discrepancies, func(item stockDiscrepancy, _ int) generated.StockDiscrepancy {
+			return generated.StockDiscrepancy{
+				ActualCount:     item.ActualCount,
+				BookUid:         item.BookUID,
+				BrokenMovements: item.BrokenMovements,
+				LedgerCount:     item.LedgerCount,
+				RecordedCount:   item.RecordedCount,
+			}
+		}),
+	}, nil
+}
+
+func (s *Server) CreateTransfer(ctx context.Context, request generated.CreateTransferRequestObject) (generated.CreateTransferResponseObject, error) {
+	logger := slog.With("handler", "CreateTransfer")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.CreateTransfer403JSONResponse{
+			Message: "only library staff can transfer copies",
+		}, nil
+	}
+
+	switch {
+	case request.Body.SourceLibraryUid == request.Body.DestinationLibraryUid:
+		return generated.CreateTransfer400JSONResponse(*validationError("destinationLibraryUid", "destination library must differ from source library")), nil
+	case request.Body.Quantity < 1 || request.Body.Quantity > maxStockAdjustment:
+		return generated.CreateTransfer400JSONResponse(*validationError("quantity", fmt.Sprintf("quantity must be between 1 and %d", maxStockAdjustment))), nil
+	}
+
+	query := `select b.id as book_id, s.id as source_library_id, d.id as destination_library_id
+		from books b, library s, library d
+		where b.book_uid = $1 and s.library_uid = $2 and d.library_uid = $3`
+
+	var transfers []transfer
+	if err := s.db.SelectContext(ctx, &transfers, query, request.Body.BookUid, request.Body.SourceLibraryUid, request.Body.DestinationLibraryUid); err != nil {
+		logger.Error("select libraries and book from db", "error", err)
+		return nil, fmt.Errorf("select libraries and book from db: %w", err)
+	}
+
+	if len(transfers) == 0 {
+		return generated.CreateTransfer404JSONResponse{
+			Message: "library or book not found",
+		}, nil
+	}
+
+	t := transfers[0]
+	t.TransferUID = uuid.New()
+	t.Quantity = request.Body.Quantity
+	t.Status = transferRequested
+	t.RequestedBy = contextutils.GetUser(ctx)
+
+	query = `insert into transfers (transfer_uid, book_id, source_library_id, destination_library_id, quantity, status, requested_by)
+		values ($1, $2, $3, $4, $5, $6, $7) returning id, requested_at`
+	if err := s.db.QueryRowContext(ctx, query, t.TransferUID, t.BookID, t.SourceLibraryID, t.DestinationLibraryID, t.Quantity, t.Status, t.RequestedBy).Scan(&t.ID, &t.RequestedAt); err != nil {
+		logger.Error("insert transfer", "error", err)
+		return nil, fmt.Errorf("insert transfer: %w", err)
+	}
+
+	return generated.CreateTransfer201JSONResponse(toTransferResponse(transferInfo{
+		transfer:              t,
+		BookUID:               request.Body.BookUid,
+		SourceLibraryUID:      request.Body.SourceLibraryUid,
+		DestinationLibraryUID: request.Body.DestinationLibraryUid,
+	})), nil
+}
+
+func (s *Server) GetTransfer(ctx context.Context, request generated.GetTransferRequestObject) (generated.GetTransferResponseObject, error) {
+	logger := slog.With("handler", "GetTransfer")
+	query := transferInfoQuery + ` where t.transfer_uid = $1`
+
+	var transfers []transferInfo
+	if err := s.db.SelectContext(ctx, &transfers, query, request.TransferUid); err != nil {
+		logger.Error("select transfer from db", "error", err)
+		return nil, fmt.Errorf("select transfer from db: %w", err)
+	}
+
+	if len(transfers) == 0 {
+		return generated.GetTransfer404JSONResponse{
+			Message: "transfer not found",
+		}, nil
+	}
+
+	return generated.GetTransfer200JSONResponse(toTransferResponse(transfers[0])), nil
+}
+
+func (s *Server) DispatchTransfer(ctx context.Context, request generated.DispatchTransferRequestObject) (generated.DispatchTransferResponseObject, error) {
+	logger := slog.With("handler", "DispatchTransfer")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.DispatchTransfer403JSONResponse{
+			Message: "only library staff can transfer copies",
+		}, nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	transfers, err := lockTransfer(ctx, tx, request.TransferUid)
+	if err != nil {
+		logger.Error("select transfer from db", "error", err)
+		return nil, fmt.Errorf("select transfer from db: %w", err)
+	}
+
+	if len(transfers) == 0 {
+		return generated.DispatchTransfer404JSONResponse{
+			Message: "transfer not found",
+		}, nil
+	}
+
+	t := transfers[0]
+	if t.Status != transferRequested {
+		return generated.DispatchTransfer409JSONResponse{
+			Message: fmt.Sprintf("transfer in status %s can not be dispatched", t.Status),
+		}, nil
+	}
+
+	query := `select * from book_copies c
+		where c.library_id = $1 and c.book_id = $2 and c.status = 'AVAILABLE'
+		order by ` + copyConditionOrder + `, c.id
+		limit $3
+		for update skip locked`
+
+	var copies []bookCopy
+	if err := tx.SelectContext(ctx, &copies, query, t.SourceLibraryID, t.BookID, t.Quantity); err != nil {
+		logger.Error("select book copies from db", "error", err)
+		return nil, fmt.Errorf("select book copies from db: %w", err)
+	}
+
+	if len(copies) < t.Quantity {
+		return generated.DispatchTransfer409JSONResponse{
+			Message: fmt.Sprintf("only %d available copies can be dispatched", len(copies)),
+		}, nil
+	}
+
+	ids := lo.Map(copies, func(item bookCopy, _ int) int64 {
+		return int64(item.ID)
+	})
+
+	query = `update book_copies set status = $2 where id = any($1)`
+	if _, err := tx.ExecContext(ctx, query, pq.Array(ids), copyInTransit); err != nil {
+		logger.Error("update book copies table in db", "error", err)
+		return nil, fmt.Errorf("update book copies table in db: %w", err)
+	}
+
+	query = `insert into transfer_copies (transfer_id, copy_id) select $1, unnest($2::int[])`
+	if _, err := tx.ExecContext(ctx, query, t.ID, pq.Array(ids)); err != nil {
+		logger.Error("insert transfer copies", "error", err)
+		return nil, fmt.Errorf("insert transfer copies: %w", err)
+	}
+
+	if _, err := recordMovement(ctx, tx, stockMovement{
+		LibraryID:      t.SourceLibraryID,
+		BookID:         t.BookID,
+		Actor:          contextutils.GetUser(ctx),
+		Reason:         movementTransfer,
+		Delta:          -t.Quantity,
+		CorrelationUID: &t.TransferUID,
+	}); err != nil {
+		logger.Error("record stock movement", "error", err)
+		return nil, fmt.Errorf("record stock movement: %w", err)
+	}
+
+	t.Status = transferInTransit
+	query = `update transfers set status = $2, dispatched_at = now() where id = $1 returning dispatched_at`
+	if err := tx.QueryRowContext(ctx, query, t.ID, t.Status).Scan(&t.DispatchedAt); err != nil {
+		logger.Error("update transfer", "error", err)
+		return nil, fmt.Errorf("update transfer: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.DispatchTransfer200JSONResponse(toTransferResponse(t)), nil
+}
+
+func (s *Server) ReceiveTransfer(ctx context.Context, request generated.ReceiveTransferRequestObject) (generated.ReceiveTransferResponseObject, error) {
+	logger := slog.With("handler", "ReceiveTransfer")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.ReceiveTransfer403JSONResponse{
+			Message: "only library staff can transfer copies",
+		}, nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	transfers, err := lockTransfer(ctx, tx, request.TransferUid)
+	if err != nil {
+		logger.Error("select transfer from db", "error", err)
+		return nil, fmt.Errorf("select transfer from db: %w", err)
+	}
+
+	if len(transfers) == 0 {
+		return generated.ReceiveTransfer404JSONResponse{
+			Message: "transfer not found",
+		}, nil
+	}
+
+	t := transfers[0]
+	if t.Status != transferInTransit {
+		return generated.ReceiveTransfer409JSONResponse{
+			Message: fmt.Sprintf("transfer in status %s can not be received", t.Status),
+		}, nil
+	}
+
+	query := `update book_copies c set status = $2, library_id = $3
+		from transfer_copies tc
+		where tc.copy_id = c.id and tc.transfer_id = $1`
+	if _, err := tx.ExecContext(ctx, query, t.ID, copyAvailable, t.DestinationLibraryID); err != nil {
+		logger.Error("update book copies table in db", "error", err)
+		return nil, fmt.Errorf("update book copies table in db: %w", err)
+	}
+
+	if _, err := recordMovement(ctx, tx, stockMovement{
+		LibraryID:      t.DestinationLibraryID,
+		BookID:         t.BookID,
+		Actor:          contextutils.GetUser(ctx),
+		Reason:         movementTransfer,
+		Delta:          t.Quantity,
+		CorrelationUID: &t.TransferUID,
+	}); err != nil {
+		logger.Error("record stock movement", "error", err)
+		return nil, fmt.Errorf("record stock movement: %w", err)
+	}
+
+	t.Status = transferReceived
+	query = `update transfers set status = $2, received_at = now() where id = $1 returning received_at`
+	if err := tx.QueryRowContext(ctx, query, t.ID, t.Status).Scan(&t.ReceivedAt); err != nil {
+		logger.Error("update transfer", "error", err)
+		return nil, fmt.Errorf("update transfer: %w", err)
+	}
+
+	if err := s.promoteHolds(ctx, tx, t.DestinationLibraryID, t.BookID); err != nil {
+		logger.Error("promote holds", "error", err)
+		return nil, fmt.Errorf("promote holds: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.ReceiveTransfer200JSONResponse(toTransferResponse(t)), nil
+}
+
+func (s *Server) CancelTransfer(ctx context.Context, request generated.CancelTransferRequestObject) (generated.CancelTransferResponseObject, error) {
+	logger := slog.With("handler", "CancelTransfer")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.CancelTransfer403JSONResponse{
+			Message: "only library staff can transfer copies",
+		}, nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	transfers, err := lockTransfer(ctx, tx, request.TransferUid)
+	if err != nil {
+		logger.Error("select transfer from db", "error", err)
+		return nil, fmt.Errorf("select transfer from db: %w", err)
+	}
+
+	if len(transfers) == 0 {
+		return generated.CancelTransfer404JSONResponse{
+			Message: "transfer not found",
+		}, nil
+	}
+
+	t := transfers[0]
+	if t.Status != transferRequested {
+		return generated.CancelTransfer409JSONResponse{
+			Message: "only not dispatched transfer can be cancelled",
+		}, nil
+	}
+
+	t.Status = transferCancelled
+	query := `update transfers set status = $2, cancelled_at = now() where id = $1 returning cancelled_at`
+	if err := tx.QueryRowContext(ctx, query, t.ID, t.Status).Scan(&t.CancelledAt); err != nil {
+		logger.Error("update transfer", "error", err)
+		return nil, fmt.Errorf("update transfer: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.CancelTransfer200JSONResponse(toTransferResponse(t)), nil
+}
+
+func (s *Server) ListLibraryTransfers(ctx context.Context, request generated.ListLibraryTransfersRequestObject) (generated.ListLibraryTransfersResponseObject, error) {
+	logger := slog.With("handler", "ListLibraryTransfers")
+
+	page, verr := plainPage(request.Params.Page, request.Params.Size, "desc")
+	if verr != nil {
+		return generated.ListLibraryTransfers400JSONResponse(*verr), nil
+	}
+
+	var q listing.Query
+	libraryUID := q.Bind(request.LibraryUid)
+
+	var filtered string
+	switch lo.FromPtr(request.Params.Direction) {
+	case generated.Incoming:
+		filtered = transferInfoQuery + ` where d.library_uid = ` + libraryUID
+	case generated.Outgoing:
+		filtered = transferInfoQuery + ` where s.library_uid = ` + libraryUID
+	default:
+		filtered = transferInfoQuery + ` where (s.library_uid = ` + libraryUID + ` or d.library_uid = ` + libraryUID + `)`
+	}
+
+	if request.Params.Status != nil {
+		filtered += ` and t.status = ` + q.Bind(string(*request.Params.Status))
+	}
+
+	filterArgs := len(q.Args)
+	query := page.Keyset(&q, filtered, "t.requested_at")
+
+	var transfers []transferInfo
+	if err := s.db.SelectContext(ctx, &transfers, query, q.Args...); err != nil {
+		logger.Error("select transfers from db", "error", err)
+		return nil, fmt.Errorf("select transfers from db: %w", err)
+	}
+
+	query = `select count(*) from (` + filtered + `) t`
+	var count int
+	if err := s.db.QueryRowContext(ctx, query, q.Args[:filterArgs]...).Scan(&count); err != nil {
+		logger.Error("select count from db", "error", err)
+		return nil, fmt.Errorf("select count from db: %w", err)
+	}
+
+	transfers = listing.Cut(page, transfers)
+
+	return generated.ListLibraryTransfers200JSONResponse{
+		Items: lo.Map(transfers, func(item transferInfo, _ int) generated.TransferResponse {
+			return toTransferResponse(item)
+		}),
+		Page:          request.Params.Page,
+		PageSize:      request.Params.Size,
+		TotalElements: count,
+	}, nil
+}
+
+func (s *Server) ListPopularBooks(ctx context.Context, request generated.ListPopularBooksRequestObject) (generated.ListPopularBooksResponseObject, error) {
+	logger := slog.With("handler", "ListPopularBooks")
+
+	from, to, verr := statisticsPeriod(request.Params.From, request.Params.To)
+	if verr != nil {
+		return generated.ListPopularBooks400JSONResponse(*verr), nil
+	}
+
+	limit := lo.FromPtrOr(request.Params.Limit, defaultPopularLimit)
+	if limit < 1 || limit > maxPopularLimit {
+		return generated.ListPopularBooks400JSONResponse(*validationError("limit", fmt.Sprintf("limit must be between 1 and %d", maxPopularLimit))), nil
+	}
+
+	var q listing.Query
+	query := `select ` + bookColumns + `, sum(cd.checkouts)::int as checkouts, sum(cd.returns)::int as returns from
+		circulation_daily cd
+		join books b on b.id = cd.book_id
+		` + bookJoins + `
+		join library l on l.id = cd.library_id
+	where cd.day between ` + q.Bind(from.Format(time.DateOnly)) + `::date and ` + q.Bind(to.Format(time.DateOnly)) + `::date`
+
+	if request.Params.City != nil {
+		query += ` and l.city = ` + q.Bind(*request.Params.City)
+	}
+
+	if request.Params.Genre != nil {
+		query += ` and lower(b.genre) = lower(` + q.Bind(*request.Params.Genre) + `)`
+	}
+
+	query += ` group by b.id, w.id, s.id having sum(cd.checkouts) > 0 order by checkouts desc, b.id limit ` + q.Bind(limit)
+
+	var books []popularBook
+	if err := s.db.SelectContext(ctx, &books, query, q.Args...); err != nil {
+		logger.Error("select popular books from db", "error", err)
+		return nil, fmt.Errorf("select popular books from db: %w", err)
+	}
+
+	return generated.ListPopularBooks200JSONResponse(lo.Map(books, func(item popularBook, _ int) generated.PopularBookResponse {
+		return generated.PopularBookResponse{
+			Book:      toBookInfo(item.book),
+			Checkouts: item.Checkouts,
+			Returns:   item.Returns,
+		}
+	})), nil
+}
+
+func (s *Server) GetLibraryStatistics(ctx context.Context, request generated.GetLibraryStatisticsRequestObject) (generated.GetLibraryStatisticsResponseObject, error) {
+	logger := slog.With("handler", "GetLibraryStatistics")
+
+	from, to, verr := statisticsPeriod(request.Params.From, request.Params.To)
+	if verr != nil {
+		return generated.GetLibraryStatistics400JSONResponse(*verr), nil
+	}
+
+	libraries, err := s.selectLibrary(ctx, request.LibraryUid)
+	if err != nil {
+		logger.Error("select library from db", "error", err)
+		return nil, fmt.Errorf("select library from db: %w", err)
+	}
+
+	if len(libraries) == 0 {
+		return generated.GetLibraryStatistics404JSONResponse{
+			Message: "library not found",
+		}, nil
+	}
+
+	var q listing.Query
+	libraryID := q.Bind(libraries[0].ID)
+
+	bookFilter := ""
+	if request.Params.BookUid != nil {
+		bookFilter = ` and book_id = (select id from books where book_uid = ` + q.Bind(*request.Params.BookUid) + `)`
+	}
+
+	query := `select
+		coalesce(sum(cd.checkouts), 0)::int as checkouts,
+		coalesce(sum(cd.returns), 0)::int as returns,
+		coalesce(sum(cd.loan_seconds), 0)::bigint as loan_seconds,
+		coalesce(sum(cd.completed_loans), 0)::int as completed_loans,
+		c.total_copies,
+		c.copies_out
+	from (
+		select count(*) filter (where status <> 'WITHDRAWN') as total_copies,
+			count(*) filter (where status = 'RENTED') as copies_out
+		from book_copies
+		where library_id = ` + libraryID + bookFilter + `
+	) c
+	left join circulation_daily cd on cd.library_id = ` + libraryID + bookFilter + `
+		and cd.day between ` + q.Bind(from.Format(time.DateOnly)) + `::date and ` + q.Bind(to.Format(time.DateOnly)) + `::date
+	group by c.total_copies, c.copies_out`
+
+	var totals circulationTotals
+	if err := s.db.GetContext(ctx, &totals, query, q.Args...); err != nil {
+		logger.Error("select statistics from db", "error", err)
+		return nil, fmt.Errorf("select statistics from db: %w", err)
+	}
+
+	resp := generated.GetLibraryStatistics200JSONResponse{
+		LibraryUid:  request.LibraryUid,
+		BookUid:     request.Params.BookUid,
+		From:        from.Format(time.DateOnly),
+		To:          to.Format(time.DateOnly),
+		Checkouts:   totals.Checkouts,
+		Returns:     totals.Returns,
+		TotalCopies: totals.TotalCopies,
+		CopiesOut:   totals.CopiesOut,
+	}
+
+	if totals.CompletedLoans > 0 {
+		resp.AverageLoanDays = lo.ToPtr(float64(totals.LoanSeconds) / float64(totals.CompletedLoans) / (24 * 60 * 60))
+	}
+
+	if totals.TotalCopies > 0 {
+		resp.Utilization = float64(totals.CopiesOut) / float64(totals.TotalCopies)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetCopyConditionHistory(ctx context.Context, request generated.GetCopyConditionHistoryRequestObject) (generated.GetCopyConditionHistoryResponseObject, error) {
+	logger := slog.With("handler", "GetCopyConditionHistory")
+	query := `select * from book_copies where copy_uid = $1`
+
+	var copies []bookCopy
+	if err := s.db.SelectContext(ctx, &copies, query, request.CopyUid); err != nil {
+		logger.Error("select book copy from db", "error", err)
+		return nil, fmt.Errorf("select book copy from db: %w", err)
+	}
+
+	if len(copies) == 0 {
+		return generated.GetCopyConditionHistory404JSONResponse{
+			Message: "copy not found",
+		}, nil
+	}
+
+	query = `select * from copy_condition_history where copy_id = $1 order by changed_at, id`
+
+	var changes []conditionChange
+	if err := s.db.SelectContext(ctx, &changes, query, copies[0].ID); err != nil {
+		logger.Error("select condition history from db", "error", err)
+		return nil, fmt.Errorf("select condition history from db: %w", err)
+	}
+
+	return generated.GetCopyConditionHistory200JSONResponse(lo.Map(changes, func(item conditionChange, _ int) generated.ConditionChangeResponse {
+		return generated.ConditionChangeResponse{
+			ChangedAt:      item.ChangedAt,
+			ChangedBy:      item.ChangedBy,
+			NewCondition:   generated.ConditionChangeResponseNewCondition(item.NewCondition),
+			OldCondition:   generated.ConditionChangeResponseOldCondition(item.OldCondition),
+			ReservationUid: item.ReservationUID,
+		}
+	})), nil
+}
+
+func (s *Server) GetInventoryReport(ctx context.Context, request generated.GetInventoryReportRequestObject) (generated.GetInventoryReportResponseObject, error) {
+	logger := slog.With("handler", "GetInventoryReport")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.GetInventoryReport403JSONResponse{
+			Message: "only library staff can export reports",
+		}, nil
+	}
+
+	libraries, err := s.selectLibrary(ctx, request.LibraryUid)
+	if err != nil {
+		logger.Error("select library from db", "error", err)
+		return nil, fmt.Errorf("select library from db: %w", err)
+	}
+
+	if len(libraries) == 0 {
+		return generated.GetInventoryReport404JSONResponse{
+			Message: "library not found",
+		}, nil
+	}
+
+	format := report.Format(lo.FromPtr(request.Params.Format))
+	if format == "" {
+		format = report.CSV
+	}
+
+	// The format must be checked before streaming starts, the status can not
+	// be changed after that.
+	if !format.Valid() {
+		return generated.GetInventoryReport400JSONResponse(*validationError("format", "format must be csv or xlsx")), nil
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		if err := report.Inventory(ctx, s.db, request.LibraryUid, format, pw); err != nil {
+			logger.Error("write inventory report", "error", err)
+			pw.CloseWithError(err)
+			return
+		}
+
+		pw.Close()
+	}()
+
+	headers := generated.GetInventoryReport200ResponseHeaders{
+		ContentDisposition: fmt.Sprintf(`attachment; filename="%s"`, report.FileName(request.LibraryUid, time.Now(), format)),
+	}
+
+	if format == report.XLSX {
+		return generated.GetInventoryReport200ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheetResponse{
+			Body:    pr,
+			Headers: headers,
+		}, nil
+	}
+
+	return generated.GetInventoryReport200TextcsvResponse{
+		Body:    pr,
+		Headers: headers,
+	}, nil
+}
+
+func (s *Server) selectLibrary(ctx context.Context, libraryUID uuid.UUID) ([]library, error) {
+	query := `select * from library where library_uid = $1`
+
+	var libraries []library
+	if err := s.db.SelectContext(ctx, &libraries, query, libraryUID); err != nil {
+		return nil, err
+	}
+
+	return libraries, nil
+}
+
+func (s *Server) librarySchedule(ctx context.Context, lib library, from time.Time) (schedule, []openingHours, []holiday, error) {
+	query := `select library_id, weekday, to_char(opens_at, 'HH24:MI') as opens_at, to_char(closes_at, 'HH24:MI') as closes_at
+		from library_opening_hours where library_id = $1 order by weekday`
+
+	var hours []openingHours
+	if err := s.db.SelectContext(ctx, &hours, query, lib.ID); err != nil {
+		return schedule{}, nil, nil, fmt.Errorf("select opening hours: %w", err)
+	}
+
+	query = `select * from library_holidays where library_id = $1 and date >= $2::date order by date`
+
+	var holidays []holiday
+	if err := s.db.SelectContext(ctx, &holidays, query, lib.ID, from.Format(time.DateOnly)); err != nil {
+		return schedule{}, nil, nil, fmt.Errorf("select holidays: %w", err)
+	}
+
+	sched, err := newSchedule(lib.Timezone, hours, holidays)
+	if err != nil {
+		return schedule{}, nil, nil, fmt.Errorf("load timezone: %w", err)
+	}
+
+	return sched, hours, holidays, nil
+}
+
+func (s *Server) libraryScheduleResponse(ctx context.Context, lib library) (generated.LibraryScheduleResponse, error) {
+	location, err := time.LoadLocation(lib.Timezone)
+	if err != nil {
+		return generated.LibraryScheduleResponse{}, fmt.Errorf("load timezone: %w", err)
+	}
+
+	_, hours, holidays, err := s.librarySchedule(ctx, lib, time.Now().In(location))
+	if err != nil {
+		return generated.LibraryScheduleResponse{}, err
+	}
+
+	return generated.LibraryScheduleResponse{
+		LibraryUid: lib.LibraryUID,
+		Timezone:   lib.Timezone,
+		OpeningHours: lo.Map(hours, func(item openingHours, _ int) generated.OpeningHours {
+			return generated.OpeningHours{
+				Weekday:  item.Weekday,
+				OpensAt:  item.OpensAt,
+				ClosesAt: item.ClosesAt,
+			}
+		}),
+		Holidays: lo.Map(holidays, func(item holiday, _ int) generated.HolidayResponse {
+			return generated.HolidayResponse{
+				Date:   item.Date.Format(time.DateOnly),
+				Reason: item.Reason,
+			}
+		}),
+	}, nil
+}
+
+func insertCopy(ctx context.Context, tx *sqlx.Tx, c *bookCopy) error {
+	query := `insert into book_copies (copy_uid, barcode, book_id, library_id, condition, status)
+		values ($1, $2, $3, $4, $5, $6) returning id`
+
+	return tx.QueryRowContext(ctx, query, c.CopyUID, c.Barcode, c.BookID, c.LibraryID, c.Condition, c.Status).Scan(&c.ID)
+}
+
+// recordMovement appends stock change to the ledger. Movements of the same
+// book in the same library are serialized, so resulting count always reflects
+// the changes committed before.
+func recordMovement(ctx context.Context, tx *sqlx.Tx, m stockMovement) (stockMovement, error) {
+	query := `select pg_advisory_xact_lock($1, $2)`
+	if _, err := tx.ExecContext(ctx, query, m.LibraryID, m.BookID); err != nil {
+		return m, fmt.Errorf("lock stock: %w", err)
+	}
+
+	query = `select count(*) from book_copies where library_id = $1 and book_id = $2 and status = 'AVAILABLE'`
+	if err := tx.QueryRowContext(ctx, query, m.LibraryID, m.BookID).Scan(&m.ResultingCount); err != nil {
+		return m, fmt.Errorf("count available copies: %w", err)
+	}
+
+	query = `insert into stock_movements (library_id, book_id, copy_id, actor, reason, delta, resulting_count, correlation_uid, comment)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9) returning id, created_at`
+	if err := tx.QueryRowContext(ctx, query, m.LibraryID, m.BookID, m.CopyID, m.Actor, m.Reason, m.Delta, m.ResultingCount, m.CorrelationUID, m.Comment).Scan(&m.ID, &m.CreatedAt); err != nil {
+		return m, fmt.Errorf("insert stock movement: %w", err)
+	}
+
+	return m, nil
+}
+
+func toStockMovementResponse(m stockMovementInfo) generated.StockMovementResponse {
+	return generated.StockMovementResponse{
+		Actor:          m.Actor,
+		BookUid:        m.BookUID,
+		Comment:        m.Comment,
+		CopyUid:        m.CopyUID,
+		CorrelationUid: m.CorrelationUID,
+		CreatedAt:      m.CreatedAt,
+		Delta:          m.Delta,
+		LibraryUid:     m.LibraryUID,
+		Reason:         generated.StockMovementResponseReason(m.Reason),
+		ResultingCount: m.ResultingCount,
+	}
+}
+
+func lockTransfer(ctx context.Context, tx *sqlx.Tx, transferUID uuid.UUID) ([]transferInfo, error) {
+	query := transferInfoQuery + ` where t.transfer_uid = $1 for update of t`
+
+	var transfers []transferInfo
+	if err := tx.SelectContext(ctx, &transfers, query, transferUID); err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+func toTransferResponse(t transferInfo) generated.TransferResponse {
+	return generated.TransferResponse{
+		BookUid:               t.BookUID,
+		CancelledAt:           t.CancelledAt,
+		DestinationLibraryUid: t.DestinationLibraryUID,
+		DispatchedAt:          t.DispatchedAt,
+		Quantity:              t.Quantity,
+		ReceivedAt:            t.ReceivedAt,
+		RequestedAt:           t.RequestedAt,
+		RequestedBy:           t.RequestedBy,
+		SourceLibraryUid:      t.SourceLibraryUID,
+		Status:                generated.TransferResponseStatus(t.Status),
+		TransferUid:           t.TransferUID,
+	}
+}
+
+// rentSetAsideCopy rents the copy, which was set aside for the reader, e.g.
+// for a hold or a booking, and counts the checkout.
+// untrackedCopy selects a rented copy, which is not bound to any reservation,
+// for the loan, which has never had a copy in the library, i.e. the one the
+// copy backfill could not match. Every use of such a copy is logged.
+func untrackedCopy(ctx context.Context, tx *sqlx.Tx, libraryUID, bookUID, reservationUID uuid.UUID) ([]bookCopyInfo, error) {
+	query := `select c.*, b.book_uid, l.library_uid from
+		book_copies c
+		join books b on b.id = c.book_id
+		join library l on l.id = c.library_id
+		where l.library_uid = $1 and b.book_uid = $2 and c.status = 'RENTED' and c.reservation_uid is null
+		  and not exists(select 1 from stock_movements where correlation_uid = $3)
+		  and not exists(select 1 from copy_write_offs where reservation_uid = $3)
+		order by c.id
+		limit 1 for update of c`
+
+	var copies []bookCopyInfo
+	if err := tx.SelectContext(ctx, &copies, query, libraryUID, bookUID, reservationUID); err != nil {
+		return nil, err
+	}
+
+	if len(copies) > 0 {
+		slog.Warn("untracked copy used for loan", "copy_uid", copies[0].CopyUID, "reservation_uid", reservationUID)
+	}
+
+	return copies, nil
+}
+
+func rentSetAsideCopy(ctx context.Context, tx *sqlx.Tx, copyID int, status string, reservationUID *uuid.UUID) (bookCopyInfo, error) {
+	query := `select c.*, b.book_uid, l.library_uid from
+		book_copies c
+		join books b on b.id = c.book_id
+		join library l on l.id = c.library_id
+		where c.id = $1 and c.status = $2
+		for update of c`
+
+	var copies []bookCopyInfo
+	if err := tx.SelectContext(ctx, &copies, query, copyID, status); err != nil {
+		return bookCopyInfo{}, fmt.Errorf("select book copy: %w", err)
+	}
+
+	if len(copies) == 0 {
+		return bookCopyInfo{}, fmt.Errorf("copy %d in status %s not found", copyID, status)
+	}
+
+	taken := copies[0]
+	taken.Status = copyRented
+	taken.ReservationUID = reservationUID
+
+	query = `update book_copies set status = $2, reservation_uid = $3, rented_at = now() where id = $1`
+	if _, err := tx.ExecContext(ctx, query, taken.ID, taken.Status, taken.ReservationUID); err != nil {
+		return bookCopyInfo{}, fmt.Errorf("update book copy: %w", err)
+	}
+
+	if err := countCirculation(ctx, tx, circulation{
+		LibraryID: taken.LibraryID,
+		BookID:    taken.BookID,
+		Checkouts: 1,
+	}); err != nil {
+		return bookCopyInfo{}, fmt.Errorf("count circulation: %w", err)
+	}
+
+	return taken, nil
+}
+
+func countCirculation(ctx context.Context, tx *sqlx.Tx, c circulation) error {
+	query := `insert into circulation_daily (library_id, book_id, day, checkouts, returns, loan_seconds, completed_loans)
+		select l.id, $2, (now() at time zone l.timezone)::date, $3, $4, $5, $6 from library l where l.id = $1
+		on conflict (library_id, book_id, day) do update set
+			checkouts = circulation_daily.checkouts + excluded.checkouts,
+			returns = circulation_daily.returns + excluded.returns,
+			loan_seconds = circulation_daily.loan_seconds + excluded.loan_seconds,
+			completed_loans = circulation_daily.completed_loans + excluded.completed_loans`
+
+	_, err := tx.ExecContext(ctx, query, c.LibraryID, c.BookID, c.Checkouts, c.Returns, c.LoanSeconds, c.CompletedLoans)
+	return err
+}
+
+func statisticsPeriod(rawFrom, rawTo *string) (time.Time, time.Time, *generated.ValidationErrorResponse) {
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	if rawTo != nil {
+		parsed, err := time.Parse(time.DateOnly, *rawTo)
+		if err != nil {
+			return time.Time{}, time.Time{}, validationError("to", "date must be in YYYY-MM-DD format")
+		}
+
+		to = parsed
+	}
+
+	from := to.AddDate(0, 0, 1-defaultStatisticsDays)
+	if rawFrom != nil {
+		parsed, err := time.Parse(time.DateOnly, *rawFrom)
+		if err != nil {
+			return time.Time{}, time.Time{}, validationError("from", "date must be in YYYY-MM-DD format")
+		}
+
+		from = parsed
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, validationError("from", "period start must not be after its end")
+	}
+
+	return from, to, nil
+}
+
+func (s *Server) deleteCover(ctx context.Context, bookUID uuid.UUID, hash string) {
+	for _, thumbnail := range []bool{false, true} {
+		if err := s.covers.Delete(ctx, coverKey(bookUID, hash, thumbnail)); err != nil {
+			slog.Error("delete cover from store", "error", err, "book", bookUID)
+		}
+	}
+}
+
+func (s *Server) workResponse(ctx context.Context, workUID uuid.UUID) (generated.WorkResponse, bool, error) {
+	query := `select * from works where work_uid = $1`
+
+	var works []work
+	if err := s.db.SelectContext(ctx, &works, query, workUID); err != nil {
+		return generated.WorkResponse{}, false, fmt.Errorf("select work: %w", err)
+	}
+
+	if len(works) == 0 {
+		return generated.WorkResponse{}, false, nil
+	}
+
+	query = bookQuery + ` where b.work_id = $1 order by ` + editionOrder
+
+	var editions []book
+	if err := s.db.SelectContext(ctx, &editions, query, works[0].ID); err != nil {
+		return generated.WorkResponse{}, false, fmt.Errorf("select editions: %w", err)
+	}
+
+	return generated.WorkResponse{
+		WorkUid: works[0].WorkUID,
+		Title:   works[0].Title,
+		Author:  works[0].Author,
+		Editions: lo.Map(editions, func(item book, _ int) generated.BookInfo {
+			return toBookInfo(item)
+		}),
+	}, true, nil
+}
+
+func (s *Server) seriesResponse(ctx context.Context, seriesUID uuid.UUID, libraryUID *uuid.UUID) (generated.SeriesResponse, bool, error) {
+	query := `select * from series where series_uid = $1`
+
+	var found []series
+	if err := s.db.SelectContext(ctx, &found, query, seriesUID); err != nil {
+		return generated.SeriesResponse{}, false, fmt.Errorf("select series: %w", err)
+	}
+
+	if len(found) == 0 {
+		return generated.SeriesResponse{}, false, nil
+	}
+
+	query = `select ` + bookColumns + `,
+		count(c.id) filter (where c.status = 'AVAILABLE' and ($2::uuid is null or l.library_uid = $2)) as available_count
+	from books b
+		` + bookJoins + `
+		left join book_copies c on c.book_id = b.id
+		left join library l on l.id = c.library_id
+	where b.series_id = $1
+	group by b.id, w.id, s.id
+	order by b.volume_number`
+
+	var volumes []seriesVolume
+	if err := s.db.SelectContext(ctx, &volumes, query, found[0].ID, libraryUID); err != nil {
+		return generated.SeriesResponse{}, false, fmt.Errorf("select volumes: %w", err)
+	}
+
+	return toSeriesResponse(found[0], volumes), true, nil
+}
+
+func toSeriesResponse(sr series, volumes []seriesVolume) generated.SeriesResponse {
+	return generated.SeriesResponse{
+		SeriesUid: sr.SeriesUID,
+		Name:      sr.Name,
+		Author:    sr.Author,
+		Volumes: lo.Map(volumes, func(item seriesVolume, _ int) generated.SeriesVolumeResponse {
+			return generated.SeriesVolumeResponse{
+				VolumeNumber:   lo.FromPtr(item.VolumeNumber),
+				Book:           toBookInfo(item.book),
+				AvailableCount: item.AvailableCount,
+			}
+		}),
+	}
+}
+
+func toHoldResponse(h holdInfo) generated.HoldResponse {
+	return generated.HoldResponse{
+		BookUid:     h.BookUID,
+		ExpiresAt:   h.ExpiresAt,
+		HoldUid:     h.HoldUID,
+		LibraryUid:  h.LibraryUID,
+		Position:    h.Position,
+		RequestedAt: h.RequestedAt,
+		Status:      generated.HoldResponseStatus(h.Status),
+	}
+}
+
+func toBookingResponse(b copyBooking, libraryUID, bookUID uuid.UUID, c *bookCopyInfo) generated.BookingResponse {
+	resp := generated.BookingResponse{
+		BookUid:        bookUID,
+		ExpiresAt:      b.ExpiresAt,
+		LibraryUid:     libraryUID,
+		PickupDate:     b.PickupDate.Format(time.DateOnly),
+		ReservationUid: b.ReservationUID,
+		Status:         generated.BookingResponseStatus(b.Status),
+	}
+
+	if c != nil {
+		resp.Copy = lo.ToPtr(toBookCopyResponse(*c))
+	}
+
+	return resp
+}
+
+func toBookCopyResponse(c bookCopyInfo) generated.BookCopyResponse {
+	return generated.BookCopyResponse{
+		Barcode:    c.Barcode,
+		BookUid:    c.BookUID,
+		Condition:  generated.BookCopyResponseCondition(c.Condition),
+		CopyUid:    c.CopyUID,
+		LibraryUid: c.LibraryUID,
+		Status:     generated.BookCopyResponseStatus(c.Status),
+	}
+}
+
+func barcode(copyUID uuid.UUID) string {
+	return strings.ToUpper(strings.ReplaceAll(copyUID.String(), "-", "")[:12])
+}
+
+func toLibraryResponse(l library) generated.LibraryResponse {
+	return generated.LibraryResponse{
+		Address:    l.Address,
+		City:       l.City,
+		LibraryUid: l.LibraryUID,
+		Name:       l.Name,
+		Latitude:   l.Latitude,
+		Longitude:  l.Longitude,
+	}
+}
+
+func toBookInfo(b book) generated.BookInfo {
+	coverURL, coverThumbnailURL := coverURLs(b.BookUID, b.CoverHash)
+
+	return generated.BookInfo{
+		Author:            b.Author,
+		BookUid:           b.BookUID,
+		Genre:             b.Genre,
+		Name:              b.Name,
+		Isbn10:            b.ISBN10,
+		Isbn13:            b.ISBN13,
+		PublicationYear:   b.PublicationYear,
+		Publisher:         b.Publisher,
+		Language:          b.Language,
+		PageCount:         b.PageCount,
+		Description:       b.Description,
+		CoverUrl:          coverURL,
+		CoverThumbnailUrl: coverThumbnailURL,
+		WorkUid:           b.WorkUID,
+		SeriesUid:         b.SeriesUID,
+		VolumeNumber:      b.VolumeNumber,
+	}
 }
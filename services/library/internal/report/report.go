@@ -0,0 +1,138 @@
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"io"
+	"time"
+)
+
+type Format string
+
+const (
+	CSV  Format = "csv"
+	XLSX Format = "xlsx"
+)
+
+var ErrUnknownFormat = errors.New("unknown report format")
+
+// Valid reports whether the report can be written in the format.
+func (f Format) Valid() bool {
+	return f == CSV || f == XLSX
+}
+
+type encoder interface {
+	Write(cells []any) error
+	Close() error
+}
+
+func newEncoder(format Format, w io.Writer) (encoder, error) {
+	switch format {
+	case CSV:
+		return newCSVEncoder(w), nil
+	case XLSX:
+		return newXLSXEncoder(w)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+type inventoryRow struct {
+	BookUID   uuid.UUID `db:"book_uid"`
+	Name      string    `db:"name"`
+	Author    string    `db:"author"`
+	ISBN13    *string   `db:"isbn13"`
+	Total     int       `db:"total"`
+	Available int       `db:"available"`
+	Out       int       `db:"out"`
+	InTransit int       `db:"in_transit"`
+	OnHold    int       `db:"on_hold"`
+	Excellent int       `db:"excellent"`
+	Good      int       `db:"good"`
+	Damaged   int       `db:"damaged"`
+	Withdrawn int       `db:"withdrawn"`
+}
+
+var inventoryHeader = []any{
+	"book_uid", "name", "author", "isbn13",
+	"total", "available", "out", "in_transit", "on_hold",
+	"excellent", "good", "damaged", "withdrawn",
+}
+
+func (r inventoryRow) cells() []any {
+	isbn := ""
+	if r.ISBN13 != nil {
+		isbn = *r.ISBN13
+	}
+
+	return []any{
+		r.BookUID.String(), r.Name, r.Author, isbn,
+		r.Total, r.Available, r.Out, r.InTransit, r.OnHold,
+		r.Excellent, r.Good, r.Damaged, r.Withdrawn,
+	}
+}
+
+const inventoryQuery = `select b.book_uid, b.name, b.author, b.isbn13,
+		count(*) filter (where c.status <> 'WITHDRAWN') as total,
+		count(*) filter (where c.status = 'AVAILABLE') as available,
+		count(*) filter (where c.status = 'RENTED') as out,
+		count(*) filter (where c.status = 'IN_TRANSIT') as in_transit,
+		count(*) filter (where c.status = 'ON_HOLD') as on_hold,
+		count(*) filter (where c.status <> 'WITHDRAWN' and c.condition = 'EXCELLENT') as excellent,
+		count(*) filter (where c.status <> 'WITHDRAWN' and c.condition = 'GOOD') as good,
+		count(*) filter (where c.status <> 'WITHDRAWN' and c.condition = 'BAD') as damaged,
+		count(*) filter (where c.status = 'WITHDRAWN') as withdrawn
+	from book_copies c
+		join books b on b.id = c.book_id
+		join library l on l.id = c.library_id
+	where l.library_uid = $1
+	group by b.id
+	order by b.name, b.id`
+
+// Inventory writes stock of the library by title and copy condition. Rows are
+// encoded one by one as they are read from db, so the whole report is never
+// kept in memory.
+func Inventory(ctx context.Context, db sqlx.QueryerContext, libraryUID uuid.UUID, format Format, w io.Writer) error {
+	enc, err := newEncoder(format, w)
+	if err != nil {
+		return err
+	}
+
+	rows, err := db.QueryxContext(ctx, inventoryQuery, libraryUID)
+	if err != nil {
+		return fmt.Errorf("select inventory: %w", err)
+	}
+	defer rows.Close()
+
+	if err := enc.Write(inventoryHeader); err != nil {
+		return fmt.Errorf("write header: %w", err)
+	}
+
+	for rows.Next() {
+		var row inventoryRow
+		if err := rows.StructScan(&row); err != nil {
+			return fmt.Errorf("scan inventory row: %w", err)
+		}
+
+		if err := enc.Write(row.cells()); err != nil {
+			return fmt.Errorf("write row: %w", err)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("read inventory: %w", err)
+	}
+
+	if err := enc.Close(); err != nil {
+		return fmt.Errorf("finish report: %w", err)
+	}
+
+	return nil
+}
+
+func FileName(libraryUID uuid.UUID, at time.Time, format Format) string {
+	return fmt.Sprintf("inventory-%s-%s.%s", libraryUID, at.Format(time.DateOnly), format)
+}
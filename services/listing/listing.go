@@ -0,0 +1,202 @@
+// Package listing builds paginated list queries. A page is requested either
+// by number and size or by the cursor returned with the previous page.
+package listing
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/samber/lo"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// FieldError describes the invalid list parameter.
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// Query collects arguments of the query being built.
+type Query struct {
+	Args []any
+}
+
+// Bind adds the argument and returns its placeholder.
+func (q *Query) Bind(value any) string {
+	q.Args = append(q.Args, value)
+	return fmt.Sprintf("$%d", len(q.Args))
+}
+
+// SortField is the column items can be sorted by, Value returns the value of
+// the column for the item to put it into the cursor.
+type SortField[T any] struct {
+	Column string
+	Value  func(item T) any
+}
+
+type cursor struct {
+	Sort  string `json:"s"`
+	Value any    `json:"v"`
+	ID    int    `json:"id"`
+}
+
+func decodeCursor(raw *string, sort string) (*cursor, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(*raw)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var c cursor
+	if err := decoder.Decode(&c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	if c.Sort != sort {
+		return nil, fmt.Errorf("%w: cursor was issued for another sort order", ErrInvalidCursor)
+	}
+
+	return &c, nil
+}
+
+func (c cursor) encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+type Page struct {
+	page   *int
+	size   *int
+	sort   string
+	order  string
+	cursor *cursor
+}
+
+// CheckPage validates page and size, which are not checked by the generated
+// servers. Page can be requested only together with size.
+func CheckPage(page, size *int) *FieldError {
+	switch {
+	case size != nil && *size < 1:
+		return &FieldError{Field: "size", Message: "size must be at least 1"}
+	case page != nil && *page < 1:
+		return &FieldError{Field: "page", Message: "page must be at least 1"}
+	case page != nil && size == nil:
+		return &FieldError{Field: "page", Message: "page requires size"}
+	}
+
+	return nil
+}
+
+// New validates list parameters of the request sorted by one of sorts.
+func New[T any](sorts map[string]SortField[T], page, size *int, sort, order string, rawCursor *string) (Page, SortField[T], *FieldError) {
+	field, ok := sorts[sort]
+	if !ok {
+		return Page{}, field, &FieldError{Field: "sort", Message: "unknown sort field"}
+	}
+
+	p, ferr := Plain(page, size, order)
+	if ferr != nil {
+		return Page{}, field, ferr
+	}
+
+	p.sort = sort
+
+	decoded, err := decodeCursor(rawCursor, p.sortKey())
+	if err != nil {
+		return Page{}, field, &FieldError{Field: "cursor", Message: err.Error()}
+	}
+
+	p.cursor = decoded
+
+	return p, field, nil
+}
+
+// Plain validates list parameters of the request with the fixed sort column.
+func Plain(page, size *int, order string) (Page, *FieldError) {
+	if order != "asc" && order != "desc" {
+		return Page{}, &FieldError{Field: "order", Message: "order must be asc or desc"}
+	}
+
+	if ferr := CheckPage(page, size); ferr != nil {
+		return Page{}, ferr
+	}
+
+	return Page{page: page, size: size, order: order}, nil
+}
+
+// Keyset wraps inner query into one which is ordered by column and t.id and
+// limited to the requested page. One extra row is requested to know, whether
+// the next page exists.
+func (p Page) Keyset(q *Query, inner, column string) string {
+	direction, comparison := "asc", ">"
+	if p.order == "desc" {
+		direction, comparison = "desc", "<"
+	}
+
+	query := `select * from (` + inner + `) t`
+	if p.cursor != nil {
+		query += fmt.Sprintf(` where (%s, t.id) %s (%s, %s)`, column, comparison, q.Bind(p.cursor.Value), q.Bind(p.cursor.ID))
+	}
+
+	query += fmt.Sprintf(` order by %s %s, t.id %s`, column, direction, direction)
+
+	if p.size == nil {
+		return query
+	}
+
+	query += ` limit ` + q.Bind(*p.size+1)
+	if p.cursor == nil {
+		query += ` offset ` + q.Bind(*p.size*(lo.FromPtrOr(p.page, 1)-1))
+	}
+
+	return query
+}
+
+func (p Page) sortKey() string {
+	return p.sort + ":" + p.order
+}
+
+// Cut drops the extra row requested by Keyset.
+func Cut[T any](p Page, items []T) []T {
+	if p.size == nil || len(items) <= *p.size {
+		return items
+	}
+
+	return items[:*p.size]
+}
+
+// Trim drops the extra row requested by Keyset and returns the cursor of the
+// next page if there is one.
+func Trim[T any](p Page, field SortField[T], items []T, id func(item T) int) ([]T, *string) {
+	if p.size == nil || len(items) <= *p.size {
+		return items, nil
+	}
+
+	items = items[:*p.size]
+	if len(items) == 0 {
+		return items, nil
+	}
+
+	last := items[len(items)-1]
+
+	next := cursor{
+		Sort:  p.sortKey(),
+		Value: field.Value(last),
+		ID:    id(last),
+	}
+
+	return items, lo.ToPtr(next.encode())
+}
@@ -0,0 +1,159 @@
+package listing
+
+import (
+	"github.com/samber/lo"
+	"testing"
+)
+
+type item struct {
+	ID   int
+	Name string
+}
+
+var sorts = map[string]SortField[item]{
+	"name": {Column: "t.name", Value: func(i item) any { return i.Name }},
+}
+
+func TestNew(t *testing.T) {
+	_, cursor := Trim(Page{size: lo.ToPtr(1), sort: "name", order: "asc"}, sorts["name"], []item{{1, "a"}, {2, "b"}}, func(i item) int { return i.ID })
+
+	tests := []struct {
+		name   string
+		page   *int
+		size   *int
+		sort   string
+		order  string
+		cursor *string
+		field  string
+	}{
+		{name: "defaults", sort: "name", order: "asc"},
+		{name: "page and size", page: lo.ToPtr(2), size: lo.ToPtr(10), sort: "name", order: "desc"},
+		{name: "cursor", size: lo.ToPtr(1), sort: "name", order: "asc", cursor: cursor},
+		{name: "zero size", size: lo.ToPtr(0), sort: "name", order: "asc", field: "size"},
+		{name: "negative size", size: lo.ToPtr(-5), sort: "name", order: "asc", field: "size"},
+		{name: "zero page", page: lo.ToPtr(0), size: lo.ToPtr(10), sort: "name", order: "asc", field: "page"},
+		{name: "page without size", page: lo.ToPtr(2), sort: "name", order: "asc", field: "page"},
+		{name: "unknown sort", sort: "author", order: "asc", field: "sort"},
+		{name: "unknown order", sort: "name", order: "up", field: "order"},
+		{name: "broken cursor", sort: "name", order: "asc", cursor: lo.ToPtr("!!!"), field: "cursor"},
+		{name: "cursor of other order", sort: "name", order: "desc", cursor: cursor, field: "cursor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, ferr := New(sorts, tt.page, tt.size, tt.sort, tt.order, tt.cursor)
+
+			switch {
+			case tt.field == "" && ferr != nil:
+				t.Fatalf("unexpected error: %v", ferr)
+			case tt.field != "" && ferr == nil:
+				t.Fatalf("expected error for %s", tt.field)
+			case tt.field != "" && ferr.Field != tt.field:
+				t.Fatalf("expected error for %s, got %v", tt.field, ferr)
+			}
+		})
+	}
+}
+
+func TestTrim(t *testing.T) {
+	items := []item{{1, "a"}, {2, "b"}, {3, "c"}}
+	id := func(i item) int { return i.ID }
+
+	tests := []struct {
+		name     string
+		size     *int
+		items    []item
+		expected int
+		next     bool
+	}{
+		{name: "without size", items: items, expected: 3},
+		{name: "last page", size: lo.ToPtr(3), items: items, expected: 3},
+		{name: "more pages", size: lo.ToPtr(2), items: items, expected: 2, next: true},
+		{name: "empty", size: lo.ToPtr(2), expected: 0},
+		{name: "zero size", size: lo.ToPtr(0), items: items[:1], expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Page{size: tt.size, sort: "name", order: "asc"}
+
+			trimmed, next := Trim(p, sorts["name"], tt.items, id)
+			if len(trimmed) != tt.expected {
+				t.Fatalf("expected %d items, got %d", tt.expected, len(trimmed))
+			}
+
+			if (next != nil) != tt.next {
+				t.Fatalf("expected next cursor %v, got %v", tt.next, next)
+			}
+
+			if next == nil {
+				return
+			}
+
+			decoded, err := decodeCursor(next, "name:asc")
+			if err != nil {
+				t.Fatalf("decode cursor: %v", err)
+			}
+
+			if decoded.ID != trimmed[len(trimmed)-1].ID || decoded.Value != trimmed[len(trimmed)-1].Name {
+				t.Fatalf("cursor %+v does not point to the last item", decoded)
+			}
+		})
+	}
+}
+
+func TestKeyset(t *testing.T) {
+	last := &cursor{Sort: "name:desc", Value: "b", ID: 2}
+
+	tests := []struct {
+		name     string
+		page     Page
+		expected string
+		args     []any
+	}{
+		{
+			name:     "without size",
+			page:     Page{order: "asc"},
+			expected: `select * from (inner) t order by t.name asc, t.id asc`,
+		},
+		{
+			name:     "first page",
+			page:     Page{size: lo.ToPtr(10), order: "asc"},
+			expected: `select * from (inner) t order by t.name asc, t.id asc limit $1 offset $2`,
+			args:     []any{11, 0},
+		},
+		{
+			name:     "third page",
+			page:     Page{page: lo.ToPtr(3), size: lo.ToPtr(10), order: "asc"},
+			expected: `select * from (inner) t order by t.name asc, t.id asc limit $1 offset $2`,
+			args:     []any{11, 20},
+		},
+		{
+			name:     "cursor",
+			page:     Page{size: lo.ToPtr(10), order: "desc", cursor: last},
+			expected: `select * from (inner) t where (t.name, t.id) < ($1, $2) order by t.name desc, t.id desc limit $3`,
+			args:     []any{"b", 2, 11},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var q Query
+			query := tt.page.Keyset(&q, "inner", "t.name")
+
+			if query != tt.expected {
+				t.Fatalf("expected query %q, got %q", tt.expected, query)
+			}
+
+			if len(q.Args) != len(tt.args) {
+				t.Fatalf("expected args %v, got %v", tt.args, q.Args)
+			}
+
+			for i := range tt.args {
+				if q.Args[i] != tt.args[i] {
+					t.Fatalf("expected args %v, got %v", tt.args, q.Args)
+				}
+			}
+		})
+	}
+}
@@ -14,22 +14,64 @@ import (
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 )
 
+// ErrorDescription defines model for ErrorDescription.
+type ErrorDescription struct {
+	Error string `json:"error"`
+	Field string `json:"field"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	// Message Информация об ошибке
+	Message string `json:"message"`
+}
+
+// PenaltyRequest defines model for PenaltyRequest.
+type PenaltyRequest struct {
+	// Stars На сколько опустить рейтинг
+	Stars int `json:"stars"`
+
+	// Username Имя пользователя
+	Username string `json:"username"`
+}
+
 // Rating defines model for Rating.
 type Rating struct {
 	// Stars Количество здесь у пользователя
 	Stars int `json:"stars"`
 }
 
+// ValidationErrorResponse defines model for ValidationErrorResponse.
+type ValidationErrorResponse struct {
+	// Errors Массив полей с описанием ошибки
+	Errors []ErrorDescription `json:"errors"`
+
+	// Message Информация об ошибке
+	Message string `json:"message"`
+}
+
 // SaveViolationsParams defines parameters for SaveViolations.
 type SaveViolationsParams struct {
 	Count int `form:"count" json:"count"`
+
+	// Penalty Количество звезд, снимаемых дополнительно к нарушениям, например за списанные книги
+	Penalty *int `form:"penalty,omitempty" json:"penalty,omitempty"`
+
+	// Username Пользователь, нарушения которого учитываются, по умолчанию текущий. Другого пользователя может указать только сотрудник библиотеки
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
 }
 
+// PenalizeJSONRequestBody defines body for Penalize for application/json ContentType.
+type PenalizeJSONRequestBody = PenaltyRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Получить информацию по рейтингу пользователя
 	// (GET /api/v1/rating)
 	Get(ctx echo.Context) error
+	// Оштрафовать пользователя, опустив его рейтинг
+	// (POST /api/v1/rating/penalty)
+	Penalize(ctx echo.Context) error
 	// Опустить или поднять рейтинг пользователя в зависимости от нарушений
 	// (POST /api/v1/rating/violation)
 	SaveViolations(ctx echo.Context, params SaveViolationsParams) error
@@ -52,6 +94,15 @@ func (w *ServerInterfaceWrapper) Get(ctx echo.Context) error {
 	return err
 }
 
+// Penalize converts echo context to params.
+func (w *ServerInterfaceWrapper) Penalize(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Penalize(ctx)
+	return err
+}
+
 // SaveViolations converts echo context to params.
 func (w *ServerInterfaceWrapper) SaveViolations(ctx echo.Context) error {
 	var err error
@@ -65,6 +116,20 @@ func (w *ServerInterfaceWrapper) SaveViolations(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter count: %s", err))
 	}
 
+	// ------------- Optional query parameter "penalty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "penalty", ctx.QueryParams(), &params.Penalty)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter penalty: %s", err))
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.SaveViolations(ctx, params)
 	return err
@@ -108,6 +173,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.GET(baseURL+"/api/v1/rating", wrapper.Get)
+	router.POST(baseURL+"/api/v1/rating/penalty", wrapper.Penalize)
 	router.POST(baseURL+"/api/v1/rating/violation", wrapper.SaveViolations)
 	router.GET(baseURL+"/manage/health", wrapper.Health)
 
@@ -129,6 +195,40 @@ func (response Get200JSONResponse) VisitGetResponse(w http.ResponseWriter) error
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PenalizeRequestObject struct {
+	Body *PenalizeJSONRequestBody
+}
+
+type PenalizeResponseObject interface {
+	VisitPenalizeResponse(w http.ResponseWriter) error
+}
+
+type Penalize204Response struct {
+}
+
+func (response Penalize204Response) VisitPenalizeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type Penalize400JSONResponse ValidationErrorResponse
+
+func (response Penalize400JSONResponse) VisitPenalizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Penalize403JSONResponse ErrorResponse
+
+func (response Penalize403JSONResponse) VisitPenalizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type SaveViolationsRequestObject struct {
 	Params SaveViolationsParams
 }
@@ -145,6 +245,15 @@ func (response SaveViolations204Response) VisitSaveViolationsResponse(w http.Res
 	return nil
 }
 
+type SaveViolations403JSONResponse ErrorResponse
+
+func (response SaveViolations403JSONResponse) VisitSaveViolationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type HealthRequestObject struct {
 }
 
@@ -165,6 +274,9 @@ type StrictServerInterface interface {
 	// Получить информацию по рейтингу пользователя
 	// (GET /api/v1/rating)
 	Get(ctx context.Context, request GetRequestObject) (GetResponseObject, error)
+	// Оштрафовать пользователя, опустив его рейтинг
+	// (POST /api/v1/rating/penalty)
+	Penalize(ctx context.Context, request PenalizeRequestObject) (PenalizeResponseObject, error)
 	// Опустить или поднять рейтинг пользователя в зависимости от нарушений
 	// (POST /api/v1/rating/violation)
 	SaveViolations(ctx context.Context, request SaveViolationsRequestObject) (SaveViolationsResponseObject, error)
@@ -208,6 +320,35 @@ func (sh *strictHandler) Get(ctx echo.Context) error {
 	return nil
 }
 
+// Penalize operation middleware
+func (sh *strictHandler) Penalize(ctx echo.Context) error {
+	var request PenalizeRequestObject
+
+	var body PenalizeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.Penalize(ctx.Request().Context(), request.(PenalizeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Penalize")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PenalizeResponseObject); ok {
+		return validResponse.VisitPenalizeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // SaveViolations operation middleware
 func (sh *strictHandler) SaveViolations(ctx echo.Context, params SaveViolationsParams) error {
 	var request SaveViolationsRequestObject
@@ -37,19 +37,41 @@ func (s *Server) Get(ctx context.Context, request generated.GetRequestObject) (g
 func (s *Server) SaveViolations(ctx context.Context, request generated.SaveViolationsRequestObject) (generated.SaveViolationsResponseObject, error) {
 	logger := slog.With("handler", "SaveViolations")
 
-	stars, err := s.get(ctx, contextutils.GetUser(ctx))
+	username := contextutils.GetUser(ctx)
+	if request.Params.Username != nil && *request.Params.Username != username {
+		if !contextutils.IsStaff(ctx) {
+			return generated.SaveViolations403JSONResponse{
+				Message: "only library staff can save violations of other users",
+			}, nil
+		}
+
+		username = *request.Params.Username
+	}
+
+	stars, err := s.get(ctx, username)
 	if err != nil {
 		logger.Error("get user rating", "error", err)
 		return nil, fmt.Errorf("get user rating: %w", err)
 	}
 
-	if request.Params.Count > 0 {
+	penalty := 0
+	if request.Params.Penalty != nil && *request.Params.Penalty > 0 {
+		penalty = *request.Params.Penalty
+	}
+
+	switch {
+	case request.Params.Count > 0:
 		stars -= 10 * request.Params.Count
-	} else {
+	case penalty == 0:
 		stars++
 	}
 
-	if err := s.save(ctx, contextutils.GetUser(ctx), stars); err != nil {
+	// As in Penalize, the penalty leaves the user at least one star.
+	if penalty > 0 && stars > 1 {
+		stars = max(stars-penalty, 1)
+	}
+
+	if err := s.save(ctx, username, stars); err != nil {
 		logger.Error("save user rating", "error", err)
 		return nil, fmt.Errorf("save user rating: %w", err)
 	}
@@ -57,6 +79,38 @@ func (s *Server) SaveViolations(ctx context.Context, request generated.SaveViola
 	return generated.SaveViolations204Response{}, nil
 }
 
+func (s *Server) Penalize(ctx context.Context, request generated.PenalizeRequestObject) (generated.PenalizeResponseObject, error) {
+	logger := slog.With("handler", "Penalize")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.Penalize403JSONResponse{
+			Message: "only library staff can penalize users",
+		}, nil
+	}
+
+	if request.Body.Stars < 1 || request.Body.Stars > 100 {
+		return generated.Penalize400JSONResponse{
+			Message: "invalid request parameters",
+			Errors: []generated.ErrorDescription{
+				{Field: "stars", Error: "stars must be between 1 and 100"},
+			},
+		}, nil
+	}
+
+	if _, err := s.get(ctx, request.Body.Username); err != nil {
+		logger.Error("get user rating", "error", err)
+		return nil, fmt.Errorf("get user rating: %w", err)
+	}
+
+	query := `update rating set stars = greatest(stars - $2, 1) where username = $1`
+	if _, err := s.db.ExecContext(ctx, query, request.Body.Username, request.Body.Stars); err != nil {
+		logger.Error("update user rating", "error", err)
+		return nil, fmt.Errorf("update user rating: %w", err)
+	}
+
+	return generated.Penalize204Response{}, nil
+}
+
 func (s *Server) get(ctx context.Context, username string) (int, error) {
 	query := `select stars from rating where username = $1`
 	var stars []int
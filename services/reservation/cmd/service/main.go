@@ -9,11 +9,15 @@ import (
 	_ "github.com/lib/pq"
 	"github.com/muhomorfus/ds-lab-02/services/auth/jwt"
 	"github.com/muhomorfus/ds-lab-02/services/reservation/deployments/migrations"
+	"github.com/muhomorfus/ds-lab-02/services/reservation/internal/clients/library"
+	"github.com/muhomorfus/ds-lab-02/services/reservation/internal/expiry"
 	"github.com/muhomorfus/ds-lab-02/services/reservation/internal/generated"
 	"github.com/muhomorfus/ds-lab-02/services/reservation/internal/openapi"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 )
 
 func main() {
@@ -38,7 +42,27 @@ func run() error {
 		return fmt.Errorf("run migrations: %w", err)
 	}
 
-	server := openapi.New(db)
+	libraryClient, err := library.NewClientWithResponses(cfg.LibraryAddress, library.WithHTTPClient(&http.Client{}))
+	if err != nil {
+		return fmt.Errorf("create library client: %w", err)
+	}
+
+	server := openapi.New(db, libraryClient, openapi.LoanPolicy{
+		MinDays:           cfg.MinLoanDays,
+		MaxDays:           cfg.MaxLoanDays,
+		MaxRenewals:       cfg.MaxRenewals,
+		MaxTotalDays:      cfg.MaxTotalLoanDays,
+		CancelGracePeriod: cfg.CancelGracePeriod,
+		MaxAdvanceDays:    cfg.MaxAdvanceDays,
+		PickupWindow:      cfg.PickupWindow,
+	}, openapi.FinePolicy{
+		DailyRate:      cfg.FineDailyRate,
+		MaxAmount:      cfg.FineMaxAmount,
+		BlockThreshold: cfg.FineBlockThreshold,
+	}, openapi.Penalties{
+		Lost:    cfg.LostBookPenalty,
+		Damaged: cfg.DamagedBookPenalty,
+	})
 	router := echo.New()
 	router.Use(jwt.Middleware(cfg.JWKsURI))
 	generated.RegisterHandlers(router, generated.NewStrictHandler(server, nil))
@@ -46,6 +70,8 @@ func run() error {
 	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer cancel()
 
+	go expiry.New(db, cfg.ExpiryInterval).Run(ctx)
+
 	go func() {
 		<-ctx.Done()
 
@@ -61,14 +87,28 @@ func run() error {
 }
 
 type config struct {
-	PostgresHost     string `envconfig:"PGHOST" required:"true"`
-	PostgresPort     int    `envconfig:"PGPORT" required:"true"`
-	PostgresUser     string `envconfig:"PGUSER" required:"true"`
-	PostgresPassword string `envconfig:"PGPASSWORD" required:"true"`
-	PostgresDB       string `envconfig:"PGDB" required:"true"`
-	PostgresSSL      bool   `envconfig:"PGSSL" default:"false"`
-	Port             string `envconfig:"PORT" required:"true"`
-	JWKsURI          string `envconfig:"JWKS_URI" required:"true"`
+	PostgresHost       string        `envconfig:"PGHOST" required:"true"`
+	PostgresPort       int           `envconfig:"PGPORT" required:"true"`
+	PostgresUser       string        `envconfig:"PGUSER" required:"true"`
+	PostgresPassword   string        `envconfig:"PGPASSWORD" required:"true"`
+	PostgresDB         string        `envconfig:"PGDB" required:"true"`
+	PostgresSSL        bool          `envconfig:"PGSSL" default:"false"`
+	Port               string        `envconfig:"PORT" required:"true"`
+	JWKsURI            string        `envconfig:"JWKS_URI" required:"true"`
+	LibraryAddress     string        `envconfig:"LIBRARY_ADDRESS" required:"true"`
+	ExpiryInterval     time.Duration `envconfig:"EXPIRY_INTERVAL" default:"1m"`
+	MinLoanDays        int           `envconfig:"MIN_LOAN_DAYS" default:"1"`
+	MaxLoanDays        int           `envconfig:"MAX_LOAN_DAYS" default:"30"`
+	MaxRenewals        int           `envconfig:"MAX_RENEWALS" default:"2"`
+	MaxTotalLoanDays   int           `envconfig:"MAX_TOTAL_LOAN_DAYS" default:"90"`
+	CancelGracePeriod  time.Duration `envconfig:"CANCEL_GRACE_PERIOD" default:"30m"`
+	MaxAdvanceDays     int           `envconfig:"MAX_ADVANCE_DAYS" default:"14"`
+	PickupWindow       time.Duration `envconfig:"PICKUP_WINDOW" default:"48h"`
+	FineDailyRate      int           `envconfig:"FINE_DAILY_RATE" default:"1000"`
+	FineMaxAmount      int           `envconfig:"FINE_MAX_AMOUNT" default:"50000"`
+	FineBlockThreshold int           `envconfig:"FINE_BLOCK_THRESHOLD" default:"0"`
+	LostBookPenalty    int           `envconfig:"LOST_BOOK_PENALTY" default:"30"`
+	DamagedBookPenalty int           `envconfig:"DAMAGED_BOOK_PENALTY" default:"20"`
 }
 
 func (c config) dsn() string {
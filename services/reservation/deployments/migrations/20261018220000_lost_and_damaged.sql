@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table reservation
+    drop constraint reservation_status_check,
+    add constraint reservation_status_check
+        check (status in ('RENTED', 'RETURNED', 'EXPIRED', 'LOST', 'DAMAGED'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+update reservation
+set status = 'EXPIRED'
+where status in ('LOST', 'DAMAGED');
+
+alter table reservation
+    drop constraint reservation_status_check,
+    add constraint reservation_status_check
+        check (status in ('RENTED', 'RETURNED', 'EXPIRED'));
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table reservation
+    drop constraint reservation_status_check,
+    add constraint reservation_status_check
+        check (status in ('RENTED', 'OVERDUE', 'RETURNED', 'EXPIRED', 'LOST', 'DAMAGED'));
+
+create index reservation_status_till_date_idx on reservation (status, till_date);
+
+create table penalty_events
+(
+    id              serial primary key,
+    reservation_uid uuid        not null,
+    username        varchar(80) not null,
+    reason          varchar(20) not null
+        check (reason in ('OVERDUE')),
+    created_at      timestamp   not null default now(),
+    applied_at      timestamp,
+    unique (reservation_uid, reason)
+);
+
+create index penalty_events_pending_idx on penalty_events (username) where applied_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table penalty_events;
+
+drop index reservation_status_till_date_idx;
+
+update reservation
+set status = 'RENTED'
+where status = 'OVERDUE';
+
+alter table reservation
+    drop constraint reservation_status_check,
+    add constraint reservation_status_check
+        check (status in ('RENTED', 'RETURNED', 'EXPIRED', 'LOST', 'DAMAGED'));
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+create table reservation_renewals
+(
+    id                 serial primary key,
+    reservation_id     int         not null references reservation (id) on delete cascade,
+    previous_till_date timestamp   not null,
+    till_date          timestamp   not null,
+    renewed_by         varchar(80) not null,
+    renewed_at         timestamp   not null default now(),
+    check (till_date > previous_till_date)
+);
+
+create index reservation_renewals_reservation_id_idx on reservation_renewals (reservation_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table reservation_renewals;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table reservation
+    drop constraint reservation_status_check,
+    add constraint reservation_status_check
+        check (status in ('RENTED', 'OVERDUE', 'RETURNED', 'EXPIRED', 'CANCELLED', 'LOST', 'DAMAGED'));
+
+create table reservation_events
+(
+    id             serial primary key,
+    reservation_id int         not null references reservation (id) on delete cascade,
+    from_status    varchar(20),
+    to_status      varchar(20) not null,
+    actor          varchar(80) not null,
+    comment        varchar(255),
+    created_at     timestamp   not null default now()
+);
+
+create index reservation_events_reservation_id_idx on reservation_events (reservation_id, id);
+
+insert into reservation_events (reservation_id, from_status, to_status, actor, created_at)
+select id, null, 'RENTED', username, start_date
+from reservation;
+
+insert into reservation_events (reservation_id, from_status, to_status, actor, comment, created_at)
+select r.reservation_id, 'RENTED', 'RENTED', r.renewed_by, 'renewed till ' || to_char(r.till_date, 'YYYY-MM-DD'), r.renewed_at
+from reservation_renewals r;
+
+insert into reservation_events (reservation_id, from_status, to_status, actor, created_at)
+select id, 'RENTED', status, 'system', now()
+from reservation
+where status <> 'RENTED';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table reservation_events;
+
+delete
+from reservation
+where status = 'CANCELLED';
+
+alter table reservation
+    drop constraint reservation_status_check,
+    add constraint reservation_status_check
+        check (status in ('RENTED', 'OVERDUE', 'RETURNED', 'EXPIRED', 'LOST', 'DAMAGED'));
+-- +goose StatementEnd
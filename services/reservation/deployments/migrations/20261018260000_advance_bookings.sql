@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table reservation
+    drop constraint reservation_status_check,
+    add constraint reservation_status_check
+        check (status in ('PENDING', 'RENTED', 'OVERDUE', 'RETURNED', 'EXPIRED', 'CANCELLED', 'LOST', 'DAMAGED')),
+    add column pickup_until timestamp,
+    add constraint reservation_pending_pickup_check
+        check (status <> 'PENDING' or pickup_until is not null);
+
+create index reservation_pending_pickup_until_idx on reservation (pickup_until) where status = 'PENDING';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index reservation_pending_pickup_until_idx;
+
+update reservation
+set status = 'CANCELLED'
+where status = 'PENDING';
+
+alter table reservation
+    drop constraint reservation_pending_pickup_check,
+    drop column pickup_until,
+    drop constraint reservation_status_check,
+    add constraint reservation_status_check
+        check (status in ('RENTED', 'OVERDUE', 'RETURNED', 'EXPIRED', 'CANCELLED', 'LOST', 'DAMAGED'));
+-- +goose StatementEnd
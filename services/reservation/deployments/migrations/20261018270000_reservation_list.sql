@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+create index reservation_username_start_date_idx on reservation (username, start_date, id);
+create index reservation_username_till_date_idx on reservation (username, till_date, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index reservation_username_till_date_idx;
+drop index reservation_username_start_date_idx;
+-- +goose StatementEnd
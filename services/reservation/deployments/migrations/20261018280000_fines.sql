@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+create table fine_tariffs
+(
+    id          serial primary key,
+    library_uid uuid,
+    genre       varchar(255),
+    daily_rate  int         not null check (daily_rate >= 0),
+    max_amount  int check (max_amount >= 0),
+    updated_by  varchar(80) not null,
+    updated_at  timestamp   not null default now()
+);
+
+create unique index fine_tariffs_scope_idx
+    on fine_tariffs (coalesce(library_uid, '00000000-0000-0000-0000-000000000000'), coalesce(genre, ''));
+
+create table fines
+(
+    id             serial primary key,
+    fine_uid       uuid unique not null,
+    reservation_id int unique  not null references reservation (id) on delete cascade,
+    username       varchar(80) not null,
+    amount         int         not null check (amount > 0),
+    days_late      int         not null check (days_late > 0),
+    status         varchar(20) not null
+        check (status in ('UNPAID', 'PAID', 'WAIVED')),
+    comment        varchar(255),
+    created_at     timestamp   not null default now(),
+    closed_by      varchar(80),
+    closed_at      timestamp,
+    check ((status = 'UNPAID') = (closed_at is null))
+);
+
+create index fines_username_idx on fines (username, id);
+create index fines_unpaid_idx on fines (username) where status = 'UNPAID';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table fines;
+drop table fine_tariffs;
+-- +goose StatementEnd
@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Concurrent checkouts could leave a user with several active loans of the
+-- same book. Such loans hold copies in the library, so they are not closed
+-- here: the migration fails and lists them, staff resolve them through the
+-- API (return, cancel or write off) and run the migration again.
+do
+$$
+    declare
+        report text;
+    begin
+        select string_agg(format('%s: %s (%s)', username, book_uid, loans), E'\n')
+        into report
+        from (select username, book_uid, string_agg(reservation_uid || ' ' || status, ', ' order by id) as loans
+              from reservation
+              where status in ('PENDING', 'RENTED', 'OVERDUE')
+              group by username, book_uid
+              having count(*) > 1) d;
+
+        if report is not null then
+            raise exception 'several active loans of the same book, resolve them before migrating:%', E'\n' || report;
+        end if;
+    end
+$$;
+
+create unique index reservation_active_loan_idx on reservation (username, book_uid)
+    where status in ('PENDING', 'RENTED', 'OVERDUE');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index reservation_active_loan_idx;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table penalty_events
+    add column claim_uid  uuid,
+    add column claimed_at timestamp;
+
+create index penalty_events_claim_uid_idx on penalty_events (claim_uid);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index penalty_events_claim_uid_idx;
+
+alter table penalty_events
+    drop column claim_uid,
+    drop column claimed_at;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Written off books take rating stars from the reader. Such penalties carry
+-- the number of stars, overdue ones count as violations and have no stars.
+alter table penalty_events
+    add column stars int not null default 0,
+    drop constraint penalty_events_reason_check,
+    add constraint penalty_events_reason_check
+        check (reason in ('OVERDUE', 'LOST', 'DAMAGED'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete
+from penalty_events
+where reason in ('LOST', 'DAMAGED');
+
+alter table penalty_events
+    drop column stars,
+    drop constraint penalty_events_reason_check,
+    add constraint penalty_events_reason_check
+        check (reason in ('OVERDUE'));
+-- +goose StatementEnd
@@ -0,0 +1,3 @@
+package library
+
+//go:generate oapi-codegen --config=oapi.yaml ../../../../library/api/service.yaml
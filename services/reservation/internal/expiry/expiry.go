@@ -0,0 +1,92 @@
+// Package expiry marks reservations, which were not returned in time, as
+// overdue and records penalty events for their readers. It also cancels
+// advance bookings, which were not picked up in time.
+package expiry
+
+import (
+	"context"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/muhomorfus/ds-lab-02/services/reservation/internal/state"
+	"github.com/samber/lo"
+	"log/slog"
+	"time"
+)
+
+type Job struct {
+	db       *sqlx.DB
+	interval time.Duration
+}
+
+func New(db *sqlx.DB, interval time.Duration) *Job {
+	return &Job{db: db, interval: interval}
+}
+
+func (j *Job) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.tick(ctx); err != nil {
+			slog.Error("expire reservations", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *Job) tick(ctx context.Context) error {
+	tx, err := j.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Only one replica handles overdue reservations at a time, others skip
+	// the tick while the lock is held.
+	var locked bool
+	if err := tx.GetContext(ctx, &locked, `select pg_try_advisory_xact_lock(hashtext('reservation_expiry'))`); err != nil {
+		return fmt.Errorf("take advisory lock: %w", err)
+	}
+
+	if !locked {
+		return nil
+	}
+
+	overdue, err := state.TransitionAll(ctx, tx, state.Rented, state.Overdue, state.SystemActor, nil, `till_date < current_date`)
+	if err != nil {
+		return fmt.Errorf("mark overdue reservations: %w", err)
+	}
+
+	query := `insert into penalty_events (reservation_uid, username, reason)
+	select reservation_uid, username, 'OVERDUE' from reservation where id = any($1)
+	on conflict do nothing`
+
+	if _, err := tx.ExecContext(ctx, query, pq.Array(overdue)); err != nil {
+		return fmt.Errorf("insert penalty events: %w", err)
+	}
+
+	cancelled, err := state.TransitionAll(ctx, tx, state.Pending, state.Cancelled, state.SystemActor, lo.ToPtr("not picked up in time"), `pickup_until < now() at time zone 'utc'`)
+	if err != nil {
+		return fmt.Errorf("cancel expired bookings: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	if len(overdue) > 0 {
+		slog.Info("reservations marked overdue", "count", len(overdue))
+	}
+
+	if len(cancelled) > 0 {
+		slog.Info("expired bookings cancelled", "count", len(cancelled))
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
@@ -17,17 +18,76 @@ import (
 
 // Defines values for BookReservationResponseStatus.
 const (
-	BookReservationResponseStatusEXPIRED  BookReservationResponseStatus = "EXPIRED"
-	BookReservationResponseStatusRENTED   BookReservationResponseStatus = "RENTED"
-	BookReservationResponseStatusRETURNED BookReservationResponseStatus = "RETURNED"
+	BookReservationResponseStatusCANCELLED BookReservationResponseStatus = "CANCELLED"
+	BookReservationResponseStatusDAMAGED   BookReservationResponseStatus = "DAMAGED"
+	BookReservationResponseStatusEXPIRED   BookReservationResponseStatus = "EXPIRED"
+	BookReservationResponseStatusLOST      BookReservationResponseStatus = "LOST"
+	BookReservationResponseStatusOVERDUE   BookReservationResponseStatus = "OVERDUE"
+	BookReservationResponseStatusPENDING   BookReservationResponseStatus = "PENDING"
+	BookReservationResponseStatusRENTED    BookReservationResponseStatus = "RENTED"
+	BookReservationResponseStatusRETURNED  BookReservationResponseStatus = "RETURNED"
+)
+
+// Defines values for FineResponseStatus.
+const (
+	FineResponseStatusPAID   FineResponseStatus = "PAID"
+	FineResponseStatusUNPAID FineResponseStatus = "UNPAID"
+	FineResponseStatusWAIVED FineResponseStatus = "WAIVED"
 )
 
 // Defines values for TakeBookResponseStatus.
 const (
-	TakeBookResponseStatusEXPIRED  TakeBookResponseStatus = "EXPIRED"
-	TakeBookResponseStatusLOST     TakeBookResponseStatus = "LOST"
-	TakeBookResponseStatusRENTED   TakeBookResponseStatus = "RENTED"
-	TakeBookResponseStatusRETURNED TakeBookResponseStatus = "RETURNED"
+	TakeBookResponseStatusCANCELLED TakeBookResponseStatus = "CANCELLED"
+	TakeBookResponseStatusDAMAGED   TakeBookResponseStatus = "DAMAGED"
+	TakeBookResponseStatusEXPIRED   TakeBookResponseStatus = "EXPIRED"
+	TakeBookResponseStatusLOST      TakeBookResponseStatus = "LOST"
+	TakeBookResponseStatusOVERDUE   TakeBookResponseStatus = "OVERDUE"
+	TakeBookResponseStatusPENDING   TakeBookResponseStatus = "PENDING"
+	TakeBookResponseStatusRENTED    TakeBookResponseStatus = "RENTED"
+	TakeBookResponseStatusRETURNED  TakeBookResponseStatus = "RETURNED"
+)
+
+// Defines values for WriteOffRequestStatus.
+const (
+	WriteOffRequestStatusDAMAGED WriteOffRequestStatus = "DAMAGED"
+	WriteOffRequestStatusLOST    WriteOffRequestStatus = "LOST"
+)
+
+// Defines values for WriteOffReservationResponseStatus.
+const (
+	WriteOffReservationResponseStatusDAMAGED WriteOffReservationResponseStatus = "DAMAGED"
+	WriteOffReservationResponseStatusLOST    WriteOffReservationResponseStatus = "LOST"
+)
+
+// Defines values for ListFinesParamsStatus.
+const (
+	ListFinesParamsStatusPAID   ListFinesParamsStatus = "PAID"
+	ListFinesParamsStatusUNPAID ListFinesParamsStatus = "UNPAID"
+	ListFinesParamsStatusWAIVED ListFinesParamsStatus = "WAIVED"
+)
+
+// Defines values for ListParamsStatus.
+const (
+	CANCELLED ListParamsStatus = "CANCELLED"
+	DAMAGED   ListParamsStatus = "DAMAGED"
+	EXPIRED   ListParamsStatus = "EXPIRED"
+	LOST      ListParamsStatus = "LOST"
+	OVERDUE   ListParamsStatus = "OVERDUE"
+	PENDING   ListParamsStatus = "PENDING"
+	RENTED    ListParamsStatus = "RENTED"
+	RETURNED  ListParamsStatus = "RETURNED"
+)
+
+// Defines values for ListParamsSort.
+const (
+	StartDate ListParamsSort = "startDate"
+	TillDate  ListParamsSort = "tillDate"
+)
+
+// Defines values for ListParamsOrder.
+const (
+	Asc  ListParamsOrder = "asc"
+	Desc ListParamsOrder = "desc"
 )
 
 // BookReservationResponse defines model for BookReservationResponse.
@@ -38,6 +98,9 @@ type BookReservationResponse struct {
 	// LibraryUid UUID библиотеки
 	LibraryUid openapi_types.UUID `json:"libraryUid"`
 
+	// PickupUntil До какого времени нужно забрать заранее забронированную книгу
+	PickupUntil *time.Time `json:"pickupUntil,omitempty"`
+
 	// ReservationUid UUID бронирования
 	ReservationUid openapi_types.UUID `json:"reservationUid"`
 
@@ -49,6 +112,9 @@ type BookReservationResponse struct {
 
 	// TillDate Дата окончания бронирования
 	TillDate string `json:"tillDate"`
+
+	// Username Имя пользователя, взявшего книгу
+	Username string `json:"username"`
 }
 
 // BookReservationResponseStatus Статус бронирования книги
@@ -66,18 +132,148 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// FineResponse defines model for FineResponse.
+type FineResponse struct {
+	// Amount Сумма штрафа в копейках
+	Amount int `json:"amount"`
+
+	// ClosedAt Время оплаты или списания штрафа
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
+
+	// ClosedBy Сотрудник, принявший оплату или списавший штраф
+	ClosedBy *string `json:"closedBy,omitempty"`
+
+	// Comment Причина списания штрафа
+	Comment *string `json:"comment,omitempty"`
+
+	// CreatedAt Время начисления штрафа
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DaysLate На сколько дней просрочен возврат
+	DaysLate int `json:"daysLate"`
+
+	// FineUid UUID штрафа
+	FineUid openapi_types.UUID `json:"fineUid"`
+
+	// ReservationUid UUID бронирования
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+
+	// Status Статус штрафа
+	Status FineResponseStatus `json:"status"`
+
+	// Username Имя пользователя
+	Username string `json:"username"`
+}
+
+// FineResponseStatus Статус штрафа
+type FineResponseStatus string
+
+// FineTariffRequest defines model for FineTariffRequest.
+type FineTariffRequest struct {
+	// DailyRate Штраф за день просрочки в копейках
+	DailyRate int `json:"dailyRate"`
+
+	// Genre Жанр книги, если тариф действует только для него
+	Genre *string `json:"genre,omitempty"`
+
+	// LibraryUid UUID библиотеки, если тариф действует только в ней
+	LibraryUid *openapi_types.UUID `json:"libraryUid,omitempty"`
+
+	// MaxAmount Максимальная сумма штрафа за одно бронирование в копейках
+	MaxAmount *int `json:"maxAmount,omitempty"`
+}
+
+// FineTariffResponse defines model for FineTariffResponse.
+type FineTariffResponse struct {
+	// DailyRate Штраф за день просрочки в копейках
+	DailyRate int `json:"dailyRate"`
+
+	// Genre Жанр книги, отсутствует у тарифа для всех жанров
+	Genre *string `json:"genre,omitempty"`
+
+	// LibraryUid UUID библиотеки, отсутствует у тарифа для всех библиотек
+	LibraryUid *openapi_types.UUID `json:"libraryUid,omitempty"`
+
+	// MaxAmount Максимальная сумма штрафа за одно бронирование в копейках
+	MaxAmount *int `json:"maxAmount,omitempty"`
+
+	// UpdatedAt Время изменения тарифа
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	// UpdatedBy Сотрудник, изменивший тариф
+	UpdatedBy string `json:"updatedBy"`
+}
+
 // FinishReservationRequest defines model for FinishReservationRequest.
 type FinishReservationRequest struct {
-	// Date Дата возврата
-	Date string `json:"date"`
+	// Date Дата возврата, по умолчанию текущая. Указывать может только сотрудник библиотеки
+	Date *string `json:"date,omitempty"`
+
+	// Genre Жанр книги для выбора тарифа штрафа за просрочку
+	Genre *string `json:"genre,omitempty"`
 }
 
 // FinishReservationResponse defines model for FinishReservationResponse.
 type FinishReservationResponse struct {
+	Fine *FineResponse `json:"fine,omitempty"`
+
 	// Violation Нарушены ли правила окончания брони
 	Violation bool `json:"violation"`
 }
 
+// PenaltiesResponse defines model for PenaltiesResponse.
+type PenaltiesResponse struct {
+	// ClaimUid UUID для подтверждения учета штрафов
+	ClaimUid openapi_types.UUID `json:"claimUid"`
+
+	// Count Количество нарушений, например просроченных книг
+	Count int `json:"count"`
+
+	// Stars Количество звезд рейтинга, снимаемых за списанные книги
+	Stars int `json:"stars"`
+}
+
+// RenewRequest defines model for RenewRequest.
+type RenewRequest struct {
+	// TillDate Новая дата окончания бронирования
+	TillDate string `json:"tillDate"`
+}
+
+// ReservationEventResponse defines model for ReservationEventResponse.
+type ReservationEventResponse struct {
+	// Actor Пользователь, изменивший бронирование, или system для фоновых задач
+	Actor string `json:"actor"`
+
+	// Comment Комментарий к событию
+	Comment *string `json:"comment,omitempty"`
+
+	// CreatedAt Время события
+	CreatedAt time.Time `json:"createdAt"`
+
+	// FromStatus Статус до изменения, отсутствует для создания бронирования
+	FromStatus *string `json:"fromStatus,omitempty"`
+
+	// ToStatus Статус после изменения
+	ToStatus string `json:"toStatus"`
+}
+
+// ReservationPaginationResponse defines model for ReservationPaginationResponse.
+type ReservationPaginationResponse struct {
+	Items []BookReservationResponse `json:"items"`
+
+	// NextCursor Курсор следующей страницы, отсутствует на последней странице
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// Page Номер страницы
+	Page *int `json:"page,omitempty"`
+
+	// PageSize Количество элементов на странице
+	PageSize *int `json:"pageSize,omitempty"`
+
+	// TotalElements Общее количество элементов
+	TotalElements int `json:"totalElements"`
+}
+
 // TakeBookRequest defines model for TakeBookRequest.
 type TakeBookRequest struct {
 	// BookUid UUID книги
@@ -86,6 +282,12 @@ type TakeBookRequest struct {
 	// LibraryUid UUID библиотеки
 	LibraryUid openapi_types.UUID `json:"libraryUid"`
 
+	// MaxActive Сколько незакрытых бронирований может быть у пользователя до нового, обычно его рейтинг. Значение не проверяется сервисом, его передает gateway по рейтингу пользователя, поэтому сервис не должен быть доступен клиентам напрямую
+	MaxActive int `json:"maxActive"`
+
+	// PickupDate Дата, когда пользователь заберет книгу. Без нее книга выдается сразу
+	PickupDate *string `json:"pickupDate,omitempty"`
+
 	// TillDate Дата окончания бронирования
 	TillDate string `json:"tillDate"`
 }
@@ -98,6 +300,9 @@ type TakeBookResponse struct {
 	// LibraryUid UUID библиотеки
 	LibraryUid openapi_types.UUID `json:"libraryUid"`
 
+	// PickupUntil До какого времени нужно забрать заранее забронированную книгу
+	PickupUntil *time.Time `json:"pickupUntil,omitempty"`
+
 	// ReservationUid UUID бронирования
 	ReservationUid openapi_types.UUID `json:"reservationUid"`
 
@@ -123,29 +328,161 @@ type ValidationErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// WaiveFineRequest defines model for WaiveFineRequest.
+type WaiveFineRequest struct {
+	// Comment Причина списания штрафа
+	Comment string `json:"comment"`
+}
+
+// WriteOffRequest defines model for WriteOffRequest.
+type WriteOffRequest struct {
+	// Status Итоговый статус бронирования
+	Status WriteOffRequestStatus `json:"status"`
+}
+
+// WriteOffRequestStatus Итоговый статус бронирования
+type WriteOffRequestStatus string
+
+// WriteOffReservationResponse defines model for WriteOffReservationResponse.
+type WriteOffReservationResponse struct {
+	// BookUid UUID книги
+	BookUid openapi_types.UUID `json:"bookUid"`
+
+	// LibraryUid UUID библиотеки
+	LibraryUid openapi_types.UUID `json:"libraryUid"`
+
+	// ReservationUid UUID бронирования
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+
+	// Status Статус бронирования книги
+	Status WriteOffReservationResponseStatus `json:"status"`
+
+	// Username Имя пользователя, взявшего книгу
+	Username string `json:"username"`
+}
+
+// WriteOffReservationResponseStatus Статус бронирования книги
+type WriteOffReservationResponseStatus string
+
+// ListFinesParams defines parameters for ListFines.
+type ListFinesParams struct {
+	// Username Имя пользователя, доступно только сотрудникам библиотеки
+	Username *string `form:"username,omitempty" json:"username,omitempty"`
+
+	// Status Статус штрафа
+	Status *ListFinesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListFinesParamsStatus defines parameters for ListFines.
+type ListFinesParamsStatus string
+
+// ListParams defines parameters for List.
+type ListParams struct {
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+
+	// Status Статусы бронирований
+	Status *[]ListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Active Только незакрытые бронирования (PENDING, RENTED, OVERDUE)
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+
+	// From Бронирования, начатые не раньше этой даты
+	From *string `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Бронирования, начатые не позже этой даты
+	To *string `form:"to,omitempty" json:"to,omitempty"`
+
+	// Sort Поле сортировки
+	Sort *ListParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Направление сортировки, по умолчанию сначала новые
+	Order *ListParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор, полученный в nextCursor предыдущей страницы
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// AllUsers Бронирования всех пользователей, доступно только сотрудникам библиотеки
+	AllUsers *bool `form:"allUsers,omitempty" json:"allUsers,omitempty"`
+}
+
+// ListParamsStatus defines parameters for List.
+type ListParamsStatus string
+
+// ListParamsSort defines parameters for List.
+type ListParamsSort string
+
+// ListParamsOrder defines parameters for List.
+type ListParamsOrder string
+
+// SetFineTariffJSONRequestBody defines body for SetFineTariff for application/json ContentType.
+type SetFineTariffJSONRequestBody = FineTariffRequest
+
+// WaiveFineJSONRequestBody defines body for WaiveFine for application/json ContentType.
+type WaiveFineJSONRequestBody = WaiveFineRequest
+
 // CreateJSONRequestBody defines body for Create for application/json ContentType.
 type CreateJSONRequestBody = TakeBookRequest
 
+// RenewJSONRequestBody defines body for Renew for application/json ContentType.
+type RenewJSONRequestBody = RenewRequest
+
 // FinishJSONRequestBody defines body for Finish for application/json ContentType.
 type FinishJSONRequestBody = FinishReservationRequest
 
+// WriteOffJSONRequestBody defines body for WriteOff for application/json ContentType.
+type WriteOffJSONRequestBody = WriteOffRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Получить штрафы пользователя
+	// (GET /api/v1/fines)
+	ListFines(ctx echo.Context, params ListFinesParams) error
+	// Получить тарифы штрафов за просрочку
+	// (GET /api/v1/fines/tariffs)
+	ListFineTariffs(ctx echo.Context) error
+	// Задать тариф штрафа для библиотеки и жанра
+	// (PUT /api/v1/fines/tariffs)
+	SetFineTariff(ctx echo.Context) error
+	// Отметить штраф оплаченным
+	// (POST /api/v1/fines/{fineUid}/pay)
+	PayFine(ctx echo.Context, fineUid openapi_types.UUID) error
+	// Списать штраф
+	// (POST /api/v1/fines/{fineUid}/waive)
+	WaiveFine(ctx echo.Context, fineUid openapi_types.UUID) error
 	// Получить информацию по всем взятым в прокат книгам пользователя
 	// (GET /api/v1/reservations)
-	List(ctx echo.Context) error
+	List(ctx echo.Context, params ListParams) error
 	// Взять книгу в библиотеке
 	// (POST /api/v1/reservations)
 	Create(ctx echo.Context) error
+	// Забрать еще не учтенные в рейтинге штрафы пользователя
+	// (POST /api/v1/reservations/penalties/claim)
+	ClaimPenalties(ctx echo.Context) error
+	// Подтвердить, что забранные штрафы учтены в рейтинге
+	// (POST /api/v1/reservations/penalties/claim/{claimUid}/ack)
+	AckPenalties(ctx echo.Context, claimUid openapi_types.UUID) error
 	// Получить информацию конкретно взятому бронированию
 	// (GET /api/v1/reservations/{reservationUid})
 	Get(ctx echo.Context, reservationUid openapi_types.UUID) error
 	// Отменить бронирование
 	// (POST /api/v1/reservations/{reservationUid}/cancel)
 	Cancel(ctx echo.Context, reservationUid openapi_types.UUID) error
+	// Получить историю изменений бронирования
+	// (GET /api/v1/reservations/{reservationUid}/history)
+	History(ctx echo.Context, reservationUid openapi_types.UUID) error
+	// Подтвердить выдачу заранее забронированной книги
+	// (POST /api/v1/reservations/{reservationUid}/pickup)
+	Pickup(ctx echo.Context, reservationUid openapi_types.UUID) error
+	// Продлить бронирование
+	// (POST /api/v1/reservations/{reservationUid}/renew)
+	Renew(ctx echo.Context, reservationUid openapi_types.UUID) error
 	// Вернуть книгу
 	// (POST /api/v1/reservations/{reservationUid}/return)
 	Finish(ctx echo.Context, reservationUid openapi_types.UUID) error
+	// Списать книгу по бронированию как утерянную или испорченную
+	// (POST /api/v1/reservations/{reservationUid}/write-off)
+	WriteOff(ctx echo.Context, reservationUid openapi_types.UUID) error
 	// Проверка живости сервиса
 	// (GET /manage/health)
 	Health(ctx echo.Context) error
@@ -156,12 +493,159 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// ListFines converts echo context to params.
+func (w *ServerInterfaceWrapper) ListFines(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListFinesParams
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", ctx.QueryParams(), &params.Username)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter username: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListFines(ctx, params)
+	return err
+}
+
+// ListFineTariffs converts echo context to params.
+func (w *ServerInterfaceWrapper) ListFineTariffs(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListFineTariffs(ctx)
+	return err
+}
+
+// SetFineTariff converts echo context to params.
+func (w *ServerInterfaceWrapper) SetFineTariff(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SetFineTariff(ctx)
+	return err
+}
+
+// PayFine converts echo context to params.
+func (w *ServerInterfaceWrapper) PayFine(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "fineUid" -------------
+	var fineUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fineUid", ctx.Param("fineUid"), &fineUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fineUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PayFine(ctx, fineUid)
+	return err
+}
+
+// WaiveFine converts echo context to params.
+func (w *ServerInterfaceWrapper) WaiveFine(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "fineUid" -------------
+	var fineUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "fineUid", ctx.Param("fineUid"), &fineUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fineUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WaiveFine(ctx, fineUid)
+	return err
+}
+
 // List converts echo context to params.
 func (w *ServerInterfaceWrapper) List(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListParams
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", ctx.QueryParams(), &params.Page)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter page: %s", err))
+	}
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", ctx.QueryParams(), &params.Active)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter active: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "allUsers" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "allUsers", ctx.QueryParams(), &params.AllUsers)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter allUsers: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.List(ctx)
+	err = w.Handler.List(ctx, params)
 	return err
 }
 
@@ -174,6 +658,31 @@ func (w *ServerInterfaceWrapper) Create(ctx echo.Context) error {
 	return err
 }
 
+// ClaimPenalties converts echo context to params.
+func (w *ServerInterfaceWrapper) ClaimPenalties(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ClaimPenalties(ctx)
+	return err
+}
+
+// AckPenalties converts echo context to params.
+func (w *ServerInterfaceWrapper) AckPenalties(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "claimUid" -------------
+	var claimUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "claimUid", ctx.Param("claimUid"), &claimUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter claimUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.AckPenalties(ctx, claimUid)
+	return err
+}
+
 // Get converts echo context to params.
 func (w *ServerInterfaceWrapper) Get(ctx echo.Context) error {
 	var err error
@@ -206,8 +715,8 @@ func (w *ServerInterfaceWrapper) Cancel(ctx echo.Context) error {
 	return err
 }
 
-// Finish converts echo context to params.
-func (w *ServerInterfaceWrapper) Finish(ctx echo.Context) error {
+// History converts echo context to params.
+func (w *ServerInterfaceWrapper) History(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "reservationUid" -------------
 	var reservationUid openapi_types.UUID
@@ -218,64 +727,315 @@ func (w *ServerInterfaceWrapper) Finish(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.Finish(ctx, reservationUid)
+	err = w.Handler.History(ctx, reservationUid)
 	return err
 }
 
-// Health converts echo context to params.
-func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
+// Pickup converts echo context to params.
+func (w *ServerInterfaceWrapper) Pickup(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "reservationUid" -------------
+	var reservationUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationUid", ctx.Param("reservationUid"), &reservationUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.Health(ctx)
+	err = w.Handler.Pickup(ctx, reservationUid)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
-
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
-}
-
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// Renew converts echo context to params.
+func (w *ServerInterfaceWrapper) Renew(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reservationUid" -------------
+	var reservationUid openapi_types.UUID
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationUid", ctx.Param("reservationUid"), &reservationUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
 	}
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Renew(ctx, reservationUid)
+	return err
+}
+
+// Finish converts echo context to params.
+func (w *ServerInterfaceWrapper) Finish(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reservationUid" -------------
+	var reservationUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationUid", ctx.Param("reservationUid"), &reservationUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Finish(ctx, reservationUid)
+	return err
+}
+
+// WriteOff converts echo context to params.
+func (w *ServerInterfaceWrapper) WriteOff(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "reservationUid" -------------
+	var reservationUid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reservationUid", ctx.Param("reservationUid"), &reservationUid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reservationUid: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.WriteOff(ctx, reservationUid)
+	return err
+}
+
+// Health converts echo context to params.
+func (w *ServerInterfaceWrapper) Health(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.Health(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/api/v1/fines", wrapper.ListFines)
+	router.GET(baseURL+"/api/v1/fines/tariffs", wrapper.ListFineTariffs)
+	router.PUT(baseURL+"/api/v1/fines/tariffs", wrapper.SetFineTariff)
+	router.POST(baseURL+"/api/v1/fines/:fineUid/pay", wrapper.PayFine)
+	router.POST(baseURL+"/api/v1/fines/:fineUid/waive", wrapper.WaiveFine)
 	router.GET(baseURL+"/api/v1/reservations", wrapper.List)
 	router.POST(baseURL+"/api/v1/reservations", wrapper.Create)
+	router.POST(baseURL+"/api/v1/reservations/penalties/claim", wrapper.ClaimPenalties)
+	router.POST(baseURL+"/api/v1/reservations/penalties/claim/:claimUid/ack", wrapper.AckPenalties)
 	router.GET(baseURL+"/api/v1/reservations/:reservationUid", wrapper.Get)
 	router.POST(baseURL+"/api/v1/reservations/:reservationUid/cancel", wrapper.Cancel)
+	router.GET(baseURL+"/api/v1/reservations/:reservationUid/history", wrapper.History)
+	router.POST(baseURL+"/api/v1/reservations/:reservationUid/pickup", wrapper.Pickup)
+	router.POST(baseURL+"/api/v1/reservations/:reservationUid/renew", wrapper.Renew)
 	router.POST(baseURL+"/api/v1/reservations/:reservationUid/return", wrapper.Finish)
+	router.POST(baseURL+"/api/v1/reservations/:reservationUid/write-off", wrapper.WriteOff)
 	router.GET(baseURL+"/manage/health", wrapper.Health)
 
 }
 
+type ListFinesRequestObject struct {
+	Params ListFinesParams
+}
+
+type ListFinesResponseObject interface {
+	VisitListFinesResponse(w http.ResponseWriter) error
+}
+
+type ListFines200JSONResponse []FineResponse
+
+func (response ListFines200JSONResponse) VisitListFinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFines403JSONResponse ErrorResponse
+
+func (response ListFines403JSONResponse) VisitListFinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFineTariffsRequestObject struct {
+}
+
+type ListFineTariffsResponseObject interface {
+	VisitListFineTariffsResponse(w http.ResponseWriter) error
+}
+
+type ListFineTariffs200JSONResponse []FineTariffResponse
+
+func (response ListFineTariffs200JSONResponse) VisitListFineTariffsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetFineTariffRequestObject struct {
+	Body *SetFineTariffJSONRequestBody
+}
+
+type SetFineTariffResponseObject interface {
+	VisitSetFineTariffResponse(w http.ResponseWriter) error
+}
+
+type SetFineTariff200JSONResponse FineTariffResponse
+
+func (response SetFineTariff200JSONResponse) VisitSetFineTariffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetFineTariff400JSONResponse ValidationErrorResponse
+
+func (response SetFineTariff400JSONResponse) VisitSetFineTariffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetFineTariff403JSONResponse ErrorResponse
+
+func (response SetFineTariff403JSONResponse) VisitSetFineTariffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayFineRequestObject struct {
+	FineUid openapi_types.UUID `json:"fineUid"`
+}
+
+type PayFineResponseObject interface {
+	VisitPayFineResponse(w http.ResponseWriter) error
+}
+
+type PayFine200JSONResponse FineResponse
+
+func (response PayFine200JSONResponse) VisitPayFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayFine403JSONResponse ErrorResponse
+
+func (response PayFine403JSONResponse) VisitPayFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayFine404JSONResponse ErrorResponse
+
+func (response PayFine404JSONResponse) VisitPayFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PayFine409JSONResponse ErrorResponse
+
+func (response PayFine409JSONResponse) VisitPayFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaiveFineRequestObject struct {
+	FineUid openapi_types.UUID `json:"fineUid"`
+	Body    *WaiveFineJSONRequestBody
+}
+
+type WaiveFineResponseObject interface {
+	VisitWaiveFineResponse(w http.ResponseWriter) error
+}
+
+type WaiveFine200JSONResponse FineResponse
+
+func (response WaiveFine200JSONResponse) VisitWaiveFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaiveFine400JSONResponse ValidationErrorResponse
+
+func (response WaiveFine400JSONResponse) VisitWaiveFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaiveFine403JSONResponse ErrorResponse
+
+func (response WaiveFine403JSONResponse) VisitWaiveFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaiveFine404JSONResponse ErrorResponse
+
+func (response WaiveFine404JSONResponse) VisitWaiveFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WaiveFine409JSONResponse ErrorResponse
+
+func (response WaiveFine409JSONResponse) VisitWaiveFineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type ListRequestObject struct {
+	Params ListParams
 }
 
 type ListResponseObject interface {
 	VisitListResponse(w http.ResponseWriter) error
 }
 
-type List200JSONResponse []BookReservationResponse
+type List200JSONResponse ReservationPaginationResponse
 
 func (response List200JSONResponse) VisitListResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
@@ -284,6 +1044,24 @@ func (response List200JSONResponse) VisitListResponse(w http.ResponseWriter) err
 	return json.NewEncoder(w).Encode(response)
 }
 
+type List400JSONResponse ValidationErrorResponse
+
+func (response List400JSONResponse) VisitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type List403JSONResponse ErrorResponse
+
+func (response List403JSONResponse) VisitListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type CreateRequestObject struct {
 	Body *CreateJSONRequestBody
 }
@@ -301,80 +1079,326 @@ func (response Create200JSONResponse) VisitCreateResponse(w http.ResponseWriter)
 	return json.NewEncoder(w).Encode(response)
 }
 
-type Create400JSONResponse ValidationErrorResponse
+type Create400JSONResponse ValidationErrorResponse
+
+func (response Create400JSONResponse) VisitCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Create409JSONResponse ErrorResponse
+
+func (response Create409JSONResponse) VisitCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ClaimPenaltiesRequestObject struct {
+}
+
+type ClaimPenaltiesResponseObject interface {
+	VisitClaimPenaltiesResponse(w http.ResponseWriter) error
+}
+
+type ClaimPenalties200JSONResponse PenaltiesResponse
+
+func (response ClaimPenalties200JSONResponse) VisitClaimPenaltiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AckPenaltiesRequestObject struct {
+	ClaimUid openapi_types.UUID `json:"claimUid"`
+}
+
+type AckPenaltiesResponseObject interface {
+	VisitAckPenaltiesResponse(w http.ResponseWriter) error
+}
+
+type AckPenalties204Response struct {
+}
+
+func (response AckPenalties204Response) VisitAckPenaltiesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type GetRequestObject struct {
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+}
+
+type GetResponseObject interface {
+	VisitGetResponse(w http.ResponseWriter) error
+}
+
+type Get200JSONResponse BookReservationResponse
+
+func (response Get200JSONResponse) VisitGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Get404JSONResponse ErrorResponse
+
+func (response Get404JSONResponse) VisitGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRequestObject struct {
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+}
+
+type CancelResponseObject interface {
+	VisitCancelResponse(w http.ResponseWriter) error
+}
+
+type Cancel204Response struct {
+}
+
+func (response Cancel204Response) VisitCancelResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type Cancel404JSONResponse ErrorResponse
+
+func (response Cancel404JSONResponse) VisitCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Cancel409JSONResponse ErrorResponse
+
+func (response Cancel409JSONResponse) VisitCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HistoryRequestObject struct {
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+}
+
+type HistoryResponseObject interface {
+	VisitHistoryResponse(w http.ResponseWriter) error
+}
+
+type History200JSONResponse []ReservationEventResponse
+
+func (response History200JSONResponse) VisitHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type History404JSONResponse ErrorResponse
+
+func (response History404JSONResponse) VisitHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PickupRequestObject struct {
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+}
+
+type PickupResponseObject interface {
+	VisitPickupResponse(w http.ResponseWriter) error
+}
+
+type Pickup200JSONResponse BookReservationResponse
+
+func (response Pickup200JSONResponse) VisitPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Pickup403JSONResponse ErrorResponse
+
+func (response Pickup403JSONResponse) VisitPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Pickup404JSONResponse ErrorResponse
+
+func (response Pickup404JSONResponse) VisitPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Pickup409JSONResponse ErrorResponse
+
+func (response Pickup409JSONResponse) VisitPickupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RenewRequestObject struct {
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+	Body           *RenewJSONRequestBody
+}
+
+type RenewResponseObject interface {
+	VisitRenewResponse(w http.ResponseWriter) error
+}
+
+type Renew200JSONResponse BookReservationResponse
+
+func (response Renew200JSONResponse) VisitRenewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Renew400JSONResponse ValidationErrorResponse
+
+func (response Renew400JSONResponse) VisitRenewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Renew404JSONResponse ErrorResponse
+
+func (response Renew404JSONResponse) VisitRenewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Renew409JSONResponse ErrorResponse
+
+func (response Renew409JSONResponse) VisitRenewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type FinishRequestObject struct {
+	ReservationUid openapi_types.UUID `json:"reservationUid"`
+	Body           *FinishJSONRequestBody
+}
+
+type FinishResponseObject interface {
+	VisitFinishResponse(w http.ResponseWriter) error
+}
+
+type Finish200JSONResponse FinishReservationResponse
+
+func (response Finish200JSONResponse) VisitFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Finish400JSONResponse ValidationErrorResponse
 
-func (response Create400JSONResponse) VisitCreateResponse(w http.ResponseWriter) error {
+func (response Finish400JSONResponse) VisitFinishResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRequestObject struct {
-	ReservationUid openapi_types.UUID `json:"reservationUid"`
-}
+type Finish403JSONResponse ErrorResponse
 
-type GetResponseObject interface {
-	VisitGetResponse(w http.ResponseWriter) error
+func (response Finish403JSONResponse) VisitFinishResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type Get200JSONResponse BookReservationResponse
+type Finish404JSONResponse ErrorResponse
 
-func (response Get200JSONResponse) VisitGetResponse(w http.ResponseWriter) error {
+func (response Finish404JSONResponse) VisitFinishResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type Get404JSONResponse ErrorResponse
+type Finish409JSONResponse ErrorResponse
 
-func (response Get404JSONResponse) VisitGetResponse(w http.ResponseWriter) error {
+func (response Finish409JSONResponse) VisitFinishResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelRequestObject struct {
+type WriteOffRequestObject struct {
 	ReservationUid openapi_types.UUID `json:"reservationUid"`
+	Body           *WriteOffJSONRequestBody
 }
 
-type CancelResponseObject interface {
-	VisitCancelResponse(w http.ResponseWriter) error
+type WriteOffResponseObject interface {
+	VisitWriteOffResponse(w http.ResponseWriter) error
 }
 
-type Cancel204Response struct {
-}
+type WriteOff200JSONResponse WriteOffReservationResponse
 
-func (response Cancel204Response) VisitCancelResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+func (response WriteOff200JSONResponse) VisitWriteOffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-type FinishRequestObject struct {
-	ReservationUid openapi_types.UUID `json:"reservationUid"`
-	Body           *FinishJSONRequestBody
+	return json.NewEncoder(w).Encode(response)
 }
 
-type FinishResponseObject interface {
-	VisitFinishResponse(w http.ResponseWriter) error
+type WriteOff403JSONResponse ErrorResponse
+
+func (response WriteOff403JSONResponse) VisitWriteOffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type Finish200JSONResponse FinishReservationResponse
+type WriteOff404JSONResponse ErrorResponse
 
-func (response Finish200JSONResponse) VisitFinishResponse(w http.ResponseWriter) error {
+func (response WriteOff404JSONResponse) VisitWriteOffResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type Finish404JSONResponse ErrorResponse
+type WriteOff409JSONResponse ErrorResponse
 
-func (response Finish404JSONResponse) VisitFinishResponse(w http.ResponseWriter) error {
+func (response WriteOff409JSONResponse) VisitWriteOffResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
@@ -396,21 +1420,54 @@ func (response Health200Response) VisitHealthResponse(w http.ResponseWriter) err
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// Получить штрафы пользователя
+	// (GET /api/v1/fines)
+	ListFines(ctx context.Context, request ListFinesRequestObject) (ListFinesResponseObject, error)
+	// Получить тарифы штрафов за просрочку
+	// (GET /api/v1/fines/tariffs)
+	ListFineTariffs(ctx context.Context, request ListFineTariffsRequestObject) (ListFineTariffsResponseObject, error)
+	// Задать тариф штрафа для библиотеки и жанра
+	// (PUT /api/v1/fines/tariffs)
+	SetFineTariff(ctx context.Context, request SetFineTariffRequestObject) (SetFineTariffResponseObject, error)
+	// Отметить штраф оплаченным
+	// (POST /api/v1/fines/{fineUid}/pay)
+	PayFine(ctx context.Context, request PayFineRequestObject) (PayFineResponseObject, error)
+	// Списать штраф
+	// (POST /api/v1/fines/{fineUid}/waive)
+	WaiveFine(ctx context.Context, request WaiveFineRequestObject) (WaiveFineResponseObject, error)
 	// Получить информацию по всем взятым в прокат книгам пользователя
 	// (GET /api/v1/reservations)
 	List(ctx context.Context, request ListRequestObject) (ListResponseObject, error)
 	// Взять книгу в библиотеке
 	// (POST /api/v1/reservations)
 	Create(ctx context.Context, request CreateRequestObject) (CreateResponseObject, error)
+	// Забрать еще не учтенные в рейтинге штрафы пользователя
+	// (POST /api/v1/reservations/penalties/claim)
+	ClaimPenalties(ctx context.Context, request ClaimPenaltiesRequestObject) (ClaimPenaltiesResponseObject, error)
+	// Подтвердить, что забранные штрафы учтены в рейтинге
+	// (POST /api/v1/reservations/penalties/claim/{claimUid}/ack)
+	AckPenalties(ctx context.Context, request AckPenaltiesRequestObject) (AckPenaltiesResponseObject, error)
 	// Получить информацию конкретно взятому бронированию
 	// (GET /api/v1/reservations/{reservationUid})
 	Get(ctx context.Context, request GetRequestObject) (GetResponseObject, error)
 	// Отменить бронирование
 	// (POST /api/v1/reservations/{reservationUid}/cancel)
 	Cancel(ctx context.Context, request CancelRequestObject) (CancelResponseObject, error)
+	// Получить историю изменений бронирования
+	// (GET /api/v1/reservations/{reservationUid}/history)
+	History(ctx context.Context, request HistoryRequestObject) (HistoryResponseObject, error)
+	// Подтвердить выдачу заранее забронированной книги
+	// (POST /api/v1/reservations/{reservationUid}/pickup)
+	Pickup(ctx context.Context, request PickupRequestObject) (PickupResponseObject, error)
+	// Продлить бронирование
+	// (POST /api/v1/reservations/{reservationUid}/renew)
+	Renew(ctx context.Context, request RenewRequestObject) (RenewResponseObject, error)
 	// Вернуть книгу
 	// (POST /api/v1/reservations/{reservationUid}/return)
 	Finish(ctx context.Context, request FinishRequestObject) (FinishResponseObject, error)
+	// Списать книгу по бронированию как утерянную или испорченную
+	// (POST /api/v1/reservations/{reservationUid}/write-off)
+	WriteOff(ctx context.Context, request WriteOffRequestObject) (WriteOffResponseObject, error)
 	// Проверка живости сервиса
 	// (GET /manage/health)
 	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
@@ -428,10 +1485,145 @@ type strictHandler struct {
 	middlewares []StrictMiddlewareFunc
 }
 
+// ListFines operation middleware
+func (sh *strictHandler) ListFines(ctx echo.Context, params ListFinesParams) error {
+	var request ListFinesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFines(ctx.Request().Context(), request.(ListFinesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFines")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListFinesResponseObject); ok {
+		return validResponse.VisitListFinesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// ListFineTariffs operation middleware
+func (sh *strictHandler) ListFineTariffs(ctx echo.Context) error {
+	var request ListFineTariffsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListFineTariffs(ctx.Request().Context(), request.(ListFineTariffsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListFineTariffs")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ListFineTariffsResponseObject); ok {
+		return validResponse.VisitListFineTariffsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// SetFineTariff operation middleware
+func (sh *strictHandler) SetFineTariff(ctx echo.Context) error {
+	var request SetFineTariffRequestObject
+
+	var body SetFineTariffJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetFineTariff(ctx.Request().Context(), request.(SetFineTariffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetFineTariff")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(SetFineTariffResponseObject); ok {
+		return validResponse.VisitSetFineTariffResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PayFine operation middleware
+func (sh *strictHandler) PayFine(ctx echo.Context, fineUid openapi_types.UUID) error {
+	var request PayFineRequestObject
+
+	request.FineUid = fineUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PayFine(ctx.Request().Context(), request.(PayFineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PayFine")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PayFineResponseObject); ok {
+		return validResponse.VisitPayFineResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// WaiveFine operation middleware
+func (sh *strictHandler) WaiveFine(ctx echo.Context, fineUid openapi_types.UUID) error {
+	var request WaiveFineRequestObject
+
+	request.FineUid = fineUid
+
+	var body WaiveFineJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WaiveFine(ctx.Request().Context(), request.(WaiveFineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WaiveFine")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WaiveFineResponseObject); ok {
+		return validResponse.VisitWaiveFineResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // List operation middleware
-func (sh *strictHandler) List(ctx echo.Context) error {
+func (sh *strictHandler) List(ctx echo.Context, params ListParams) error {
 	var request ListRequestObject
 
+	request.Params = params
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.List(ctx.Request().Context(), request.(ListRequestObject))
 	}
@@ -480,6 +1672,54 @@ func (sh *strictHandler) Create(ctx echo.Context) error {
 	return nil
 }
 
+// ClaimPenalties operation middleware
+func (sh *strictHandler) ClaimPenalties(ctx echo.Context) error {
+	var request ClaimPenaltiesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ClaimPenalties(ctx.Request().Context(), request.(ClaimPenaltiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ClaimPenalties")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(ClaimPenaltiesResponseObject); ok {
+		return validResponse.VisitClaimPenaltiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// AckPenalties operation middleware
+func (sh *strictHandler) AckPenalties(ctx echo.Context, claimUid openapi_types.UUID) error {
+	var request AckPenaltiesRequestObject
+
+	request.ClaimUid = claimUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AckPenalties(ctx.Request().Context(), request.(AckPenaltiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AckPenalties")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(AckPenaltiesResponseObject); ok {
+		return validResponse.VisitAckPenaltiesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Get operation middleware
 func (sh *strictHandler) Get(ctx echo.Context, reservationUid openapi_types.UUID) error {
 	var request GetRequestObject
@@ -530,6 +1770,87 @@ func (sh *strictHandler) Cancel(ctx echo.Context, reservationUid openapi_types.U
 	return nil
 }
 
+// History operation middleware
+func (sh *strictHandler) History(ctx echo.Context, reservationUid openapi_types.UUID) error {
+	var request HistoryRequestObject
+
+	request.ReservationUid = reservationUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.History(ctx.Request().Context(), request.(HistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "History")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(HistoryResponseObject); ok {
+		return validResponse.VisitHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// Pickup operation middleware
+func (sh *strictHandler) Pickup(ctx echo.Context, reservationUid openapi_types.UUID) error {
+	var request PickupRequestObject
+
+	request.ReservationUid = reservationUid
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.Pickup(ctx.Request().Context(), request.(PickupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Pickup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PickupResponseObject); ok {
+		return validResponse.VisitPickupResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// Renew operation middleware
+func (sh *strictHandler) Renew(ctx echo.Context, reservationUid openapi_types.UUID) error {
+	var request RenewRequestObject
+
+	request.ReservationUid = reservationUid
+
+	var body RenewJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.Renew(ctx.Request().Context(), request.(RenewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Renew")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(RenewResponseObject); ok {
+		return validResponse.VisitRenewResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Finish operation middleware
 func (sh *strictHandler) Finish(ctx echo.Context, reservationUid openapi_types.UUID) error {
 	var request FinishRequestObject
@@ -561,6 +1882,37 @@ func (sh *strictHandler) Finish(ctx echo.Context, reservationUid openapi_types.U
 	return nil
 }
 
+// WriteOff operation middleware
+func (sh *strictHandler) WriteOff(ctx echo.Context, reservationUid openapi_types.UUID) error {
+	var request WriteOffRequestObject
+
+	request.ReservationUid = reservationUid
+
+	var body WriteOffJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.WriteOff(ctx.Request().Context(), request.(WriteOffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "WriteOff")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(WriteOffResponseObject); ok {
+		return validResponse.VisitWriteOffResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // Health operation middleware
 func (sh *strictHandler) Health(ctx echo.Context) error {
 	var request HealthRequestObject
@@ -0,0 +1,339 @@
+package openapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/muhomorfus/ds-lab-02/services/auth/contextutils"
+	"github.com/muhomorfus/ds-lab-02/services/listing"
+	"github.com/muhomorfus/ds-lab-02/services/reservation/internal/generated"
+	"github.com/samber/lo"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// FinePolicy is the default tariff for late returns, which is used when no
+// tariff is set for the library and genre of the book. Amounts are in
+// kopecks, zero MaxAmount means the fine is not capped. New checkouts are
+// blocked while unpaid fines of the user exceed BlockThreshold, zero
+// BlockThreshold disables blocking.
+type FinePolicy struct {
+	DailyRate      int
+	MaxAmount      int
+	BlockThreshold int
+}
+
+const fineQuery = `select f.*, r.reservation_uid from fines f join reservation r on r.id = f.reservation_id`
+
+// chargeFine records the fine for the reservation returned on date. The
+// tariff is chosen by library and genre, the most specific one wins.
+func (s *Server) chargeFine(ctx context.Context, tx *sqlx.Tx, r reservation, date time.Time, genre *string) (*fine, error) {
+	late := daysLate(r.TillDate, date)
+	if late <= 0 {
+		return nil, nil
+	}
+
+	query := `select * from fine_tariffs
+		where (library_uid = $1 or library_uid is null) and (genre = $2 or genre is null)
+		order by library_uid is null, genre is null
+		limit 1`
+
+	tariff := fineTariff{DailyRate: s.fines.DailyRate}
+	if s.fines.MaxAmount > 0 {
+		tariff.MaxAmount = &s.fines.MaxAmount
+	}
+
+	if err := tx.GetContext(ctx, &tariff, query, r.LibraryUid, genre); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("select fine tariff: %w", err)
+	}
+
+	amount := fineAmount(late, tariff.DailyRate, tariff.MaxAmount)
+	if amount <= 0 {
+		return nil, nil
+	}
+
+	query = `insert into fines (fine_uid, reservation_id, username, amount, days_late, status)
+		values ($1, $2, $3, $4, $5, $6)`
+	fineUID := uuid.New()
+	if _, err := tx.ExecContext(ctx, query, fineUID, r.ID, r.Username, amount, late, fineUnpaid); err != nil {
+		return nil, fmt.Errorf("insert fine: %w", err)
+	}
+
+	var charged fine
+	if err := tx.GetContext(ctx, &charged, fineQuery+` where f.fine_uid = $1`, fineUID); err != nil {
+		return nil, fmt.Errorf("select fine: %w", err)
+	}
+
+	return &charged, nil
+}
+
+// daysLate counts whole days between the due date and the return date.
+func daysLate(till, returned time.Time) int {
+	return int(returned.Sub(till.Truncate(24*time.Hour)).Hours() / 24)
+}
+
+// fineAmount is the fine for the book returned days late, it is capped by
+// maxAmount if one is set.
+func fineAmount(days, dailyRate int, maxAmount *int) int {
+	amount := days * dailyRate
+	if maxAmount != nil {
+		amount = min(amount, *maxAmount)
+	}
+
+	return amount
+}
+
+// finesBlockCheckout reports whether unpaid fines of the user are too big to
+// take new books.
+func (s *Server) finesBlockCheckout(ctx context.Context, username string) (bool, error) {
+	if s.fines.BlockThreshold <= 0 {
+		return false, nil
+	}
+
+	query := `select coalesce(sum(amount), 0) from fines where username = $1 and status = 'UNPAID'`
+
+	var unpaid int
+	if err := s.db.GetContext(ctx, &unpaid, query, username); err != nil {
+		return false, err
+	}
+
+	return unpaid > s.fines.BlockThreshold, nil
+}
+
+func (s *Server) ListFines(ctx context.Context, request generated.ListFinesRequestObject) (generated.ListFinesResponseObject, error) {
+	logger := slog.With("handler", "ListFines")
+
+	username := contextutils.GetUser(ctx)
+	if request.Params.Username != nil && *request.Params.Username != username {
+		if !contextutils.IsStaff(ctx) {
+			return generated.ListFines403JSONResponse{
+				Message: "only library staff can see fines of other users",
+			}, nil
+		}
+
+		username = *request.Params.Username
+	}
+
+	var q listing.Query
+	conditions := []string{`f.username = ` + q.Bind(username)}
+	if request.Params.Status != nil {
+		conditions = append(conditions, `f.status = `+q.Bind(string(*request.Params.Status)))
+	}
+
+	query := fineQuery + ` where ` + strings.Join(conditions, " and ") + ` order by f.id desc`
+
+	var fines []fine
+	if err := s.db.SelectContext(ctx, &fines, query, q.Args...); err != nil {
+		logger.Error("select fines from db", "error", err)
+		return nil, fmt.Errorf("select fines from db: %w", err)
+	}
+
+	return generated.ListFines200JSONResponse(lo.Map(fines, func(f fine, _ int) generated.FineResponse {
+		return toFineResponse(f)
+	})), nil
+}
+
+func (s *Server) PayFine(ctx context.Context, request generated.PayFineRequestObject) (generated.PayFineResponseObject, error) {
+	logger := slog.With("handler", "PayFine")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.PayFine403JSONResponse{
+			Message: "only library staff can accept fine payments",
+		}, nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	fines, err := lockFine(ctx, tx, request.FineUid)
+	if err != nil {
+		logger.Error("select fine from db", "error", err)
+		return nil, fmt.Errorf("select fine from db: %w", err)
+	}
+
+	if len(fines) == 0 {
+		return generated.PayFine404JSONResponse{
+			Message: "fine not found",
+		}, nil
+	}
+
+	if fines[0].Status != fineUnpaid {
+		return generated.PayFine409JSONResponse{
+			Message: fmt.Sprintf("fine is already %s", strings.ToLower(fines[0].Status)),
+		}, nil
+	}
+
+	closed, err := closeFine(ctx, tx, fines[0], finePaid, contextutils.GetUser(ctx), nil)
+	if err != nil {
+		logger.Error("close fine", "error", err)
+		return nil, fmt.Errorf("close fine: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.PayFine200JSONResponse(toFineResponse(closed)), nil
+}
+
+func (s *Server) WaiveFine(ctx context.Context, request generated.WaiveFineRequestObject) (generated.WaiveFineResponseObject, error) {
+	logger := slog.With("handler", "WaiveFine")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.WaiveFine403JSONResponse{
+			Message: "only library staff can waive fines",
+		}, nil
+	}
+
+	comment := strings.TrimSpace(request.Body.Comment)
+	if comment == "" {
+		return generated.WaiveFine400JSONResponse(validationError("comment", "comment is required")), nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	fines, err := lockFine(ctx, tx, request.FineUid)
+	if err != nil {
+		logger.Error("select fine from db", "error", err)
+		return nil, fmt.Errorf("select fine from db: %w", err)
+	}
+
+	if len(fines) == 0 {
+		return generated.WaiveFine404JSONResponse{
+			Message: "fine not found",
+		}, nil
+	}
+
+	if fines[0].Status != fineUnpaid {
+		return generated.WaiveFine409JSONResponse{
+			Message: fmt.Sprintf("fine is already %s", strings.ToLower(fines[0].Status)),
+		}, nil
+	}
+
+	closed, err := closeFine(ctx, tx, fines[0], fineWaived, contextutils.GetUser(ctx), &comment)
+	if err != nil {
+		logger.Error("close fine", "error", err)
+		return nil, fmt.Errorf("close fine: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.WaiveFine200JSONResponse(toFineResponse(closed)), nil
+}
+
+func (s *Server) ListFineTariffs(ctx context.Context, request generated.ListFineTariffsRequestObject) (generated.ListFineTariffsResponseObject, error) {
+	logger := slog.With("handler", "ListFineTariffs")
+
+	query := `select * from fine_tariffs order by library_uid nulls first, genre nulls first`
+
+	var tariffs []fineTariff
+	if err := s.db.SelectContext(ctx, &tariffs, query); err != nil {
+		logger.Error("select fine tariffs from db", "error", err)
+		return nil, fmt.Errorf("select fine tariffs from db: %w", err)
+	}
+
+	return generated.ListFineTariffs200JSONResponse(lo.Map(tariffs, func(t fineTariff, _ int) generated.FineTariffResponse {
+		return toFineTariffResponse(t)
+	})), nil
+}
+
+func (s *Server) SetFineTariff(ctx context.Context, request generated.SetFineTariffRequestObject) (generated.SetFineTariffResponseObject, error) {
+	logger := slog.With("handler", "SetFineTariff")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.SetFineTariff403JSONResponse{
+			Message: "only library staff can change fine tariffs",
+		}, nil
+	}
+
+	switch {
+	case request.Body.DailyRate < 0:
+		return generated.SetFineTariff400JSONResponse(validationError("dailyRate", "daily rate can not be negative")), nil
+	case request.Body.MaxAmount != nil && *request.Body.MaxAmount < 0:
+		return generated.SetFineTariff400JSONResponse(validationError("maxAmount", "max amount can not be negative")), nil
+	case request.Body.Genre != nil && strings.TrimSpace(*request.Body.Genre) == "":
+		return generated.SetFineTariff400JSONResponse(validationError("genre", "genre can not be empty")), nil
+	}
+
+	query := `insert into fine_tariffs (library_uid, genre, daily_rate, max_amount, updated_by)
+		values ($1, $2, $3, $4, $5)
+		on conflict (coalesce(library_uid, '00000000-0000-0000-0000-000000000000'), coalesce(genre, ''))
+		do update set daily_rate = excluded.daily_rate, max_amount = excluded.max_amount,
+			updated_by = excluded.updated_by, updated_at = now()
+		returning *`
+
+	var tariff fineTariff
+	if err := s.db.GetContext(ctx, &tariff, query, request.Body.LibraryUid, request.Body.Genre, request.Body.DailyRate, request.Body.MaxAmount, contextutils.GetUser(ctx)); err != nil {
+		logger.Error("save fine tariff", "error", err)
+		return nil, fmt.Errorf("save fine tariff: %w", err)
+	}
+
+	return generated.SetFineTariff200JSONResponse(toFineTariffResponse(tariff)), nil
+}
+
+// lockFine selects the fine for update.
+func lockFine(ctx context.Context, tx *sqlx.Tx, fineUID uuid.UUID) ([]fine, error) {
+	var fines []fine
+	if err := tx.SelectContext(ctx, &fines, fineQuery+` where f.fine_uid = $1 for update of f`, fineUID); err != nil {
+		return nil, err
+	}
+
+	return fines, nil
+}
+
+func closeFine(ctx context.Context, tx *sqlx.Tx, f fine, status, actor string, comment *string) (fine, error) {
+	query := `update fines set status = $2, closed_by = $3, closed_at = now(), comment = $4 where id = $1`
+	if _, err := tx.ExecContext(ctx, query, f.ID, status, actor, comment); err != nil {
+		return fine{}, err
+	}
+
+	var closed fine
+	if err := tx.GetContext(ctx, &closed, fineQuery+` where f.id = $1`, f.ID); err != nil {
+		return fine{}, err
+	}
+
+	return closed, nil
+}
+
+func toFineResponse(f fine) generated.FineResponse {
+	return generated.FineResponse{
+		Amount:         f.Amount,
+		ClosedAt:       f.ClosedAt,
+		ClosedBy:       f.ClosedBy,
+		Comment:        f.Comment,
+		CreatedAt:      f.CreatedAt,
+		DaysLate:       f.DaysLate,
+		FineUid:        f.FineUID,
+		ReservationUid: f.ReservationUID,
+		Status:         generated.FineResponseStatus(f.Status),
+		Username:       f.Username,
+	}
+}
+
+func toFineTariffResponse(t fineTariff) generated.FineTariffResponse {
+	return generated.FineTariffResponse{
+		DailyRate:  t.DailyRate,
+		Genre:      t.Genre,
+		LibraryUid: t.LibraryUID,
+		MaxAmount:  t.MaxAmount,
+		UpdatedAt:  t.UpdatedAt,
+		UpdatedBy:  t.UpdatedBy,
+	}
+}
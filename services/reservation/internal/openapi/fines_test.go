@@ -0,0 +1,57 @@
+package openapi
+
+import (
+	"github.com/samber/lo"
+	"testing"
+	"time"
+)
+
+func TestDaysLate(t *testing.T) {
+	till := time.Date(2026, 10, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		till     time.Time
+		returned time.Time
+		expected int
+	}{
+		{name: "before due date", till: till, returned: till.AddDate(0, 0, -3), expected: -3},
+		{name: "on due date", till: till, returned: till},
+		{name: "one day late", till: till, returned: till.AddDate(0, 0, 1), expected: 1},
+		{name: "week late", till: till, returned: till.AddDate(0, 0, 7), expected: 7},
+		{name: "due date with time", till: till.Add(15 * time.Hour), returned: till.AddDate(0, 0, 2), expected: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := daysLate(tt.till, tt.returned); got != tt.expected {
+				t.Fatalf("expected %d days, got %d", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFineAmount(t *testing.T) {
+	tests := []struct {
+		name      string
+		days      int
+		dailyRate int
+		maxAmount *int
+		expected  int
+	}{
+		{name: "not capped", days: 3, dailyRate: 1000, expected: 3000},
+		{name: "below cap", days: 3, dailyRate: 1000, maxAmount: lo.ToPtr(5000), expected: 3000},
+		{name: "equal to cap", days: 5, dailyRate: 1000, maxAmount: lo.ToPtr(5000), expected: 5000},
+		{name: "capped", days: 60, dailyRate: 1000, maxAmount: lo.ToPtr(50000), expected: 50000},
+		{name: "free tariff", days: 10, dailyRate: 0, expected: 0},
+		{name: "zero cap", days: 10, dailyRate: 1000, maxAmount: lo.ToPtr(0), expected: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fineAmount(tt.days, tt.dailyRate, tt.maxAmount); got != tt.expected {
+				t.Fatalf("expected amount %d, got %d", tt.expected, got)
+			}
+		})
+	}
+}
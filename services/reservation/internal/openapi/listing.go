@@ -0,0 +1,16 @@
+package openapi
+
+import (
+	"github.com/muhomorfus/ds-lab-02/services/listing"
+	"github.com/muhomorfus/ds-lab-02/services/reservation/internal/generated"
+	"github.com/samber/lo"
+)
+
+func newListPage[T any](sorts map[string]listing.SortField[T], page, size *int, sort, order string, cursor *string) (listing.Page, listing.SortField[T], *generated.ValidationErrorResponse) {
+	p, field, ferr := listing.New(sorts, page, size, sort, order, cursor)
+	if ferr != nil {
+		return p, field, lo.ToPtr(validationError(ferr.Field, ferr.Message))
+	}
+
+	return p, field, nil
+}
@@ -2,22 +2,71 @@ package openapi
 
 import (
 	"github.com/google/uuid"
+	"github.com/muhomorfus/ds-lab-02/services/listing"
 	"time"
 )
 
 type reservation struct {
-	ID             int       `db:"id"`
-	BookUid        uuid.UUID `db:"book_uid"`
-	LibraryUid     uuid.UUID `db:"library_uid"`
-	ReservationUid uuid.UUID `db:"reservation_uid"`
-	StartDate      time.Time `db:"start_date"`
-	Status         string    `db:"status"`
-	TillDate       time.Time `db:"till_date"`
-	Username       string    `db:"username"`
+	ID             int        `db:"id"`
+	BookUid        uuid.UUID  `db:"book_uid"`
+	LibraryUid     uuid.UUID  `db:"library_uid"`
+	ReservationUid uuid.UUID  `db:"reservation_uid"`
+	StartDate      time.Time  `db:"start_date"`
+	Status         string     `db:"status"`
+	TillDate       time.Time  `db:"till_date"`
+	Username       string     `db:"username"`
+	PickupUntil    *time.Time `db:"pickup_until"`
+}
+
+type reservationEvent struct {
+	ID            int       `db:"id"`
+	ReservationID int       `db:"reservation_id"`
+	FromStatus    *string   `db:"from_status"`
+	ToStatus      string    `db:"to_status"`
+	Actor         string    `db:"actor"`
+	Comment       *string   `db:"comment"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+const uniqueViolation = "23505"
+
+type activeLoans struct {
+	Active   int `db:"active"`
+	SameBook int `db:"same_book"`
 }
 
 const (
-	rented   = "RENTED"
-	returned = "RETURNED"
-	expired  = "EXPIRED"
+	fineUnpaid = "UNPAID"
+	finePaid   = "PAID"
+	fineWaived = "WAIVED"
 )
+
+type fine struct {
+	ID             int        `db:"id"`
+	FineUID        uuid.UUID  `db:"fine_uid"`
+	ReservationID  int        `db:"reservation_id"`
+	ReservationUID uuid.UUID  `db:"reservation_uid"`
+	Username       string     `db:"username"`
+	Amount         int        `db:"amount"`
+	DaysLate       int        `db:"days_late"`
+	Status         string     `db:"status"`
+	Comment        *string    `db:"comment"`
+	CreatedAt      time.Time  `db:"created_at"`
+	ClosedBy       *string    `db:"closed_by"`
+	ClosedAt       *time.Time `db:"closed_at"`
+}
+
+type fineTariff struct {
+	ID         int        `db:"id"`
+	LibraryUID *uuid.UUID `db:"library_uid"`
+	Genre      *string    `db:"genre"`
+	DailyRate  int        `db:"daily_rate"`
+	MaxAmount  *int       `db:"max_amount"`
+	UpdatedBy  string     `db:"updated_by"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+}
+
+var reservationSorts = map[string]listing.SortField[reservation]{
+	"startDate": {Column: "t.start_date", Value: func(item reservation) any { return item.StartDate }},
+	"tillDate":  {Column: "t.till_date", Value: func(item reservation) any { return item.TillDate }},
+}
@@ -2,22 +2,55 @@ package openapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/muhomorfus/ds-lab-02/services/auth/contextutils"
+	"github.com/muhomorfus/ds-lab-02/services/listing"
+	"github.com/muhomorfus/ds-lab-02/services/reservation/internal/clients/library"
 	"github.com/muhomorfus/ds-lab-02/services/reservation/internal/generated"
+	"github.com/muhomorfus/ds-lab-02/services/reservation/internal/state"
 	"github.com/samber/lo"
 	"log/slog"
+	"net/http"
+	"strings"
 	"time"
 )
 
+// LoanPolicy limits how long a book can be kept. MinDays and MaxDays bound
+// the loan requested on checkout, MaxTotalDays bounds the loan length counted
+// from the start date including all renewals. A loan can be cancelled only
+// within CancelGracePeriod after checkout. A book can be booked for pickup
+// at most MaxAdvanceDays ahead and waits for the reader PickupWindow since
+// the start of the pickup date.
+type LoanPolicy struct {
+	MinDays           int
+	MaxDays           int
+	MaxRenewals       int
+	MaxTotalDays      int
+	CancelGracePeriod time.Duration
+	MaxAdvanceDays    int
+	PickupWindow      time.Duration
+}
+
+// Penalties are rating stars taken from a reader, whose book was written off.
+type Penalties struct {
+	Lost    int
+	Damaged int
+}
+
 type Server struct {
-	db *sqlx.DB
+	db        *sqlx.DB
+	library   *library.ClientWithResponses
+	loans     LoanPolicy
+	fines     FinePolicy
+	penalties Penalties
 }
 
-func New(db *sqlx.DB) *Server {
-	return &Server{db: db}
+func New(db *sqlx.DB, library *library.ClientWithResponses, loans LoanPolicy, fines FinePolicy, penalties Penalties) *Server {
+	return &Server{db: db, library: library, loans: loans, fines: fines, penalties: penalties}
 }
 
 func (s *Server) Health(ctx context.Context, request generated.HealthRequestObject) (generated.HealthResponseObject, error) {
@@ -25,12 +58,48 @@ func (s *Server) Health(ctx context.Context, request generated.HealthRequestObje
 }
 
 func (s *Server) Cancel(ctx context.Context, request generated.CancelRequestObject) (generated.CancelResponseObject, error) {
-	logger := slog.With("handler", "Get")
-	query := `delete from reservation where username = $1 and reservation_uid = $2`
+	logger := slog.With("handler", "Cancel")
 
-	if _, err := s.db.ExecContext(ctx, query, contextutils.GetUser(ctx), request.ReservationUid); err != nil {
-		logger.Error("delete reservation from db", "error", err)
-		return nil, fmt.Errorf("delete reservtion from db: %w", err)
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select * from reservation where username = $1 and reservation_uid = $2 for update`
+
+	var reservations []reservation
+	if err := tx.SelectContext(ctx, &reservations, query, contextutils.GetUser(ctx), request.ReservationUid); err != nil {
+		logger.Error("select reservation from db", "error", err)
+		return nil, fmt.Errorf("select reservtion from db: %w", err)
+	}
+
+	if len(reservations) == 0 {
+		return generated.Cancel404JSONResponse{
+			Message: "reservation not found",
+		}, nil
+	}
+
+	r := reservations[0]
+	if deadline := r.StartDate.Add(s.loans.CancelGracePeriod); r.Status == state.Rented && time.Now().After(deadline) {
+		return generated.Cancel409JSONResponse{
+			Message: fmt.Sprintf("reservation could be cancelled only until %s", deadline.Format(time.RFC3339)),
+		}, nil
+	}
+
+	if err := state.Transition(ctx, tx, r.ID, r.Status, state.Cancelled, contextutils.GetUser(ctx)); errors.Is(err, state.ErrInvalidTransition) {
+		return generated.Cancel409JSONResponse{
+			Message: fmt.Sprintf("reservation is already %s", strings.ToLower(r.Status)),
+		}, nil
+	} else if err != nil {
+		logger.Error("cancel reservation", "error", err)
+		return nil, fmt.Errorf("cancel reservation: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
 	}
 
 	return generated.Cancel204Response{}, nil
@@ -38,10 +107,10 @@ func (s *Server) Cancel(ctx context.Context, request generated.CancelRequestObje
 
 func (s *Server) Get(ctx context.Context, request generated.GetRequestObject) (generated.GetResponseObject, error) {
 	logger := slog.With("handler", "Get")
-	query := `select * from reservation where username = $1 and reservation_uid = $2`
+	query := `select * from reservation where reservation_uid = $1 and (username = $2 or $3)`
 
 	var reservations []reservation
-	if err := s.db.SelectContext(ctx, &reservations, query, contextutils.GetUser(ctx), request.ReservationUid); err != nil {
+	if err := s.db.SelectContext(ctx, &reservations, query, request.ReservationUid, contextutils.GetUser(ctx), contextutils.IsStaff(ctx)); err != nil {
 		logger.Error("select reservation from db", "error", err)
 		return nil, fmt.Errorf("select reservtion from db: %w", err)
 	}
@@ -52,37 +121,137 @@ func (s *Server) Get(ctx context.Context, request generated.GetRequestObject) (g
 		}, nil
 	}
 
-	return generated.Get200JSONResponse{
-		BookUid:        reservations[0].BookUid,
-		LibraryUid:     reservations[0].LibraryUid,
-		ReservationUid: reservations[0].ReservationUid,
-		StartDate:      reservations[0].StartDate.Format(time.DateOnly),
-		Status:         generated.BookReservationResponseStatus(reservations[0].Status),
-		TillDate:       reservations[0].TillDate.Format(time.DateOnly),
-	}, nil
+	return generated.Get200JSONResponse(toBookReservationResponse(reservations[0])), nil
+}
+
+func (s *Server) History(ctx context.Context, request generated.HistoryRequestObject) (generated.HistoryResponseObject, error) {
+	logger := slog.With("handler", "History")
+
+	query := `select * from reservation where reservation_uid = $1 and (username = $2 or $3)`
+
+	var reservations []reservation
+	if err := s.db.SelectContext(ctx, &reservations, query, request.ReservationUid, contextutils.GetUser(ctx), contextutils.IsStaff(ctx)); err != nil {
+		logger.Error("select reservation from db", "error", err)
+		return nil, fmt.Errorf("select reservtion from db: %w", err)
+	}
+
+	if len(reservations) == 0 {
+		return generated.History404JSONResponse{
+			Message: "reservation not found",
+		}, nil
+	}
+
+	query = `select * from reservation_events where reservation_id = $1 order by id`
+
+	var events []reservationEvent
+	if err := s.db.SelectContext(ctx, &events, query, reservations[0].ID); err != nil {
+		logger.Error("select reservation events from db", "error", err)
+		return nil, fmt.Errorf("select reservation events from db: %w", err)
+	}
+
+	return generated.History200JSONResponse(lo.Map(events, func(e reservationEvent, _ int) generated.ReservationEventResponse {
+		return generated.ReservationEventResponse{
+			Actor:      e.Actor,
+			Comment:    e.Comment,
+			CreatedAt:  e.CreatedAt,
+			FromStatus: e.FromStatus,
+			ToStatus:   e.ToStatus,
+		}
+	})), nil
 }
 
 func (s *Server) List(ctx context.Context, request generated.ListRequestObject) (generated.ListResponseObject, error) {
 	logger := slog.With("handler", "List")
 
-	query := `select * from reservation where username = $1`
+	page, sort, verr := newListPage(reservationSorts, request.Params.Page, request.Params.Size,
+		string(lo.FromPtrOr(request.Params.Sort, generated.StartDate)),
+		string(lo.FromPtrOr(request.Params.Order, generated.Desc)),
+		request.Params.Cursor,
+	)
+	if verr != nil {
+		logger.Warn("invalid list parameters", "errors", verr.Errors)
+		return generated.List400JSONResponse(*verr), nil
+	}
+
+	allUsers := lo.FromPtr(request.Params.AllUsers)
+	if allUsers && !contextutils.IsStaff(ctx) {
+		return generated.List403JSONResponse{
+			Message: "only library staff can list reservations of all users",
+		}, nil
+	}
+
+	var q listing.Query
+	var conditions []string
+	if !allUsers {
+		conditions = append(conditions, `username = `+q.Bind(contextutils.GetUser(ctx)))
+	}
+
+	if request.Params.Status != nil && len(*request.Params.Status) > 0 {
+		statuses := lo.Map(*request.Params.Status, func(item generated.ListParamsStatus, _ int) string {
+			return q.Bind(string(item))
+		})
+		conditions = append(conditions, `status in (`+strings.Join(statuses, ", ")+`)`)
+	}
+
+	if lo.FromPtr(request.Params.Active) {
+		statuses := lo.Map(state.Active, func(item string, _ int) string {
+			return q.Bind(item)
+		})
+		conditions = append(conditions, `status in (`+strings.Join(statuses, ", ")+`)`)
+	}
+
+	if request.Params.From != nil {
+		from, err := time.Parse(time.DateOnly, *request.Params.From)
+		if err != nil {
+			return generated.List400JSONResponse(validationError("from", "invalid date format")), nil
+		}
+
+		conditions = append(conditions, `start_date >= `+q.Bind(from))
+	}
+
+	if request.Params.To != nil {
+		to, err := time.Parse(time.DateOnly, *request.Params.To)
+		if err != nil {
+			return generated.List400JSONResponse(validationError("to", "invalid date format")), nil
+		}
+
+		conditions = append(conditions, `start_date < `+q.Bind(to.AddDate(0, 0, 1)))
+	}
+
+	filtered := `select * from reservation`
+	if len(conditions) > 0 {
+		filtered += ` where ` + strings.Join(conditions, " and ")
+	}
+
+	filterArgs := len(q.Args)
+	query := page.Keyset(&q, filtered, sort.Column)
 
 	var reservations []reservation
-	if err := s.db.SelectContext(ctx, &reservations, query, contextutils.GetUser(ctx)); err != nil {
+	if err := s.db.SelectContext(ctx, &reservations, query, q.Args...); err != nil {
 		logger.Error("select reservations from db", "error", err)
 		return nil, fmt.Errorf("select reservtions from db: %w", err)
 	}
 
-	return generated.List200JSONResponse(lo.Map(reservations, func(r reservation, _ int) generated.BookReservationResponse {
-		return generated.BookReservationResponse{
-			BookUid:        r.BookUid,
-			LibraryUid:     r.LibraryUid,
-			ReservationUid: r.ReservationUid,
-			StartDate:      r.StartDate.Format(time.DateOnly),
-			Status:         generated.BookReservationResponseStatus(r.Status),
-			TillDate:       r.TillDate.Format(time.DateOnly),
-		}
-	})), nil
+	query = `select count(*) from (` + filtered + `) t`
+	var count int
+	if err := s.db.QueryRowContext(ctx, query, q.Args[:filterArgs]...).Scan(&count); err != nil {
+		logger.Error("select count from db", "error", err)
+		return nil, fmt.Errorf("select count from db: %w", err)
+	}
+
+	reservations, next := listing.Trim(page, sort, reservations, func(item reservation) int {
+		return item.ID
+	})
+
+	return generated.List200JSONResponse{
+		Items: lo.Map(reservations, func(r reservation, _ int) generated.BookReservationResponse {
+			return toBookReservationResponse(r)
+		}),
+		Page:          request.Params.Page,
+		PageSize:      request.Params.Size,
+		TotalElements: count,
+		NextCursor:    next,
+	}, nil
 }
 
 func (s *Server) Create(ctx context.Context, request generated.CreateRequestObject) (generated.CreateResponseObject, error) {
@@ -92,10 +261,7 @@ func (s *Server) Create(ctx context.Context, request generated.CreateRequestObje
 	till, err := time.Parse(time.DateOnly, request.Body.TillDate)
 	if err != nil {
 		logger.Error("parse time", "error", err)
-		return generated.Create400JSONResponse{
-			Errors:  nil,
-			Message: "invalid till date format",
-		}, nil
+		return generated.Create400JSONResponse(validationError("tillDate", "invalid till date format")), nil
 	}
 
 	r := reservation{
@@ -103,20 +269,116 @@ func (s *Server) Create(ctx context.Context, request generated.CreateRequestObje
 		LibraryUid:     request.Body.LibraryUid,
 		ReservationUid: uuid.New(),
 		StartDate:      now,
-		Status:         rented,
+		Status:         state.Rented,
 		TillDate:       till,
 		Username:       contextutils.GetUser(ctx),
 	}
 
-	query := `insert into reservation 
-    (reservation_uid, username, book_uid, library_uid, status, start_date, till_date)
-    values (:reservation_uid, :username, :book_uid, :library_uid, :status, :start_date, :till_date)`
+	today := now.UTC().Truncate(24 * time.Hour)
+	loanStart := today
 
-	if _, err := s.db.NamedExecContext(ctx, query, r); err != nil {
+	if request.Body.PickupDate != nil {
+		pickup, err := time.Parse(time.DateOnly, *request.Body.PickupDate)
+		if err != nil {
+			return generated.Create400JSONResponse(validationError("pickupDate", "invalid pickup date format")), nil
+		}
+
+		switch {
+		case !pickup.After(today):
+			return generated.Create400JSONResponse(validationError("pickupDate", "pickup date must be in the future")), nil
+		case pickup.After(today.AddDate(0, 0, s.loans.MaxAdvanceDays)):
+			return generated.Create400JSONResponse(validationError("pickupDate", fmt.Sprintf("book can be booked at most %d days in advance", s.loans.MaxAdvanceDays))), nil
+		}
+
+		loanStart = pickup
+		r.StartDate = pickup
+		r.Status = state.Pending
+		r.PickupUntil = lo.ToPtr(pickup.Add(s.loans.PickupWindow))
+	}
+
+	switch days := int(till.Sub(loanStart).Hours() / 24); {
+	case till.Before(today):
+		return generated.Create400JSONResponse(validationError("tillDate", "till date can not be in the past")), nil
+	case days < s.loans.MinDays:
+		return generated.Create400JSONResponse(validationError("tillDate", fmt.Sprintf("loan must be at least %d days", s.loans.MinDays))), nil
+	case days > s.loans.MaxDays:
+		return generated.Create400JSONResponse(validationError("tillDate", fmt.Sprintf("loan can not be longer than %d days", s.loans.MaxDays))), nil
+	}
+
+	blocked, err := s.finesBlockCheckout(ctx, r.Username)
+	if err != nil {
+		logger.Error("check unpaid fines", "error", err)
+		return nil, fmt.Errorf("check unpaid fines: %w", err)
+	}
+
+	if blocked {
+		return generated.Create409JSONResponse{
+			Message: "unpaid fines exceed the allowed amount, pay them to take new books",
+		}, nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select pg_advisory_xact_lock(hashtext('reservation_checkout'), hashtext($1))`
+	if _, err := tx.ExecContext(ctx, query, r.Username); err != nil {
+		logger.Error("lock user checkouts", "error", err)
+		return nil, fmt.Errorf("lock user checkouts: %w", err)
+	}
+
+	query = `select count(*) as active, count(*) filter (where book_uid = $2) as same_book
+		from reservation
+		where username = $1 and status = any($3)`
+
+	var loans activeLoans
+	if err := tx.GetContext(ctx, &loans, query, r.Username, r.BookUid, pq.Array(state.Active)); err != nil {
+		logger.Error("count active reservations", "error", err)
+		return nil, fmt.Errorf("count active reservations: %w", err)
+	}
+
+	// MaxActive is trusted as is: it is set by the gateway from the reader's
+	// rating, readers do not call the service directly. The check answers 400
+	// as the gateway did before the limit moved here.
+	switch {
+	case loans.SameBook > 0:
+		return generated.Create409JSONResponse{
+			Message: "user already has this book",
+		}, nil
+	case loans.Active > request.Body.MaxActive:
+		return generated.Create400JSONResponse{
+			Message: "too many taken books",
+		}, nil
+	}
+
+	query = `insert into reservation 
+    (reservation_uid, username, book_uid, library_uid, status, start_date, till_date, pickup_until)
+    values ($1, $2, $3, $4, $5, $6, $7, $8)
+    returning id`
+
+	var pqErr *pq.Error
+	if err := tx.QueryRowContext(ctx, query, r.ReservationUid, r.Username, r.BookUid, r.LibraryUid, r.Status, r.StartDate, r.TillDate, r.PickupUntil).Scan(&r.ID); errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return generated.Create409JSONResponse{
+			Message: "user already has this book",
+		}, nil
+	} else if err != nil {
 		logger.Error("create reservation", "error", err)
 		return nil, fmt.Errorf("create reservation: %w", err)
 	}
 
+	if err := state.Created(ctx, tx, r.ID, r.Status, r.Username); err != nil {
+		logger.Error("record reservation event", "error", err)
+		return nil, fmt.Errorf("record reservation event: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
 	return generated.Create200JSONResponse{
 		BookUid:        r.BookUid,
 		LibraryUid:     r.LibraryUid,
@@ -124,16 +386,24 @@ func (s *Server) Create(ctx context.Context, request generated.CreateRequestObje
 		StartDate:      r.StartDate.Format(time.DateOnly),
 		Status:         generated.TakeBookResponseStatus(r.Status),
 		TillDate:       r.TillDate.Format(time.DateOnly),
+		PickupUntil:    r.PickupUntil,
 	}, nil
 }
 
 func (s *Server) Finish(ctx context.Context, request generated.FinishRequestObject) (generated.FinishResponseObject, error) {
 	logger := slog.With("handler", "Finish")
 
-	query := `select * from reservation where reservation_uid = $1 and username = $2`
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select * from reservation where reservation_uid = $1 and (username = $2 or $3) for update`
 
 	var reservations []reservation
-	if err := s.db.SelectContext(ctx, &reservations, query, request.ReservationUid, contextutils.GetUser(ctx)); err != nil {
+	if err := tx.SelectContext(ctx, &reservations, query, request.ReservationUid, contextutils.GetUser(ctx), contextutils.IsStaff(ctx)); err != nil {
 		logger.Error("select reservations from db", "error", err)
 		return nil, fmt.Errorf("select reservtions from db: %w", err)
 	}
@@ -145,24 +415,421 @@ func (s *Server) Finish(ctx context.Context, request generated.FinishRequestObje
 		}, nil
 	}
 
-	status := returned
-	date, err := time.Parse(time.DateOnly, request.Body.Date)
+	date := time.Now().UTC().Truncate(24 * time.Hour)
+	if request.Body != nil && request.Body.Date != nil {
+		if !contextutils.IsStaff(ctx) {
+			return generated.Finish403JSONResponse{
+				Message: "only library staff can set return date",
+			}, nil
+		}
+
+		explicit, err := time.Parse(time.DateOnly, *request.Body.Date)
+		if err != nil {
+			return generated.Finish400JSONResponse(validationError("date", "invalid return date format")), nil
+		}
+
+		if explicit.After(date) {
+			return generated.Finish400JSONResponse(validationError("date", "return date can not be in the future")), nil
+		}
+
+		if explicit.Before(reservations[0].StartDate.Truncate(24 * time.Hour)) {
+			return generated.Finish400JSONResponse(validationError("date", "return date can not be before start date")), nil
+		}
+
+		date = explicit
+	}
+
+	r := reservations[0]
+
+	status := state.Returned
+	if date.After(r.TillDate) {
+		status = state.Expired
+	}
+
+	if err := state.Transition(ctx, tx, r.ID, r.Status, status, contextutils.GetUser(ctx)); errors.Is(err, state.ErrInvalidTransition) {
+		return generated.Finish409JSONResponse{
+			Message: fmt.Sprintf("reservation is already %s", strings.ToLower(r.Status)),
+		}, nil
+	} else if err != nil {
+		logger.Error("finish reservation", "error", err)
+		return nil, fmt.Errorf("finish reservation: %w", err)
+	}
+
+	var genre *string
+	if request.Body != nil {
+		genre = request.Body.Genre
+	}
+
+	charged, err := s.chargeFine(ctx, tx, r, date, genre)
 	if err != nil {
-		logger.Error("parse return date", "error", err)
-		return nil, fmt.Errorf("invalid date format: %w", err)
+		logger.Error("charge fine", "error", err)
+		return nil, fmt.Errorf("charge fine: %w", err)
 	}
 
-	if date.After(reservations[0].TillDate) {
-		status = expired
+	// Overdue loans are already penalized by the expiry job, the violation is
+	// reported only for loans it has not noticed yet.
+	var penalized bool
+	query = `select exists(select 1 from penalty_events where reservation_uid = $1 and reason = 'OVERDUE')`
+	if err := tx.GetContext(ctx, &penalized, query, r.ReservationUid); err != nil {
+		logger.Error("select penalty events from db", "error", err)
+		return nil, fmt.Errorf("select penalty events from db: %w", err)
 	}
 
-	query = `update reservation set status = $1 where reservation_uid = $2`
-	if _, err := s.db.ExecContext(ctx, query, status, request.ReservationUid); err != nil {
-		logger.Error("update reservation status", "error", err)
-		return nil, fmt.Errorf("update reservation status: %w", err)
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
 	}
 
-	return generated.Finish200JSONResponse{
-		Violation: status == expired,
+	resp := generated.Finish200JSONResponse{
+		Violation: status == state.Expired && !penalized,
+	}
+
+	if charged != nil {
+		resp.Fine = lo.ToPtr(toFineResponse(*charged))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) Pickup(ctx context.Context, request generated.PickupRequestObject) (generated.PickupResponseObject, error) {
+	logger := slog.With("handler", "Pickup")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.Pickup403JSONResponse{
+			Message: "only library staff can confirm pickup",
+		}, nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select * from reservation where reservation_uid = $1 for update`
+
+	var reservations []reservation
+	if err := tx.SelectContext(ctx, &reservations, query, request.ReservationUid); err != nil {
+		logger.Error("select reservations from db", "error", err)
+		return nil, fmt.Errorf("select reservtions from db: %w", err)
+	}
+
+	if len(reservations) == 0 {
+		return generated.Pickup404JSONResponse{
+			Message: "reservation not found",
+		}, nil
+	}
+
+	r := reservations[0]
+	if r.Status == state.Pending && r.PickupUntil != nil && time.Now().After(*r.PickupUntil) {
+		return generated.Pickup409JSONResponse{
+			Message: "booking was not picked up in time",
+		}, nil
+	}
+
+	if r.Status == state.Pending && time.Now().Before(r.StartDate.Truncate(24*time.Hour)) {
+		return generated.Pickup409JSONResponse{
+			Message: "book can not be picked up before " + r.StartDate.Format(time.DateOnly),
+		}, nil
+	}
+
+	if err := state.Transition(ctx, tx, r.ID, r.Status, state.Rented, contextutils.GetUser(ctx)); errors.Is(err, state.ErrInvalidTransition) {
+		return generated.Pickup409JSONResponse{
+			Message: fmt.Sprintf("reservation is %s, not waiting for pickup", strings.ToLower(r.Status)),
+		}, nil
+	} else if err != nil {
+		logger.Error("pick up reservation", "error", err)
+		return nil, fmt.Errorf("pick up reservation: %w", err)
+	}
+
+	r.Status = state.Rented
+	r.StartDate = time.Now()
+
+	query = `update reservation set start_date = $1 where id = $2`
+	if _, err := tx.ExecContext(ctx, query, r.StartDate, r.ID); err != nil {
+		logger.Error("update reservation start date", "error", err)
+		return nil, fmt.Errorf("update reservation start date: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.Pickup200JSONResponse(toBookReservationResponse(r)), nil
+}
+
+func (s *Server) Renew(ctx context.Context, request generated.RenewRequestObject) (generated.RenewResponseObject, error) {
+	logger := slog.With("handler", "Renew")
+
+	till, err := time.Parse(time.DateOnly, request.Body.TillDate)
+	if err != nil {
+		return generated.Renew400JSONResponse(validationError("tillDate", "invalid till date format")), nil
+	}
+
+	query := `select * from reservation where reservation_uid = $1 and username = $2`
+
+	var reservations []reservation
+	if err := s.db.SelectContext(ctx, &reservations, query, request.ReservationUid, contextutils.GetUser(ctx)); err != nil {
+		logger.Error("select reservations from db", "error", err)
+		return nil, fmt.Errorf("select reservtions from db: %w", err)
+	}
+
+	if len(reservations) == 0 {
+		return generated.Renew404JSONResponse{
+			Message: "reservation not found",
+		}, nil
+	}
+
+	// The queue is checked before the reservation is locked, so a slow library
+	// service does not hold the lock. A hold placed after this check is not
+	// seen by the renewal, it is served after the renewed loan is returned.
+	queueResp, err := s.library.GetHoldQueueWithResponse(ctx, reservations[0].LibraryUid, reservations[0].BookUid, s.token(ctx))
+	if err != nil {
+		logger.Error("get hold queue", "error", err)
+		return nil, fmt.Errorf("get hold queue: %w", err)
+	}
+
+	if queueResp.JSON200 == nil {
+		logger.Error("get hold queue unknown status", "status", queueResp.StatusCode())
+		return nil, fmt.Errorf("get hold queue: %s", string(queueResp.Body))
+	}
+
+	if queueResp.JSON200.Waiting > 0 {
+		return generated.Renew409JSONResponse{
+			Message: "other readers are waiting for this book",
+		}, nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query = `select * from reservation where id = $1 for update`
+
+	var r reservation
+	if err := tx.GetContext(ctx, &r, query, reservations[0].ID); err != nil {
+		logger.Error("select reservation from db", "error", err)
+		return nil, fmt.Errorf("select reservation from db: %w", err)
+	}
+
+	today := time.Now().Truncate(24 * time.Hour)
+
+	switch {
+	case r.Status == state.Overdue || (r.Status == state.Rented && r.TillDate.Before(today)):
+		return generated.Renew409JSONResponse{
+			Message: "reservation is overdue, return the book first",
+		}, nil
+	case r.Status != state.Rented:
+		return generated.Renew409JSONResponse{
+			Message: fmt.Sprintf("reservation is already %s", strings.ToLower(r.Status)),
+		}, nil
+	}
+
+	if !till.After(r.TillDate) {
+		return generated.Renew400JSONResponse(validationError("tillDate", "till date must be after current till date "+r.TillDate.Format(time.DateOnly))), nil
+	}
+
+	// The till date is shifted by the gateway to the day the library is open,
+	// which may go past the total loan length, so it is clamped here.
+	if maxTill := r.StartDate.Truncate(24*time.Hour).AddDate(0, 0, s.loans.MaxTotalDays); till.After(maxTill) {
+		if !maxTill.After(r.TillDate) {
+			return generated.Renew400JSONResponse(validationError("tillDate", fmt.Sprintf("loan can not be longer than %d days, latest till date is %s", s.loans.MaxTotalDays, maxTill.Format(time.DateOnly)))), nil
+		}
+
+		till = maxTill
+	}
+
+	var renewals int
+	query = `select count(*) from reservation_renewals where reservation_id = $1`
+	if err := tx.GetContext(ctx, &renewals, query, r.ID); err != nil {
+		logger.Error("count renewals", "error", err)
+		return nil, fmt.Errorf("count renewals: %w", err)
+	}
+
+	if renewals >= s.loans.MaxRenewals {
+		return generated.Renew409JSONResponse{
+			Message: fmt.Sprintf("reservation can be renewed at most %d times", s.loans.MaxRenewals),
+		}, nil
+	}
+
+	query = `insert into reservation_renewals (reservation_id, previous_till_date, till_date, renewed_by) values ($1, $2, $3, $4)`
+	if _, err := tx.ExecContext(ctx, query, r.ID, r.TillDate, till, contextutils.GetUser(ctx)); err != nil {
+		logger.Error("insert renewal", "error", err)
+		return nil, fmt.Errorf("insert renewal: %w", err)
+	}
+
+	r.TillDate = till
+
+	query = `update reservation set till_date = $1 where id = $2`
+	if _, err := tx.ExecContext(ctx, query, r.TillDate, r.ID); err != nil {
+		logger.Error("update reservation till date", "error", err)
+		return nil, fmt.Errorf("update reservation till date: %w", err)
+	}
+
+	if err := state.Note(ctx, tx, r.ID, r.Status, contextutils.GetUser(ctx), "renewed till "+r.TillDate.Format(time.DateOnly)); err != nil {
+		logger.Error("record reservation event", "error", err)
+		return nil, fmt.Errorf("record reservation event: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.Renew200JSONResponse(toBookReservationResponse(r)), nil
+}
+
+// penaltyClaimTimeout is how long claimed penalties wait for the ack before
+// they are handed out again, e.g. when the rating update has failed.
+const penaltyClaimTimeout = 5 * time.Minute
+
+func (s *Server) ClaimPenalties(ctx context.Context, request generated.ClaimPenaltiesRequestObject) (generated.ClaimPenaltiesResponseObject, error) {
+	logger := slog.With("handler", "ClaimPenalties")
+
+	query := `update penalty_events set claim_uid = $2, claimed_at = now()
+		where username = $1 and applied_at is null and (claim_uid is null or claimed_at < $3)
+		returning stars`
+	claimUID := uuid.New()
+
+	var stars []int
+	if err := s.db.SelectContext(ctx, &stars, query, contextutils.GetUser(ctx), claimUID, time.Now().Add(-penaltyClaimTimeout)); err != nil {
+		logger.Error("update penalty events", "error", err)
+		return nil, fmt.Errorf("update penalty events: %w", err)
+	}
+
+	// Penalties without stars are violations, the others take their stars.
+	resp := generated.ClaimPenalties200JSONResponse{ClaimUid: claimUID}
+	for _, st := range stars {
+		if st == 0 {
+			resp.Count++
+		}
+
+		resp.Stars += st
+	}
+
+	return resp, nil
+}
+
+func (s *Server) AckPenalties(ctx context.Context, request generated.AckPenaltiesRequestObject) (generated.AckPenaltiesResponseObject, error) {
+	logger := slog.With("handler", "AckPenalties")
+
+	query := `update penalty_events set applied_at = now()
+		where claim_uid = $1 and username = $2 and applied_at is null`
+	if _, err := s.db.ExecContext(ctx, query, request.ClaimUid, contextutils.GetUser(ctx)); err != nil {
+		logger.Error("update penalty events", "error", err)
+		return nil, fmt.Errorf("update penalty events: %w", err)
+	}
+
+	return generated.AckPenalties204Response{}, nil
+}
+
+func (s *Server) WriteOff(ctx context.Context, request generated.WriteOffRequestObject) (generated.WriteOffResponseObject, error) {
+	logger := slog.With("handler", "WriteOff")
+
+	if !contextutils.IsStaff(ctx) {
+		return generated.WriteOff403JSONResponse{
+			Message: "only library staff can write off books",
+		}, nil
+	}
+
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("begin transaction", "error", err)
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `select * from reservation where reservation_uid = $1 for update`
+
+	var reservations []reservation
+	if err := tx.SelectContext(ctx, &reservations, query, request.ReservationUid); err != nil {
+		logger.Error("select reservations from db", "error", err)
+		return nil, fmt.Errorf("select reservtions from db: %w", err)
+	}
+
+	if len(reservations) == 0 {
+		return generated.WriteOff404JSONResponse{
+			Message: "reservation not found",
+		}, nil
+	}
+
+	r := reservations[0]
+	status := string(request.Body.Status)
+
+	if err := state.Transition(ctx, tx, r.ID, r.Status, status, contextutils.GetUser(ctx)); errors.Is(err, state.ErrInvalidTransition) {
+		return generated.WriteOff409JSONResponse{
+			Message: fmt.Sprintf("reservation is already %s", strings.ToLower(r.Status)),
+		}, nil
+	} else if err != nil {
+		logger.Error("write off reservation", "error", err)
+		return nil, fmt.Errorf("write off reservation: %w", err)
+	}
+
+	r.Status = status
+
+	// The penalty is saved with the write-off and taken from the rating of
+	// the reader the same way as penalties for overdue books.
+	stars := s.penalties.Lost
+	if status == state.Damaged {
+		stars = s.penalties.Damaged
+	}
+
+	if stars > 0 {
+		query = `insert into penalty_events (reservation_uid, username, reason, stars) values ($1, $2, $3, $4)
+			on conflict do nothing`
+		if _, err := tx.ExecContext(ctx, query, r.ReservationUid, r.Username, status, stars); err != nil {
+			logger.Error("insert penalty event", "error", err)
+			return nil, fmt.Errorf("insert penalty event: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("commit transaction", "error", err)
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return generated.WriteOff200JSONResponse{
+		ReservationUid: r.ReservationUid,
+		Username:       r.Username,
+		Status:         generated.WriteOffReservationResponseStatus(r.Status),
+		BookUid:        r.BookUid,
+		LibraryUid:     r.LibraryUid,
 	}, nil
 }
+
+func toBookReservationResponse(r reservation) generated.BookReservationResponse {
+	return generated.BookReservationResponse{
+		BookUid:        r.BookUid,
+		LibraryUid:     r.LibraryUid,
+		ReservationUid: r.ReservationUid,
+		StartDate:      r.StartDate.Format(time.DateOnly),
+		Status:         generated.BookReservationResponseStatus(r.Status),
+		TillDate:       r.TillDate.Format(time.DateOnly),
+		PickupUntil:    r.PickupUntil,
+		Username:       r.Username,
+	}
+}
+
+func (s *Server) token(ctx context.Context) func(ctx context.Context, req *http.Request) error {
+	token := contextutils.GetToken(ctx)
+
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		return nil
+	}
+}
+
+func validationError(field, message string) generated.ValidationErrorResponse {
+	return generated.ValidationErrorResponse{
+		Message: "invalid request parameters",
+		Errors: []generated.ErrorDescription{
+			{Field: field, Error: message},
+		},
+	}
+}
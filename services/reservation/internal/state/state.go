@@ -0,0 +1,114 @@
+// Package state holds the reservation lifecycle. Status changes go through
+// Transition, which checks them against the allowed transitions and records
+// them in the reservation history.
+package state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jmoiron/sqlx"
+	"slices"
+)
+
+const (
+	Pending   = "PENDING"
+	Rented    = "RENTED"
+	Overdue   = "OVERDUE"
+	Returned  = "RETURNED"
+	Expired   = "EXPIRED"
+	Cancelled = "CANCELLED"
+	Lost      = "LOST"
+	Damaged   = "DAMAGED"
+)
+
+// Active lists the statuses of reservations which are not closed yet.
+var Active = []string{Pending, Rented, Overdue}
+
+// SystemActor is recorded for transitions made by background jobs.
+const SystemActor = "system"
+
+var ErrInvalidTransition = errors.New("invalid reservation status transition")
+
+var transitions = map[string][]string{
+	Pending: {Rented, Cancelled},
+	Rented:  {Overdue, Returned, Expired, Cancelled, Lost, Damaged},
+	Overdue: {Returned, Expired, Lost, Damaged},
+}
+
+// Can reports whether a reservation in status from can be moved to status to.
+func Can(from, to string) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// Final reports whether no transitions are allowed from the status.
+func Final(status string) bool {
+	return len(transitions[status]) == 0
+}
+
+// Created records the initial status of a new reservation.
+func Created(ctx context.Context, tx sqlx.ExecerContext, reservationID int, status, actor string) error {
+	return record(ctx, tx, reservationID, nil, status, actor, nil)
+}
+
+// Transition moves the reservation from status from to status to. It fails
+// with ErrInvalidTransition if the move is not allowed or the reservation is
+// not in status from anymore.
+func Transition(ctx context.Context, tx sqlx.ExtContext, reservationID int, from, to, actor string) error {
+	if !Can(from, to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, from, to)
+	}
+
+	res, err := tx.ExecContext(ctx, `update reservation set status = $3 where id = $1 and status = $2`, reservationID, from, to)
+	if err != nil {
+		return fmt.Errorf("update reservation status: %w", err)
+	}
+
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("count updated reservations: %w", err)
+	} else if n == 0 {
+		return fmt.Errorf("%w: reservation is not %s anymore", ErrInvalidTransition, from)
+	}
+
+	return record(ctx, tx, reservationID, &from, to, actor, nil)
+}
+
+// TransitionAll moves all reservations in status from, which match the
+// condition, to status to and records the transitions with the comment. The
+// condition is an SQL expression over the reservation row without arguments.
+// It returns ids of the moved reservations.
+func TransitionAll(ctx context.Context, tx sqlx.ExtContext, from, to, actor string, comment *string, condition string) ([]int, error) {
+	if !Can(from, to) {
+		return nil, fmt.Errorf("%w: %s -> %s", ErrInvalidTransition, from, to)
+	}
+
+	query := `with moved as (
+		update reservation set status = $2
+		where status = $1 and (` + condition + `)
+		returning id
+	)
+	insert into reservation_events (reservation_id, from_status, to_status, actor, comment)
+	select id, $1, $2, $3, $4 from moved
+	returning reservation_id`
+
+	var ids []int
+	if err := sqlx.SelectContext(ctx, tx, &ids, query, from, to, actor, comment); err != nil {
+		return nil, fmt.Errorf("update reservation statuses: %w", err)
+	}
+
+	return ids, nil
+}
+
+// Note records an event, which does not change the status, e.g. a renewal.
+func Note(ctx context.Context, tx sqlx.ExecerContext, reservationID int, status, actor, comment string) error {
+	return record(ctx, tx, reservationID, &status, status, actor, &comment)
+}
+
+func record(ctx context.Context, tx sqlx.ExecerContext, reservationID int, from *string, to, actor string, comment *string) error {
+	query := `insert into reservation_events (reservation_id, from_status, to_status, actor, comment) values ($1, $2, $3, $4, $5)`
+	if _, err := tx.ExecContext(ctx, query, reservationID, from, to, actor, comment); err != nil {
+		return fmt.Errorf("insert reservation event: %w", err)
+	}
+
+	return nil
+}
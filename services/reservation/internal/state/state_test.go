@@ -0,0 +1,104 @@
+package state
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"strings"
+	"testing"
+)
+
+type result int64
+
+func (r result) LastInsertId() (int64, error) { return 0, nil }
+
+func (r result) RowsAffected() (int64, error) { return int64(r), nil }
+
+// fakeTx answers the status update with the configured number of updated rows
+// and records all executed statements.
+type fakeTx struct {
+	sqlx.ExtContext
+	updated int64
+	queries []string
+	args    [][]any
+}
+
+func (tx *fakeTx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	tx.queries = append(tx.queries, query)
+	tx.args = append(tx.args, args)
+
+	if strings.HasPrefix(query, "update") {
+		return result(tx.updated), nil
+	}
+
+	return result(1), nil
+}
+
+func TestTransition(t *testing.T) {
+	tx := &fakeTx{updated: 1}
+
+	if err := Transition(context.Background(), tx, 7, Rented, Returned, "reader"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(tx.queries) != 2 {
+		t.Fatalf("expected update and event insert, got %v", tx.queries)
+	}
+
+	if !strings.Contains(tx.queries[0], "where id = $1 and status = $2") {
+		t.Fatalf("status update is not conditional: %s", tx.queries[0])
+	}
+
+	if !strings.Contains(tx.queries[1], "insert into reservation_events") {
+		t.Fatalf("expected event insert, got %s", tx.queries[1])
+	}
+
+	args := tx.args[1]
+	if from, ok := args[1].(*string); args[0] != 7 || !ok || *from != Rented || args[2] != Returned || args[3] != "reader" {
+		t.Fatalf("unexpected event %v", args)
+	}
+}
+
+func TestTransitionStaleStatus(t *testing.T) {
+	tx := &fakeTx{updated: 0}
+
+	err := Transition(context.Background(), tx, 7, Rented, Returned, "reader")
+	if !errors.Is(err, ErrInvalidTransition) {
+		t.Fatalf("expected invalid transition, got %v", err)
+	}
+
+	if len(tx.queries) != 1 {
+		t.Fatalf("expected no event for stale status, got %v", tx.queries)
+	}
+}
+
+func TestTransitionForbidden(t *testing.T) {
+	tests := []struct {
+		from string
+		to   string
+	}{
+		{from: Rented, to: Pending},
+		{from: Overdue, to: Cancelled},
+		{from: Returned, to: Rented},
+		{from: "UNKNOWN", to: Rented},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+" -> "+tt.to, func(t *testing.T) {
+			tx := &fakeTx{updated: 1}
+
+			if err := Transition(context.Background(), tx, 7, tt.from, tt.to, "reader"); !errors.Is(err, ErrInvalidTransition) {
+				t.Fatalf("expected invalid transition, got %v", err)
+			}
+
+			if _, err := TransitionAll(context.Background(), tx, tt.from, tt.to, SystemActor, nil, "true"); !errors.Is(err, ErrInvalidTransition) {
+				t.Fatalf("expected invalid bulk transition, got %v", err)
+			}
+
+			if len(tx.queries) != 0 {
+				t.Fatalf("expected no statements, got %v", tx.queries)
+			}
+		})
+	}
+}